@@ -1,8 +1,16 @@
 package azure
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // QuotedStringSlice formats a string slice into a quoted string containing all segments passed in a slice (e.g. string[]{"one", "two", "three"} will return {"one", "two" or "three"}). Useful for error messages with multiple possible values.
@@ -27,3 +35,265 @@ func QuotedStringSlice(strs []string) string {
 
 	return sb.String()
 }
+
+// ErrorCodeRequestDisallowedByPolicy is the Error Code returned by Resource Manager when a request is denied by an Azure Policy
+const ErrorCodeRequestDisallowedByPolicy = "RequestDisallowedByPolicy"
+
+// ResourceManagerError is the error envelope returned by Resource Manager
+type ResourceManagerError struct {
+	Code           string                               `json:"code"`
+	Message        string                               `json:"message"`
+	Target         string                               `json:"target"`
+	Details        []ResourceManagerError               `json:"details"`
+	AdditionalInfo []ResourceManagerErrorAdditionalInfo `json:"additionalInfo"`
+}
+
+// ResourceManagerErrorAdditionalInfo is additional information about a Resource Manager error, the contents of which
+// depends on the Type (for example `PolicyViolation`)
+type ResourceManagerErrorAdditionalInfo struct {
+	Type string          `json:"type"`
+	Info json.RawMessage `json:"info"`
+}
+
+// PolicyViolation contains the details of the Azure Policy which denied a request
+type PolicyViolation struct {
+	PolicyAssignmentID   string `json:"policyAssignmentId"`
+	PolicyAssignmentName string `json:"policyAssignmentName"`
+	PolicyDefinitionID   string `json:"policyDefinitionId"`
+	PolicyDefinitionName string `json:"policyDefinitionName"`
+}
+
+// ParseResourceManagerError parses the Resource Manager error envelope from the response body, which is either
+// nested within an `error` object or (for some APIs) at the top-level - returning nil if the body isn't an error
+func ParseResourceManagerError(body []byte) *ResourceManagerError {
+	var envelope struct {
+		ResourceManagerError
+		Error *ResourceManagerError `json:"error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+
+	if envelope.Error != nil && envelope.Error.Code != "" {
+		return envelope.Error
+	}
+	if envelope.Code != "" {
+		return &envelope.ResourceManagerError
+	}
+
+	return nil
+}
+
+// PolicyViolations returns the Azure Policies which denied the request, for this error and any nested errors
+// with the Error Code `RequestDisallowedByPolicy`
+func (e ResourceManagerError) PolicyViolations() []PolicyViolation {
+	violations := make([]PolicyViolation, 0)
+	if strings.EqualFold(e.Code, ErrorCodeRequestDisallowedByPolicy) {
+		for _, info := range e.AdditionalInfo {
+			if !strings.EqualFold(info.Type, "PolicyViolation") {
+				continue
+			}
+
+			var violation PolicyViolation
+			if err := json.Unmarshal(info.Info, &violation); err != nil || violation.PolicyAssignmentID == "" {
+				continue
+			}
+			violations = append(violations, violation)
+		}
+	}
+
+	for _, v := range e.Details {
+		violations = append(violations, v.PolicyViolations()...)
+	}
+
+	return violations
+}
+
+// Diagnostic returns a Diagnostic for this error using the specified Summary, where the Detail describes the error
+// (including any nested errors and the Azure Policy Assignments denying the request) and the Attribute Path is the
+// field within the Schema matching the `target` of the error, where it can be determined
+func (e ResourceManagerError) Diagnostic(summary string, resourceSchema map[string]*pluginsdk.Schema) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity:      diag.Error,
+		Summary:       summary,
+		Detail:        e.detail(),
+		AttributePath: e.attributePath(resourceSchema),
+	}
+}
+
+func (e ResourceManagerError) detail() string {
+	lines := make([]string, 0)
+	if e.Message != "" {
+		lines = append(lines, e.Message)
+	}
+	if e.Target != "" {
+		lines = append(lines, fmt.Sprintf("Target: %s", e.Target))
+	}
+
+	for _, v := range e.PolicyViolations() {
+		lines = append(lines, fmt.Sprintf("Azure Policy Assignment ID: %s", v.PolicyAssignmentID))
+		if v.PolicyDefinitionID != "" {
+			lines = append(lines, fmt.Sprintf("Azure Policy Definition ID: %s", v.PolicyDefinitionID))
+		}
+	}
+
+	if len(e.Details) > 0 {
+		lines = append(lines, "", "Details:")
+		lines = append(lines, e.nestedDetails("")...)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (e ResourceManagerError) nestedDetails(indent string) []string {
+	lines := make([]string, 0)
+	for _, v := range e.Details {
+		line := fmt.Sprintf("%s- %s: %s", indent, v.Code, v.Message)
+		if v.Target != "" {
+			line = fmt.Sprintf("%s (Target: %s)", line, v.Target)
+		}
+		lines = append(lines, line)
+		lines = append(lines, v.nestedDetails(indent+"  ")...)
+	}
+	return lines
+}
+
+// attributePath returns the Attribute Path for the `target` of this error - falling back to the `target` of
+// the first nested error which maps to a field within the Schema
+func (e ResourceManagerError) attributePath(resourceSchema map[string]*pluginsdk.Schema) cty.Path {
+	if path := AttributePathForTarget(e.Target, resourceSchema); path != nil {
+		return path
+	}
+
+	for _, v := range e.Details {
+		if path := v.attributePath(resourceSchema); path != nil {
+			return path
+		}
+	}
+
+	return nil
+}
+
+var targetIndexRegex = regexp.MustCompile(`^(.*)\[(\d+)\]$`)
+
+// AttributePathForTarget maps the `target` of a Resource Manager error (e.g. `properties.networkProfile.dnsServiceIP`)
+// to the matching field within the Schema (e.g. `network_profile.0.dns_service_ip`) - returning nil when the
+// `target` doesn't map to a field within the Schema
+func AttributePathForTarget(target string, resourceSchema map[string]*pluginsdk.Schema) cty.Path {
+	target = strings.TrimPrefix(strings.TrimPrefix(target, "$"), ".")
+	if target == "" || len(resourceSchema) == 0 {
+		return nil
+	}
+
+	type segment struct {
+		name  string
+		index *int
+	}
+	segments := make([]segment, 0)
+	for _, v := range strings.Split(target, ".") {
+		item := segment{
+			name: v,
+		}
+		if match := targetIndexRegex.FindStringSubmatch(v); match != nil {
+			index, _ := strconv.Atoi(match[2])
+			item.name = match[1]
+			item.index = &index
+		}
+
+		// the `properties` envelope isn't exposed in the Schema
+		if strings.EqualFold(item.name, "properties") {
+			continue
+		}
+		segments = append(segments, item)
+	}
+
+	var path cty.Path
+	currentSchema := resourceSchema
+	for i := 0; i < len(segments); i++ {
+		if currentSchema == nil {
+			// the remainder of the target is within a field which isn't a nested block
+			break
+		}
+
+		// the Schema can flatten nested objects (e.g. `sku.name` as `sku_name`), so try the longest match first
+		var field *pluginsdk.Schema
+		var fieldName string
+		for j := len(segments) - 1; j >= i; j-- {
+			names := make([]string, 0)
+			for _, v := range segments[i : j+1] {
+				names = append(names, toSnakeCase(v.name))
+			}
+
+			if name, v, ok := schemaFieldForName(currentSchema, strings.Join(names, "_")); ok {
+				field = v
+				fieldName = name
+				i = j
+				break
+			}
+		}
+		if field == nil {
+			if len(path) == 0 {
+				return nil
+			}
+			break
+		}
+
+		path = path.GetAttr(fieldName)
+		currentSchema = nil
+
+		nested, ok := field.Elem.(*pluginsdk.Resource)
+		if !ok || (field.Type != pluginsdk.TypeList && field.Type != pluginsdk.TypeSet) {
+			continue
+		}
+
+		// items within a Set can't be referenced by index
+		if field.Type == pluginsdk.TypeSet {
+			break
+		}
+
+		switch {
+		case segments[i].index != nil:
+			path = path.IndexInt(*segments[i].index)
+		case field.MaxItems == 1:
+			path = path.IndexInt(0)
+		default:
+			// without an index the item within the List is unknown
+			return path
+		}
+		currentSchema = nested.Schema
+	}
+
+	return path
+}
+
+// schemaFieldForName returns the field within the Schema for the specified name - where Resource Manager uses
+// a plural name for a List (e.g. `ipConfigurations`) the Schema generally uses the singular (`ip_configuration`)
+func schemaFieldForName(input map[string]*pluginsdk.Schema, name string) (string, *pluginsdk.Schema, bool) {
+	for _, candidate := range []string{name, strings.TrimSuffix(name, "s")} {
+		if v, ok := input[candidate]; ok {
+			return candidate, v, true
+		}
+	}
+
+	return "", nil, false
+}
+
+// toSnakeCase converts the camelCase field names used by Resource Manager (e.g. `dnsServiceIP`) into the
+// snake_case field names used in the Schema (e.g. `dns_service_ip`)
+func toSnakeCase(input string) string {
+	runes := []rune(input)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			previous := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
@@ -1,9 +1,12 @@
 package azure_test
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 func TestQuotedStringSlice(t *testing.T) {
@@ -38,3 +41,191 @@ func TestQuotedStringSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestParseResourceManagerError(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    `{"error": {"code": "InvalidParameter", "message": "The value is invalid"}}`,
+			expected: "InvalidParameter",
+		},
+		{
+			input:    `{"code": "Conflict", "message": "The resource is being updated"}`,
+			expected: "Conflict",
+		},
+		{
+			input:    `{"id": "/subscriptions/00000000-0000-0000-0000-000000000000"}`,
+			expected: "",
+		},
+		{
+			input:    `not json`,
+			expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		actual := azure.ParseResourceManagerError([]byte(v.input))
+		if v.expected == "" {
+			if actual != nil {
+				t.Fatalf("Expected no error but got %+v", *actual)
+			}
+			continue
+		}
+
+		if actual == nil || actual.Code != v.expected {
+			t.Fatalf("Expected the Error Code %q but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestResourceManagerErrorDiagnosticForPolicyViolation(t *testing.T) {
+	body := `{
+  "error": {
+    "code": "RequestDisallowedByPolicy",
+    "target": "example",
+    "message": "Resource 'example' was disallowed by policy.",
+    "additionalInfo": [
+      {
+        "type": "PolicyViolation",
+        "info": {
+          "policyDefinitionId": "/providers/Microsoft.Authorization/policyDefinitions/allowed-locations",
+          "policyAssignmentId": "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations"
+        }
+      }
+    ]
+  }
+}`
+	armError := azure.ParseResourceManagerError([]byte(body))
+	if armError == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	actual := armError.Diagnostic("creating Example", map[string]*pluginsdk.Schema{})
+	if actual.Summary != "creating Example" {
+		t.Fatalf("Expected the Summary %q but got %q", "creating Example", actual.Summary)
+	}
+	expected := "Azure Policy Assignment ID: /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/allowed-locations"
+	if !strings.Contains(actual.Detail, expected) {
+		t.Fatalf("Expected the Detail to contain %q but got %q", expected, actual.Detail)
+	}
+	if actual.AttributePath != nil {
+		t.Fatalf("Expected no Attribute Path but got %+v", actual.AttributePath)
+	}
+}
+
+func TestResourceManagerErrorDiagnosticForNestedTarget(t *testing.T) {
+	body := `{
+  "error": {
+    "code": "InvalidTemplateDeployment",
+    "message": "The template deployment failed.",
+    "details": [
+      {
+        "code": "InvalidParameter",
+        "target": "properties.networkProfile.dnsServiceIP",
+        "message": "The value of parameter networkProfile.dnsServiceIP is invalid."
+      }
+    ]
+  }
+}`
+	armError := azure.ParseResourceManagerError([]byte(body))
+	if armError == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	actual := armError.Diagnostic("creating Example", exampleErrorSchema())
+	expected := cty.GetAttrPath("network_profile").IndexInt(0).GetAttr("dns_service_ip")
+	if !actual.AttributePath.Equals(expected) {
+		t.Fatalf("Expected the Attribute Path %+v but got %+v", expected, actual.AttributePath)
+	}
+	if !strings.Contains(actual.Detail, "- InvalidParameter: The value of parameter networkProfile.dnsServiceIP is invalid. (Target: properties.networkProfile.dnsServiceIP)") {
+		t.Fatalf("Expected the Detail to contain the nested error but got %q", actual.Detail)
+	}
+}
+
+func TestAttributePathForTarget(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected cty.Path
+	}{
+		{
+			input:    "name",
+			expected: cty.GetAttrPath("name"),
+		},
+		{
+			input:    "sku.name",
+			expected: cty.GetAttrPath("sku_name"),
+		},
+		{
+			input:    "properties.subnet.id",
+			expected: cty.GetAttrPath("subnet_id"),
+		},
+		{
+			input:    "properties.ipConfigurations[1].properties.privateIPAddress",
+			expected: cty.GetAttrPath("ip_configuration").IndexInt(1).GetAttr("private_ip_address"),
+		},
+		{
+			input:    "properties.ipConfigurations",
+			expected: cty.GetAttrPath("ip_configuration"),
+		},
+		{
+			input:    "properties.ipConfiguration.privateIPAddress",
+			expected: cty.GetAttrPath("ip_configuration"),
+		},
+		{
+			input:    "properties.unknown",
+			expected: nil,
+		},
+		{
+			input:    "",
+			expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		actual := azure.AttributePathForTarget(v.input, exampleErrorSchema())
+		if !actual.Equals(v.expected) {
+			t.Fatalf("Expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func exampleErrorSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type: pluginsdk.TypeString,
+		},
+		"sku_name": {
+			Type: pluginsdk.TypeString,
+		},
+		"subnet_id": {
+			Type: pluginsdk.TypeString,
+		},
+		"ip_configuration": {
+			Type: pluginsdk.TypeList,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"private_ip_address": {
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+		"network_profile": {
+			Type:     pluginsdk.TypeList,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"dns_service_ip": {
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
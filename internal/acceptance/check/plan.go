@@ -0,0 +1,239 @@
+package check
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ExpectNoOp returns a PlanCheck which asserts that no changes are planned for the Resource
+func (t thatType) ExpectNoOp() plancheck.PlanCheck {
+	return planCheck{
+		resourceName: t.resourceName,
+		check: func(change *tfjson.Change) error {
+			if !change.Actions.NoOp() {
+				return fmt.Errorf("expected no changes but got action(s) %v - changed attributes: %s", change.Actions, strings.Join(changedAttributes(change), ", "))
+			}
+			return nil
+		},
+	}
+}
+
+// ExpectUpdateInPlace returns a PlanCheck which asserts that the Resource is updated in-place (rather than
+// being replaced) and that each of the specified attributes (e.g. `sku_name` or `identity.0.type`) is changed
+func (t thatType) ExpectUpdateInPlace(attributes ...string) plancheck.PlanCheck {
+	return planCheck{
+		resourceName: t.resourceName,
+		check: func(change *tfjson.Change) error {
+			if !change.Actions.Update() {
+				return fmt.Errorf("expected an in-place update but got action(s) %v - changed attributes: %s", change.Actions, strings.Join(changedAttributes(change), ", "))
+			}
+			return expectAttributesChanged(change, attributes)
+		},
+	}
+}
+
+// ExpectReplace returns a PlanCheck which asserts that the Resource is replaced (that is, destroyed and
+// re-created), that each of the specified attributes (e.g. `location` or `sku.0.name`) is changed and that
+// each of these attributes (or a block containing it) is ForceNew within the Schema for the Resource.
+func (t thatType) ExpectReplace(resource *pluginsdk.Resource, attributes ...string) plancheck.PlanCheck {
+	return planCheck{
+		resourceName: t.resourceName,
+		check: func(change *tfjson.Change) error {
+			if !change.Actions.Replace() {
+				return fmt.Errorf("expected the resource to be replaced but got action(s) %v - changed attributes: %s", change.Actions, strings.Join(changedAttributes(change), ", "))
+			}
+			if err := expectAttributesChanged(change, attributes); err != nil {
+				return err
+			}
+			return expectAttributesForceNew(resource, attributes)
+		},
+	}
+}
+
+var _ plancheck.PlanCheck = planCheck{}
+
+// planCheck is a PlanCheck which runs an assertion against the planned Change for a single Resource
+type planCheck struct {
+	// resourceName being the full resource name e.g. azurerm_foo.bar
+	resourceName string
+
+	check func(change *tfjson.Change) error
+}
+
+func (p planCheck) CheckPlan(_ context.Context, req plancheck.CheckPlanRequest, resp *plancheck.CheckPlanResponse) {
+	for _, rc := range req.Plan.ResourceChanges {
+		if rc.Address != p.resourceName || rc.Change == nil {
+			continue
+		}
+
+		if err := p.check(rc.Change); err != nil {
+			resp.Error = fmt.Errorf("%s: %+v", p.resourceName, err)
+		}
+		return
+	}
+
+	resp.Error = fmt.Errorf("%s was not found in the plan", p.resourceName)
+}
+
+// expectAttributesChanged returns an error if any of the attributes are unchanged within the planned Change
+func expectAttributesChanged(change *tfjson.Change, attributes []string) error {
+	changed := changedAttributes(change)
+
+	unchanged := make([]string, 0)
+	for _, attribute := range attributes {
+		found := false
+		for _, v := range changed {
+			if v == attribute || strings.HasPrefix(v, attribute+".") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unchanged = append(unchanged, attribute)
+		}
+	}
+
+	if len(unchanged) > 0 {
+		return fmt.Errorf("expected the attribute(s) %s to be changed but only %s changed", strings.Join(unchanged, ", "), strings.Join(changed, ", "))
+	}
+
+	return nil
+}
+
+// expectAttributesForceNew returns an error if any of the attributes (or a block containing it) isn't ForceNew
+// within the Schema for the Resource
+func expectAttributesForceNew(resource *pluginsdk.Resource, attributes []string) error {
+	if resource == nil {
+		return fmt.Errorf("the schema for the resource was not found")
+	}
+
+	notForceNew := make([]string, 0)
+	for _, attribute := range attributes {
+		forceNew, exists := attributeIsForceNew(resource.Schema, attribute)
+		if !exists {
+			return fmt.Errorf("the attribute %s was not found within the schema", attribute)
+		}
+		if !forceNew {
+			notForceNew = append(notForceNew, attribute)
+		}
+	}
+
+	if len(notForceNew) > 0 {
+		return fmt.Errorf("expected the attribute(s) %s to be ForceNew within the schema", strings.Join(notForceNew, ", "))
+	}
+
+	return nil
+}
+
+// attributeIsForceNew returns whether the attribute (e.g. `sku.0.name`) or a block containing it is ForceNew
+// within the Schema - and whether the attribute exists within the Schema
+func attributeIsForceNew(input map[string]*pluginsdk.Schema, attribute string) (forceNew bool, exists bool) {
+	segments := strings.Split(attribute, ".")
+	current := input
+
+	for i := 0; i < len(segments); i++ {
+		field, ok := current[segments[i]]
+		if !ok {
+			return false, false
+		}
+		if field.ForceNew {
+			return true, true
+		}
+		if i == len(segments)-1 {
+			return false, true
+		}
+
+		elem, ok := field.Elem.(*pluginsdk.Resource)
+		if !ok {
+			// the items within a List/Set/Map of primitive types
+			return false, i+1 == len(segments)-1
+		}
+
+		// skip the index of the item within the List/Set
+		i++
+		if i == len(segments)-1 {
+			return false, true
+		}
+		current = elem.Schema
+	}
+
+	return false, false
+}
+
+// changedAttributes returns the (sorted) flattened paths to each attribute which is changed (or becomes
+// unknown) within the planned Change, in the same format as the keys within the State (e.g. `sku.0.name`)
+func changedAttributes(change *tfjson.Change) []string {
+	before := make(map[string]interface{})
+	flattenPlanValue("", change.Before, before)
+	after := make(map[string]interface{})
+	flattenPlanValue("", change.After, after)
+	unknown := make(map[string]interface{})
+	flattenPlanValue("", change.AfterUnknown, unknown)
+
+	changed := make(map[string]struct{})
+	for key, value := range before {
+		if v, ok := after[key]; !ok || !reflect.DeepEqual(v, value) {
+			changed[key] = struct{}{}
+		}
+	}
+	for key, value := range after {
+		if v, ok := before[key]; !ok || !reflect.DeepEqual(v, value) {
+			changed[key] = struct{}{}
+		}
+	}
+	for key, value := range unknown {
+		if isUnknown, ok := value.(bool); ok && isUnknown {
+			changed[key] = struct{}{}
+		}
+	}
+
+	out := make([]string, 0, len(changed))
+	for key := range changed {
+		if key != "" {
+			out = append(out, key)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// flattenPlanValue flattens a (nested) value from the plan into the map, keyed by the path to each value
+func flattenPlanValue(prefix string, input interface{}, out map[string]interface{}) {
+	key := func(v string) string {
+		if prefix == "" {
+			return v
+		}
+		return prefix + "." + v
+	}
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			out[prefix] = v
+			return
+		}
+		for k, value := range v {
+			flattenPlanValue(key(k), value, out)
+		}
+
+	case []interface{}:
+		if len(v) == 0 {
+			out[prefix] = v
+			return
+		}
+		for i, value := range v {
+			flattenPlanValue(key(strconv.Itoa(i)), value, out)
+		}
+
+	default:
+		out[prefix] = v
+	}
+}
@@ -0,0 +1,220 @@
+package check
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testPlan(actions tfjson.Actions, before, after, afterUnknown map[string]interface{}) *tfjson.Plan {
+	return &tfjson.Plan{
+		ResourceChanges: []*tfjson.ResourceChange{
+			{
+				Address: "azurerm_example.test",
+				Change: &tfjson.Change{
+					Actions:      actions,
+					Before:       before,
+					After:        after,
+					AfterUnknown: afterUnknown,
+				},
+			},
+		},
+	}
+}
+
+func runPlanCheck(check plancheck.PlanCheck, plan *tfjson.Plan) error {
+	resp := plancheck.CheckPlanResponse{}
+	check.CheckPlan(context.TODO(), plancheck.CheckPlanRequest{Plan: plan}, &resp)
+	return resp.Error
+}
+
+func TestChangedAttributes(t *testing.T) {
+	change := &tfjson.Change{
+		Before: map[string]interface{}{
+			"name":     "example",
+			"location": "westeurope",
+			"sku": []interface{}{
+				map[string]interface{}{
+					"name":     "Standard",
+					"capacity": float64(1),
+				},
+			},
+			"tags": map[string]interface{}{},
+		},
+		After: map[string]interface{}{
+			"name":     "example",
+			"location": "westeurope",
+			"sku": []interface{}{
+				map[string]interface{}{
+					"name":     "Premium",
+					"capacity": float64(1),
+				},
+			},
+			"tags": map[string]interface{}{
+				"hello": "world",
+			},
+		},
+		AfterUnknown: map[string]interface{}{
+			"id": true,
+		},
+	}
+
+	expected := []string{"id", "sku.0.name", "tags", "tags.hello"}
+	if actual := changedAttributes(change); !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestExpectUpdateInPlace(t *testing.T) {
+	before := map[string]interface{}{
+		"sku_name": "Standard",
+		"location": "westeurope",
+	}
+	after := map[string]interface{}{
+		"sku_name": "Premium",
+		"location": "westeurope",
+	}
+
+	plan := testPlan(tfjson.Actions{tfjson.ActionUpdate}, before, after, nil)
+	if err := runPlanCheck(That("azurerm_example.test").ExpectUpdateInPlace("sku_name"), plan); err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+	if err := runPlanCheck(That("azurerm_example.test").ExpectUpdateInPlace("location"), plan); err == nil {
+		t.Fatalf("expected an error since `location` is unchanged")
+	}
+	if err := runPlanCheck(That("azurerm_example.test").ExpectReplace(testPlanResource(), "sku_name"), plan); err == nil {
+		t.Fatalf("expected an error since the resource is updated in-place")
+	}
+	if err := runPlanCheck(That("azurerm_other.test").ExpectUpdateInPlace(), plan); err == nil {
+		t.Fatalf("expected an error since the resource isn't in the plan")
+	}
+}
+
+func testPlanResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+			"network": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"capacity": {
+							Type:     pluginsdk.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestExpectReplace(t *testing.T) {
+	before := map[string]interface{}{
+		"location": "westeurope",
+		"sku_name": "Standard",
+		"network": []interface{}{
+			map[string]interface{}{"subnet_id": "first"},
+		},
+		"sku": []interface{}{
+			map[string]interface{}{"name": "Basic", "capacity": float64(1)},
+		},
+	}
+	after := map[string]interface{}{
+		"location": "eastus",
+		"sku_name": "Premium",
+		"network": []interface{}{
+			map[string]interface{}{"subnet_id": "second"},
+		},
+		"sku": []interface{}{
+			map[string]interface{}{"name": "Standard", "capacity": float64(2)},
+		},
+	}
+
+	plan := testPlan(tfjson.Actions{tfjson.ActionDelete, tfjson.ActionCreate}, before, after, map[string]interface{}{"id": true})
+	if err := runPlanCheck(That("azurerm_example.test").ExpectReplace(testPlanResource(), "location", "network.0.subnet_id", "sku.0.name"), plan); err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+	if err := runPlanCheck(That("azurerm_example.test").ExpectUpdateInPlace("location"), plan); err == nil {
+		t.Fatalf("expected an error since the resource is replaced")
+	}
+	if err := runPlanCheck(That("azurerm_example.test").ExpectReplace(testPlanResource(), "sku_name"), plan); err == nil {
+		t.Fatalf("expected an error since `sku_name` isn't ForceNew")
+	}
+	if err := runPlanCheck(That("azurerm_example.test").ExpectReplace(testPlanResource(), "sku.0.capacity"), plan); err == nil {
+		t.Fatalf("expected an error since `sku.0.capacity` isn't ForceNew")
+	}
+	if err := runPlanCheck(That("azurerm_example.test").ExpectReplace(nil, "location"), plan); err == nil {
+		t.Fatalf("expected an error since the schema for the resource wasn't found")
+	}
+}
+
+func TestAttributeIsForceNew(t *testing.T) {
+	cases := []struct {
+		attribute string
+		forceNew  bool
+		exists    bool
+	}{
+		{attribute: "location", forceNew: true, exists: true},
+		{attribute: "sku_name", forceNew: false, exists: true},
+		{attribute: "network", forceNew: true, exists: true},
+		{attribute: "network.0.subnet_id", forceNew: true, exists: true},
+		{attribute: "sku", forceNew: false, exists: true},
+		{attribute: "sku.0.name", forceNew: true, exists: true},
+		{attribute: "sku.0.capacity", forceNew: false, exists: true},
+		{attribute: "sku.0.tier", forceNew: false, exists: false},
+		{attribute: "zone", forceNew: false, exists: false},
+	}
+	for _, v := range cases {
+		forceNew, exists := attributeIsForceNew(testPlanResource().Schema, v.attribute)
+		if forceNew != v.forceNew || exists != v.exists {
+			t.Fatalf("expected %q to be ForceNew: %t / exist: %t but got %t / %t", v.attribute, v.forceNew, v.exists, forceNew, exists)
+		}
+	}
+}
+
+func TestExpectNoOp(t *testing.T) {
+	state := map[string]interface{}{
+		"location": "westeurope",
+	}
+
+	if err := runPlanCheck(That("azurerm_example.test").ExpectNoOp(), testPlan(tfjson.Actions{tfjson.ActionNoop}, state, state, nil)); err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+	if err := runPlanCheck(That("azurerm_example.test").ExpectNoOp(), testPlan(tfjson.Actions{tfjson.ActionUpdate}, state, state, nil)); err == nil {
+		t.Fatalf("expected an error since the resource is updated")
+	}
+}
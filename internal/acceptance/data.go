@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -57,10 +58,22 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// cassette is the Cassette used to record/replay the HTTP Interactions for this test, if enabled
+	cassette *common.Cassette
+
+	// random is the source used for any further random values when recording/replaying
+	random *rand.Rand
 }
 
 // BuildTestData generates some test data for the given resource
 func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
+	if FakeServerEnabled() {
+		if err := startFakeServer(); err != nil {
+			t.Fatalf("%+v", err)
+		}
+	}
+
 	env, err := Environment()
 	if err != nil {
 		t.Fatalf("Error retrieving Environment: %+v", err)
@@ -93,6 +106,10 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		Secondary: os.Getenv("ARM_TEST_SUBSCRIPTION_ID_ALT"),
 	}
 
+	if mode := common.RecordingModeFromEnvironment(); mode != common.RecordingModeDisabled {
+		testData.startRecording(t, mode)
+	}
+
 	return testData
 }
 
@@ -131,6 +148,10 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.random != nil {
+		return randStringFromSource(td.random, len, charSetAlphaNum)
+	}
+
 	return randString(len)
 }
 
@@ -148,3 +169,13 @@ func randStringFromCharSet(strlen int, charSet string) string {
 	}
 	return string(result)
 }
+
+// randStringFromSource generates a random string from the specified source by selecting
+// characters from the charset provided
+func randStringFromSource(source *rand.Rand, strlen int, charSet string) string {
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[source.Intn(len(charSet))]
+	}
+	return string(result)
+}
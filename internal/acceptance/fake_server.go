@@ -0,0 +1,71 @@
+package acceptance
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// FakeServerEnabled returns whether the acceptance tests should be run against the fake Resource Manager
+// server (see the `fakearm` package) rather than Azure, which is enabled via `ARM_TEST_FAKE_SERVER`
+func FakeServerEnabled() bool {
+	return strings.EqualFold(os.Getenv("ARM_TEST_FAKE_SERVER"), "true")
+}
+
+// startFakeServer starts (if necessary) the fake Resource Manager server shared by all tests in this process
+// and points the Provider at it by overriding the `metadata_host`, credentials and Subscription.
+//
+// The self-signed certificate used by the server is trusted by the Provider's autorest and go-azure-sdk clients
+// via the Root Certificates passed to the Provider (see `providers`). However the requests made to authenticate
+// (using go-azure-sdk) and to discover the Azure Environment (using go-azure-sdk and go-azure-helpers) use HTTP
+// transports built using the system certificates, so `SSL_CERT_FILE` must also be set for the whole process prior
+// to the first TLS connection (and as such this is only supported on Linux) - this can be removed once these
+// allow the HTTP transport to be configured.
+func startFakeServer() error {
+	if common.RecordingModeFromEnvironment() != common.RecordingModeDisabled {
+		return fmt.Errorf("`ARM_TEST_FAKE_SERVER` and `ARM_TEST_RECORDING_MODE` cannot be used together")
+	}
+
+	server, err := fakearm.StartShared()
+	if err != nil {
+		return fmt.Errorf("starting the fake Resource Manager server: %+v", err)
+	}
+
+	variables := map[string]string{
+		"ARM_CLIENT_ID":         fakearm.ClientId,
+		"ARM_CLIENT_SECRET":     fakearm.ClientSecret,
+		"ARM_ENVIRONMENT":       fakearm.EnvironmentName,
+		"ARM_METADATA_HOSTNAME": server.MetadataHost(),
+		"ARM_SUBSCRIPTION_ID":   fakearm.SubscriptionId,
+		"ARM_TENANT_ID":         fakearm.TenantId,
+		"SSL_CERT_FILE":         server.CertificatePath(),
+
+		// the fake server doesn't expose the list of Locations, so these can't be validated
+		"ARM_PROVIDER_ENHANCED_VALIDATION": "false",
+	}
+	for key, value := range variables {
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("setting %q: %+v", key, err)
+		}
+	}
+
+	// any Location can be used with the fake server, so these are only defaulted when unset
+	locations := map[string]string{
+		"ARM_TEST_LOCATION":      "westeurope",
+		"ARM_TEST_LOCATION_ALT":  "northeurope",
+		"ARM_TEST_LOCATION_ALT2": "eastus2",
+	}
+	for key, value := range locations {
+		if os.Getenv(key) != "" {
+			continue
+		}
+		if err := os.Setenv(key, value); err != nil {
+			return fmt.Errorf("setting %q: %+v", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package fakearm
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// serveMetadata returns the Azure Environment for the Server - where each endpoint points to the Server itself.
+// The `2022-09-01` API returns a single Environment, whereas earlier API Versions return a list of Environments.
+func (s *Server) serveMetadata(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for the Metadata endpoint", r.Method))
+		return
+	}
+
+	host := strings.Split(s.MetadataHost(), ":")[0]
+	environment := map[string]interface{}{
+		"name":            EnvironmentName,
+		"portal":          s.URL(),
+		"resourceManager": s.URL() + "/",
+		"authentication": map[string]interface{}{
+			"loginEndpoint":    s.URL(),
+			"audiences":        []string{s.URL() + "/"},
+			"tenant":           "common",
+			"identityProvider": "AAD",
+		},
+		"graph":                    s.URL() + "/",
+		"graphAudience":            s.URL() + "/",
+		"microsoftGraphResourceId": s.URL() + "/",
+		"suffixes": map[string]interface{}{
+			"keyVaultDns":       fmt.Sprintf("vault.%s", host),
+			"storage":           fmt.Sprintf("storage.%s", host),
+			"sqlServerHostname": fmt.Sprintf("database.%s", host),
+			"acrLoginServer":    fmt.Sprintf("azurecr.%s", host),
+		},
+	}
+
+	if r.URL.Query().Get("api-version") == "2022-09-01" {
+		writeJson(w, http.StatusOK, environment)
+		return
+	}
+
+	writeJson(w, http.StatusOK, []interface{}{environment})
+}
+
+// serveToken issues an (unsigned) access token containing the claims for the fake Service Principal, for any
+// Client ID and Secret - since the Provider only inspects the claims within the token, this isn't signed.
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for the Token endpoint", r.Method))
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", fmt.Sprintf("parsing the token request: %+v", err))
+		return
+	}
+
+	tenantId := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")[0]
+	if tenantId == "" || strings.EqualFold(tenantId, "common") {
+		tenantId = TenantId
+	}
+	clientId := r.PostForm.Get("client_id")
+	if clientId == "" {
+		clientId = ClientId
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud":   s.URL() + "/",
+		"iat":   time.Now().Unix(),
+		"iss":   fmt.Sprintf("%s/%s/", s.URL(), tenantId),
+		"appid": clientId,
+		"oid":   ObjectId,
+		"sub":   ObjectId,
+		"tid":   tenantId,
+		"ver":   "1.0",
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "server_error", fmt.Sprintf("serializing claims: %+v", err))
+		return
+	}
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"access_token": fmt.Sprintf("%s.%s.fakearm", header, base64.RawURLEncoding.EncodeToString(claims)),
+		"token_type":   "Bearer",
+		"expires_in":   "3600",
+	})
+}
@@ -0,0 +1,189 @@
+package fakearm
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+)
+
+const (
+	registrationStateRegistered    = "Registered"
+	registrationStateNotRegistered = "NotRegistered"
+	registrationStateUnregistered  = "Unregistered"
+)
+
+// registration is the Registration State for a Resource Provider or Preview Feature within a Subscription
+type registration struct {
+	name  string
+	state string
+}
+
+// isResourceProviderPath returns whether the path is for the Resource Provider (or Preview Feature) Registration
+// APIs, that is one of:
+//
+// /subscriptions/{subscriptionId}/providers
+// /subscriptions/{subscriptionId}/providers/{namespace}
+// /subscriptions/{subscriptionId}/providers/{namespace}/(register|unregister)
+// /subscriptions/{subscriptionId}/providers/Microsoft.Features/providers/{namespace}/features[/{name}[/(register|unregister)]]
+func isResourceProviderPath(path string) bool {
+	segments := pathSegments(path)
+	if len(segments) < 3 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "providers") {
+		return false
+	}
+
+	if len(segments) > 3 && strings.EqualFold(segments[3], "Microsoft.Features") {
+		return len(segments) >= 7 && strings.EqualFold(segments[4], "providers") && strings.EqualFold(segments[6], "features")
+	}
+
+	switch len(segments) {
+	case 3, 4:
+		return true
+	case 5:
+		return isRegistrationAction(segments[4])
+	}
+
+	return false
+}
+
+func isRegistrationAction(input string) bool {
+	return strings.EqualFold(input, "register") || strings.EqualFold(input, "unregister")
+}
+
+func (s *Server) serveResourceProvider(w http.ResponseWriter, r *http.Request, path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	segments := pathSegments(path)
+	subscriptionId := segments[1]
+
+	if len(segments) > 3 && strings.EqualFold(segments[3], "Microsoft.Features") {
+		s.serveFeature(w, r, subscriptionId, segments[5], segments[7:])
+		return
+	}
+
+	switch {
+	case len(segments) == 3 && r.Method == http.MethodGet:
+		namespaces := make(map[string]struct{})
+		for namespace := range resourceproviders.Required() {
+			namespaces[strings.ToLower(namespace)] = struct{}{}
+		}
+		for key := range s.providers {
+			namespaces[key] = struct{}{}
+		}
+		keys := make([]string, 0, len(namespaces))
+		for key := range namespaces {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		values := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			values = append(values, s.resourceProvider(subscriptionId, key))
+		}
+		writeJson(w, http.StatusOK, map[string]interface{}{
+			"value": values,
+		})
+
+	case len(segments) == 4 && r.Method == http.MethodGet:
+		writeJson(w, http.StatusOK, s.resourceProvider(subscriptionId, segments[3]))
+
+	case len(segments) == 5 && r.Method == http.MethodPost:
+		state := registrationStateRegistered
+		if strings.EqualFold(segments[4], "unregister") {
+			state = registrationStateUnregistered
+		}
+		s.providers[strings.ToLower(segments[3])] = &registration{
+			name:  segments[3],
+			state: state,
+		}
+		writeJson(w, http.StatusOK, s.resourceProvider(subscriptionId, segments[3]))
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for %q", r.Method, path))
+	}
+}
+
+// resourceProvider returns the Resource Provider with the specified Namespace - which is Registered unless it's
+// been explicitly Unregistered
+func (s *Server) resourceProvider(subscriptionId, namespace string) map[string]interface{} {
+	state := registrationStateRegistered
+	if existing, ok := s.providers[strings.ToLower(namespace)]; ok {
+		namespace = existing.name
+		state = existing.state
+	} else {
+		for name := range resourceproviders.Required() {
+			if strings.EqualFold(name, namespace) {
+				namespace = name
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                fmt.Sprintf("/subscriptions/%s/providers/%s", subscriptionId, namespace),
+		"namespace":         namespace,
+		"registrationState": state,
+		"resourceTypes":     []interface{}{},
+	}
+}
+
+// serveFeature serves the Preview Feature APIs for the specified Resource Provider, where the remaining
+// segments are (optionally) the name of the Feature and the action to perform
+func (s *Server) serveFeature(w http.ResponseWriter, r *http.Request, subscriptionId, namespace string, remaining []string) {
+	switch {
+	case len(remaining) == 0 && r.Method == http.MethodGet:
+		prefix := strings.ToLower(namespace) + "/"
+		keys := make([]string, 0)
+		for key := range s.features {
+			if strings.HasPrefix(key, prefix) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		values := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			values = append(values, s.feature(subscriptionId, namespace, s.features[key].name))
+		}
+		writeJson(w, http.StatusOK, map[string]interface{}{
+			"value": values,
+		})
+
+	case len(remaining) == 1 && r.Method == http.MethodGet:
+		writeJson(w, http.StatusOK, s.feature(subscriptionId, namespace, remaining[0]))
+
+	case len(remaining) == 2 && r.Method == http.MethodPost && isRegistrationAction(remaining[1]):
+		state := registrationStateRegistered
+		if strings.EqualFold(remaining[1], "unregister") {
+			state = registrationStateUnregistered
+		}
+		s.features[strings.ToLower(namespace+"/"+remaining[0])] = &registration{
+			name:  remaining[0],
+			state: state,
+		}
+		writeJson(w, http.StatusOK, s.feature(subscriptionId, namespace, remaining[0]))
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for the Features of %q", r.Method, namespace))
+	}
+}
+
+// feature returns the Preview Feature with the specified name - which is NotRegistered unless it's been
+// explicitly Registered
+func (s *Server) feature(subscriptionId, namespace, name string) map[string]interface{} {
+	state := registrationStateNotRegistered
+	if existing, ok := s.features[strings.ToLower(namespace+"/"+name)]; ok {
+		state = existing.state
+	}
+
+	return map[string]interface{}{
+		"id":   fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Features/providers/%s/features/%s", subscriptionId, namespace, name),
+		"name": fmt.Sprintf("%s/%s", namespace, name),
+		"type": "Microsoft.Features/providers/features",
+		"properties": map[string]interface{}{
+			"state": state,
+		},
+	}
+}
@@ -0,0 +1,412 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	resourceGroupResourceType = "Microsoft.Resources/resourceGroups"
+	subscriptionResourceType  = "Microsoft.Resources/subscriptions"
+
+	// operationsPathPrefix is the prefix for the Long Running Operation endpoints exposed by the Server
+	operationsPathPrefix = "/providers/Microsoft.FakeARM/"
+)
+
+// resource is a Resource stored within the Server, keyed by the (lower-cased) Resource ID
+type resource struct {
+	body map[string]interface{}
+}
+
+// payload returns a (deep) copy of the body for this Resource
+func (r *resource) payload() map[string]interface{} {
+	var out map[string]interface{}
+	raw, _ := json.Marshal(r.body)
+	_ = json.Unmarshal(raw, &out)
+	return out
+}
+
+// operation is a Long Running Operation, which is reported as In Progress when first polled and then Succeeded
+type operation struct {
+	pendingPolls int
+}
+
+func pathSegments(path string) []string {
+	segments := make([]string, 0)
+	for _, v := range strings.Split(path, "/") {
+		if v != "" {
+			segments = append(segments, v)
+		}
+	}
+	return segments
+}
+
+func resourceKey(id string) string {
+	return strings.ToLower("/" + strings.Join(pathSegments(id), "/"))
+}
+
+// resourceTypeForId returns the Resource Type (e.g. `Microsoft.Network/virtualNetworks/subnets`) for the Resource ID
+func resourceTypeForId(segments []string) string {
+	namespace := ""
+	types := make([]string, 0)
+	lastKey := ""
+	for i := 0; i+1 < len(segments); i += 2 {
+		key := segments[i]
+		lastKey = key
+		if strings.EqualFold(key, "providers") {
+			namespace = segments[i+1]
+			types = make([]string, 0)
+			continue
+		}
+		if namespace != "" {
+			types = append(types, key)
+		}
+	}
+
+	if namespace == "" || len(types) == 0 {
+		if strings.EqualFold(lastKey, "resourceGroups") {
+			return resourceGroupResourceType
+		}
+		return subscriptionResourceType
+	}
+
+	return fmt.Sprintf("%s/%s", namespace, strings.Join(types, "/"))
+}
+
+// parentScopeSegments returns the segments for the scope containing the Resource - for example the Resource
+// Group for a top-level Resource, or the parent Resource for a nested Resource
+func parentScopeSegments(segments []string) []string {
+	if len(segments) < 2 {
+		return []string{}
+	}
+
+	parent := segments[:len(segments)-2]
+	if len(parent) >= 2 && strings.EqualFold(parent[len(parent)-2], "providers") {
+		parent = parent[:len(parent)-2]
+	}
+	return parent
+}
+
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request, path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	segments := pathSegments(path)
+	if len(segments) == 0 {
+		writeError(w, http.StatusNotFound, "NotFound", "no Resource ID was specified")
+		return
+	}
+
+	// Subscriptions always exist, since these are where the Resources are created
+	if strings.EqualFold(segments[0], "subscriptions") && len(segments) <= 2 && r.Method == http.MethodGet {
+		s.serveSubscription(w, segments)
+		return
+	}
+
+	// an odd number of segments means this is a collection (e.g. `/subscriptions/{id}/resourceGroups`)
+	// rather than a Resource - or an action on a Resource when this is a POST
+	if len(segments)%2 != 0 {
+		if r.Method == http.MethodGet {
+			s.listResources(w, segments)
+			return
+		}
+
+		writeError(w, http.StatusBadRequest, "ActionNotSupported", fmt.Sprintf("the fake Resource Manager server doesn't support %s %q", r.Method, path))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		s.getResource(w, segments)
+
+	case http.MethodPut:
+		s.putResource(w, r, segments)
+
+	case http.MethodPatch:
+		s.patchResource(w, r, segments)
+
+	case http.MethodDelete:
+		s.deleteResource(w, segments)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the method %q is not supported for %q", r.Method, path))
+	}
+}
+
+func (s *Server) serveSubscription(w http.ResponseWriter, segments []string) {
+	subscription := func(subscriptionId string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":             fmt.Sprintf("/subscriptions/%s", subscriptionId),
+			"subscriptionId": subscriptionId,
+			"tenantId":       TenantId,
+			"displayName":    fmt.Sprintf("Fake Subscription %s", subscriptionId),
+			"state":          "Enabled",
+		}
+	}
+
+	if len(segments) == 1 {
+		writeJson(w, http.StatusOK, map[string]interface{}{
+			"value": []interface{}{
+				subscription(SubscriptionId),
+			},
+		})
+		return
+	}
+
+	writeJson(w, http.StatusOK, subscription(segments[1]))
+}
+
+func (s *Server) getResource(w http.ResponseWriter, segments []string) {
+	id := "/" + strings.Join(segments, "/")
+	existing, ok := s.resources[resourceKey(id)]
+	if !ok {
+		writeResourceNotFound(w, segments)
+		return
+	}
+
+	writeJson(w, http.StatusOK, existing.payload())
+}
+
+func (s *Server) listResources(w http.ResponseWriter, segments []string) {
+	prefix := resourceKey("/"+strings.Join(segments, "/")) + "/"
+
+	keys := make([]string, 0)
+	for key := range s.resources {
+		// only the direct children of this collection are returned
+		if strings.HasPrefix(key, prefix) && !strings.Contains(strings.TrimPrefix(key, prefix), "/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, s.resources[key].payload())
+	}
+
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *Server) putResource(w http.ResponseWriter, r *http.Request, segments []string) {
+	body, err := requestBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	if !s.parentScopeExists(w, segments) {
+		return
+	}
+
+	id := "/" + strings.Join(segments, "/")
+	key := resourceKey(id)
+	existing, exists := s.resources[key]
+	if exists {
+		// the casing of the Resource ID is retained from when the Resource was created, as in Azure
+		id = existing.body["id"].(string)
+	}
+
+	body["id"] = id
+	body["name"] = segments[len(segments)-1]
+	body["type"] = resourceTypeForId(segments)
+	setProvisioningState(body)
+	s.resources[key] = &resource{
+		body: body,
+	}
+
+	if exists {
+		writeJson(w, http.StatusOK, s.resources[key].payload())
+		return
+	}
+
+	// Resources are created using a Long Running Operation - which the SDKs ignore for synchronous APIs
+	w.Header().Set("Azure-AsyncOperation", s.newOperation("operationStatuses"))
+	w.Header().Set("Retry-After", "0")
+	writeJson(w, http.StatusCreated, s.resources[key].payload())
+}
+
+func (s *Server) patchResource(w http.ResponseWriter, r *http.Request, segments []string) {
+	body, err := requestBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	key := resourceKey("/" + strings.Join(segments, "/"))
+	existing, ok := s.resources[key]
+	if !ok {
+		writeResourceNotFound(w, segments)
+		return
+	}
+
+	// the top-level fields within the patch replace the existing values, other than `properties` which is merged
+	updated := existing.payload()
+	for k, v := range body {
+		if k == "id" || k == "name" || k == "type" {
+			continue
+		}
+
+		existingProperties, existingIsMap := updated[k].(map[string]interface{})
+		newProperties, newIsMap := v.(map[string]interface{})
+		if k == "properties" && existingIsMap && newIsMap {
+			for pk, pv := range newProperties {
+				existingProperties[pk] = pv
+			}
+			continue
+		}
+
+		updated[k] = v
+	}
+	setProvisioningState(updated)
+	existing.body = updated
+
+	writeJson(w, http.StatusOK, existing.payload())
+}
+
+func (s *Server) deleteResource(w http.ResponseWriter, segments []string) {
+	key := resourceKey("/" + strings.Join(segments, "/"))
+	if _, ok := s.resources[key]; !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// deleting a Resource also deletes any nested Resources, as in Azure
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+
+	resourceType := resourceTypeForId(segments)
+	for _, v := range s.LongRunningDeleteResourceTypes {
+		if strings.EqualFold(v, resourceType) {
+			w.Header().Set("Location", s.newOperation("operationResults"))
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// parentScopeExists returns whether the scope containing the Resource (e.g. the Resource Group) exists - writing
+// the error returned from Azure when it doesn't
+func (s *Server) parentScopeExists(w http.ResponseWriter, segments []string) bool {
+	parent := parentScopeSegments(segments)
+
+	// Resources at the Tenant or Subscription scope can always be created
+	if len(parent) == 0 || (len(parent) == 2 && strings.EqualFold(parent[0], "subscriptions")) {
+		return true
+	}
+
+	if _, ok := s.resources[resourceKey("/"+strings.Join(parent, "/"))]; ok {
+		return true
+	}
+
+	if resourceTypeForId(parent) == resourceGroupResourceType {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", parent[len(parent)-1]))
+		return false
+	}
+
+	writeError(w, http.StatusNotFound, "ParentResourceNotFound", fmt.Sprintf("Can not perform requested operation on nested resource. Parent resource '%s' not found.", parent[len(parent)-1]))
+	return false
+}
+
+func (s *Server) newOperation(kind string) string {
+	id := fmt.Sprintf("%d", len(s.operations)+1)
+	s.operations[id] = &operation{
+		pendingPolls: 1,
+	}
+	return fmt.Sprintf("%s%s%s/%s", s.URL(), operationsPathPrefix, kind, id)
+}
+
+// serveOperation serves the status (`Azure-AsyncOperation`) or result (`Location`) of a Long Running Operation
+func (s *Server) serveOperation(w http.ResponseWriter, r *http.Request, path string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	segments := pathSegments(strings.TrimPrefix(strings.ToLower(path), strings.ToLower(operationsPathPrefix)))
+	if len(segments) != 2 || r.Method != http.MethodGet {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("the operation %q was not found", path))
+		return
+	}
+	kind, id := segments[0], segments[1]
+
+	op, ok := s.operations[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("the operation %q was not found", id))
+		return
+	}
+
+	inProgress := op.pendingPolls > 0
+	if inProgress {
+		op.pendingPolls--
+	}
+
+	if kind == strings.ToLower("operationResults") {
+		if inProgress {
+			w.Header().Set("Location", fmt.Sprintf("%s%s", s.URL(), path))
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	status := "Succeeded"
+	if inProgress {
+		status = "InProgress"
+	}
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"name":   id,
+		"status": status,
+	})
+}
+
+func requestBody(r *http.Request) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+	if r.Body == nil {
+		return body, nil
+	}
+
+	raw, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the request body: %+v", err)
+	}
+	if len(strings.TrimSpace(string(raw))) == 0 {
+		return body, nil
+	}
+
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("the request body must be a JSON object: %+v", err)
+	}
+
+	return body, nil
+}
+
+func setProvisioningState(body map[string]interface{}) {
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok || properties == nil {
+		properties = make(map[string]interface{})
+		body["properties"] = properties
+	}
+	properties["provisioningState"] = "Succeeded"
+}
+
+func writeResourceNotFound(w http.ResponseWriter, segments []string) {
+	if resourceTypeForId(segments) == resourceGroupResourceType {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group '%s' could not be found.", segments[len(segments)-1]))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s/%s' was not found.", resourceTypeForId(segments), segments[len(segments)-1]))
+}
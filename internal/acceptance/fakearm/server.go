@@ -0,0 +1,198 @@
+package fakearm
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	// EnvironmentName is the name of the Azure Environment returned from the Metadata endpoint of the Server
+	EnvironmentName = "AzureFakeCloud"
+
+	ClientId       = "00000000-0000-0000-0000-00000000c11e"
+	ClientSecret   = "fake-client-secret"
+	ObjectId       = "00000000-0000-0000-0000-0000000000b1"
+	SubscriptionId = "00000000-0000-0000-0000-000000000500"
+	TenantId       = "00000000-0000-0000-0000-0000000007e1"
+)
+
+// Server is an in-memory fake of Azure Resource Manager, which implements generic PUT/GET/PATCH/DELETE semantics
+// for arbitrary Resource IDs (including Long Running Operations), alongside the Metadata, Token and Resource
+// Provider Registration endpoints required to point the Provider at it (via the `metadata_host`) - allowing
+// Resources to be tested without access to Azure.
+//
+// NOTE: since the Server only stores the request payload, APIs which compute values server-side (or which
+// require data plane access, such as Storage or Key Vault) aren't supported.
+type Server struct {
+	server          *httptest.Server
+	certificatePath string
+
+	// LongRunningDeleteResourceTypes are the Resource Types (e.g. `Microsoft.Resources/resourceGroups`) which are
+	// deleted using a Long Running Operation (returning a `202 Accepted` with a `Location` header) - other
+	// Resource Types are deleted synchronously, since the SDKs only accept a `200 OK` or `204 No Content` for these.
+	LongRunningDeleteResourceTypes []string
+
+	lock       sync.Mutex
+	resources  map[string]*resource
+	operations map[string]*operation
+	providers  map[string]*registration
+	features   map[string]*registration
+}
+
+var (
+	shared     *Server
+	sharedLock = &sync.Mutex{}
+)
+
+// Active returns the shared Server when this has been started (see `StartShared`), otherwise nil
+func Active() *Server {
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+
+	return shared
+}
+
+// StartShared starts (if necessary) and returns the Server shared by all tests within this process
+func StartShared() (*Server, error) {
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+
+	if shared == nil {
+		server, err := Start()
+		if err != nil {
+			return nil, err
+		}
+		shared = server
+	}
+
+	return shared, nil
+}
+
+// Start starts a new Server listening on a random local port using TLS - the self-signed certificate
+// for which is written to the file available from `CertificatePath` so that this can be trusted
+func Start() (*Server, error) {
+	s := &Server{
+		LongRunningDeleteResourceTypes: []string{
+			resourceGroupResourceType,
+		},
+		resources:  map[string]*resource{},
+		operations: map[string]*operation{},
+		providers:  map[string]*registration{},
+		features:   map[string]*registration{},
+	}
+
+	s.server = httptest.NewUnstartedServer(http.HandlerFunc(s.serveHTTP))
+	s.server.StartTLS()
+
+	certificatePath := filepath.Join(os.TempDir(), fmt.Sprintf("fakearm-%s.pem", strings.ReplaceAll(s.MetadataHost(), ":", "-")))
+	certificate := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: s.server.Certificate().Raw,
+	})
+	if err := os.WriteFile(certificatePath, certificate, 0o600); err != nil {
+		s.server.Close()
+		return nil, fmt.Errorf("writing the certificate for the fake Resource Manager server to %q: %+v", certificatePath, err)
+	}
+	s.certificatePath = certificatePath
+
+	log.Printf("[DEBUG] Started the fake Resource Manager server at %q", s.server.URL)
+	return s, nil
+}
+
+// Close stops the Server and removes the certificate file
+func (s *Server) Close() {
+	s.server.Close()
+	_ = os.Remove(s.certificatePath)
+}
+
+// CertificatePath returns the path to the PEM encoded (self-signed) certificate used by the Server
+func (s *Server) CertificatePath() string {
+	return s.certificatePath
+}
+
+// RootCertificates returns the system certificate pool with the (self-signed) certificate used by the Server
+// appended, which is trusted by the Provider's HTTP transport when running the Acceptance Tests
+func (s *Server) RootCertificates() (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, fmt.Errorf("loading the system certificate pool: %+v", err)
+	}
+	pool.AddCert(s.server.Certificate())
+	return pool, nil
+}
+
+// Client returns an HTTP Client which trusts the certificate used by the Server
+func (s *Server) Client() *http.Client {
+	return s.server.Client()
+}
+
+// MetadataHost returns the host (and port) for the Server, which should be used as the `metadata_host`
+func (s *Server) MetadataHost() string {
+	return strings.TrimPrefix(s.server.URL, "https://")
+}
+
+// URL returns the base URL for the Server, which is used as the endpoint for each API
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Resource returns the payload for the Resource with the specified ID (if it exists)
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	existing, ok := s.resources[resourceKey(id)]
+	if !ok {
+		return nil, false
+	}
+
+	return existing.payload(), true
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Printf("[DEBUG] Fake Resource Manager: %s %s", r.Method, r.URL.String())
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case path == "/metadata/endpoints":
+		s.serveMetadata(w, r)
+
+	case strings.HasSuffix(path, "/oauth2/v2.0/token"), strings.HasSuffix(path, "/oauth2/token"):
+		s.serveToken(w, r)
+
+	case strings.HasPrefix(strings.ToLower(path), strings.ToLower(operationsPathPrefix)):
+		s.serveOperation(w, r, path)
+
+	case isResourceProviderPath(path):
+		s.serveResourceProvider(w, r, path)
+
+	default:
+		s.serveResource(w, r, path)
+	}
+}
+
+func writeJson(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJson(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
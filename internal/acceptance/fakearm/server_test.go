@@ -0,0 +1,228 @@
+package fakearm
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func testRequest(t *testing.T, s *Server, method, path string, body interface{}) (*http.Response, map[string]interface{}) {
+	var payload bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&payload).Encode(body); err != nil {
+			t.Fatalf("encoding body: %+v", err)
+		}
+	}
+
+	uri := path
+	if !strings.HasPrefix(path, "https://") {
+		uri = s.URL() + path
+	}
+	req, err := http.NewRequest(method, uri, &payload)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp, err := s.Client().Do(req)
+	if err != nil {
+		t.Fatalf("sending %s %s: %+v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	out := make(map[string]interface{})
+	_ = json.NewDecoder(resp.Body).Decode(&out)
+	return resp, out
+}
+
+func startTestServer(t *testing.T) *Server {
+	s, err := Start()
+	if err != nil {
+		t.Fatalf("starting server: %+v", err)
+	}
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestServerResourceLifecycle(t *testing.T) {
+	s := startTestServer(t)
+	resourceGroupId := "/subscriptions/" + SubscriptionId + "/resourceGroups/example"
+	networkId := resourceGroupId + "/providers/Microsoft.Network/virtualNetworks/example"
+
+	// the Resource Group has to exist before Resources can be created within it
+	resp, body := testRequest(t, s, http.MethodPut, networkId+"?api-version=2022-07-01", map[string]interface{}{})
+	if resp.StatusCode != http.StatusNotFound || body["error"].(map[string]interface{})["code"] != "ResourceGroupNotFound" {
+		t.Fatalf("expected a ResourceGroupNotFound error but got %d: %+v", resp.StatusCode, body)
+	}
+
+	resp, body = testRequest(t, s, http.MethodPut, resourceGroupId+"?api-version=2022-09-01", map[string]interface{}{
+		"location": "westeurope",
+	})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 creating the Resource Group but got %d", resp.StatusCode)
+	}
+	if body["type"] != resourceGroupResourceType || body["id"] != resourceGroupId {
+		t.Fatalf("unexpected Resource Group payload: %+v", body)
+	}
+
+	resp, body = testRequest(t, s, http.MethodPut, networkId+"?api-version=2022-07-01", map[string]interface{}{
+		"location": "westeurope",
+		"properties": map[string]interface{}{
+			"addressSpace": map[string]interface{}{
+				"addressPrefixes": []interface{}{"10.0.0.0/16"},
+			},
+		},
+	})
+	if resp.StatusCode != http.StatusCreated || resp.Header.Get("Azure-AsyncOperation") == "" {
+		t.Fatalf("expected a 201 with an `Azure-AsyncOperation` header but got %d", resp.StatusCode)
+	}
+	if body["type"] != "Microsoft.Network/virtualNetworks" || body["name"] != "example" {
+		t.Fatalf("unexpected Virtual Network payload: %+v", body)
+	}
+
+	// updating an existing Resource returns a 200
+	resp, _ = testRequest(t, s, http.MethodPut, strings.ToUpper(networkId), map[string]interface{}{
+		"location": "westeurope",
+		"properties": map[string]interface{}{
+			"dhcpOptions": map[string]interface{}{},
+		},
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 updating the Virtual Network but got %d", resp.StatusCode)
+	}
+
+	resp, body = testRequest(t, s, http.MethodPatch, networkId, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"hello": "world",
+		},
+		"properties": map[string]interface{}{
+			"enableDdosProtection": true,
+		},
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 patching the Virtual Network but got %d", resp.StatusCode)
+	}
+	properties := body["properties"].(map[string]interface{})
+	if properties["enableDdosProtection"] != true || properties["dhcpOptions"] == nil || properties["provisioningState"] != "Succeeded" {
+		t.Fatalf("expected the properties to be merged but got %+v", properties)
+	}
+	if body["id"] != networkId {
+		t.Fatalf("expected the casing of the ID to be retained but got %q", body["id"])
+	}
+
+	resp, body = testRequest(t, s, http.MethodGet, resourceGroupId+"/providers/Microsoft.Network/virtualNetworks", nil)
+	if resp.StatusCode != http.StatusOK || len(body["value"].([]interface{})) != 1 {
+		t.Fatalf("expected a single Virtual Network to be listed but got %d: %+v", resp.StatusCode, body)
+	}
+
+	// deleting the Resource Group is a Long Running Operation, which also deletes the Resources within it
+	resp, _ = testRequest(t, s, http.MethodDelete, resourceGroupId, nil)
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected a 202 deleting the Resource Group but got %d", resp.StatusCode)
+	}
+	location := resp.Header.Get("Location")
+	if resp, _ = testRequest(t, s, http.MethodGet, location, nil); resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected the operation to be in progress when first polled but got %d", resp.StatusCode)
+	}
+	if resp, _ = testRequest(t, s, http.MethodGet, location, nil); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the operation to have completed but got %d", resp.StatusCode)
+	}
+
+	if resp, _ = testRequest(t, s, http.MethodGet, networkId, nil); resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 for the Virtual Network after deleting the Resource Group but got %d", resp.StatusCode)
+	}
+	if _, ok := s.Resource(resourceGroupId); ok {
+		t.Fatalf("expected the Resource Group to have been deleted")
+	}
+
+	// deleting a Resource which doesn't exist is a no-op
+	if resp, _ = testRequest(t, s, http.MethodDelete, resourceGroupId, nil); resp.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected a 204 deleting a Resource Group which doesn't exist but got %d", resp.StatusCode)
+	}
+}
+
+func TestServerAsyncOperation(t *testing.T) {
+	s := startTestServer(t)
+
+	resp, _ := testRequest(t, s, http.MethodPut, "/subscriptions/"+SubscriptionId+"/resourceGroups/example", map[string]interface{}{})
+	operation := resp.Header.Get("Azure-AsyncOperation")
+
+	expected := []string{"InProgress", "Succeeded", "Succeeded"}
+	for _, v := range expected {
+		_, body := testRequest(t, s, http.MethodGet, operation, nil)
+		if body["status"] != v {
+			t.Fatalf("expected the status to be %q but got %q", v, body["status"])
+		}
+	}
+}
+
+func TestServerNestedResources(t *testing.T) {
+	s := startTestServer(t)
+	networkId := "/subscriptions/" + SubscriptionId + "/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example"
+
+	testRequest(t, s, http.MethodPut, "/subscriptions/"+SubscriptionId+"/resourceGroups/example", map[string]interface{}{})
+
+	resp, body := testRequest(t, s, http.MethodPut, networkId+"/subnets/internal", map[string]interface{}{})
+	if resp.StatusCode != http.StatusNotFound || body["error"].(map[string]interface{})["code"] != "ParentResourceNotFound" {
+		t.Fatalf("expected a ParentResourceNotFound error but got %d: %+v", resp.StatusCode, body)
+	}
+
+	testRequest(t, s, http.MethodPut, networkId, map[string]interface{}{})
+	resp, body = testRequest(t, s, http.MethodPut, networkId+"/subnets/internal", map[string]interface{}{})
+	if resp.StatusCode != http.StatusCreated || body["type"] != "Microsoft.Network/virtualNetworks/subnets" {
+		t.Fatalf("expected the Subnet to be created but got %d: %+v", resp.StatusCode, body)
+	}
+
+	// Resources at the Subscription scope don't require a parent
+	resp, _ = testRequest(t, s, http.MethodPut, "/subscriptions/"+SubscriptionId+"/providers/Microsoft.Security/pricings/VirtualMachines", map[string]interface{}{})
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected the Subscription-level Resource to be created but got %d", resp.StatusCode)
+	}
+}
+
+func TestServerResourceProviderRegistration(t *testing.T) {
+	s := startTestServer(t)
+	providersPath := "/subscriptions/" + SubscriptionId + "/providers"
+
+	_, body := testRequest(t, s, http.MethodGet, providersPath+"/Microsoft.Example", nil)
+	if body["registrationState"] != registrationStateRegistered {
+		t.Fatalf("expected Resource Providers to be Registered by default but got %+v", body)
+	}
+
+	testRequest(t, s, http.MethodPost, providersPath+"/Microsoft.Example/unregister", nil)
+	_, body = testRequest(t, s, http.MethodGet, providersPath+"/Microsoft.Example", nil)
+	if body["registrationState"] != registrationStateUnregistered {
+		t.Fatalf("expected the Resource Provider to be Unregistered but got %+v", body)
+	}
+
+	_, body = testRequest(t, s, http.MethodGet, providersPath, nil)
+	found := false
+	for _, v := range body["value"].([]interface{}) {
+		if v.(map[string]interface{})["namespace"] == "Microsoft.Example" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected the Resource Provider to be listed")
+	}
+
+	featurePath := providersPath + "/Microsoft.Features/providers/Microsoft.Example/features/Preview"
+	_, body = testRequest(t, s, http.MethodGet, featurePath, nil)
+	if body["properties"].(map[string]interface{})["state"] != registrationStateNotRegistered {
+		t.Fatalf("expected Features to be NotRegistered by default but got %+v", body)
+	}
+	testRequest(t, s, http.MethodPost, featurePath+"/register", nil)
+	_, body = testRequest(t, s, http.MethodGet, featurePath, nil)
+	if body["properties"].(map[string]interface{})["state"] != registrationStateRegistered {
+		t.Fatalf("expected the Feature to be Registered but got %+v", body)
+	}
+}
+
+func TestServerToken(t *testing.T) {
+	s := startTestServer(t)
+
+	resp, body := testRequest(t, s, http.MethodPost, "/"+TenantId+"/oauth2/v2.0/token", nil)
+	if resp.StatusCode != http.StatusOK || body["access_token"] == "" || body["token_type"] != "Bearer" {
+		t.Fatalf("expected a token but got %d: %+v", resp.StatusCode, body)
+	}
+}
@@ -0,0 +1,332 @@
+package acceptance
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	providerResources     map[string]*pluginsdk.Resource
+	providerResourcesLock = &sync.Mutex{}
+)
+
+// resourceForType returns the Resource within the Provider for the specified Resource Type, if it exists
+func resourceForType(resourceType string) *pluginsdk.Resource {
+	providerResourcesLock.Lock()
+	defer providerResourcesLock.Unlock()
+
+	if providerResources == nil {
+		providerResources = provider.AzureProvider().ResourcesMap
+	}
+
+	return providerResources[resourceType]
+}
+
+// resourceTypeFromName returns the Resource Type for a resource name, e.g. `azurerm_resource_group` for `azurerm_resource_group.test`
+func resourceTypeFromName(resourceName string) string {
+	segments := strings.Split(resourceName, ".")
+	if len(segments) < 2 {
+		return resourceName
+	}
+
+	return segments[len(segments)-2]
+}
+
+// importVerification verifies the import of a Resource - by capturing the state of the Resource prior to the
+// import (when determining the Resource ID to import) and comparing this to the imported state
+type importVerification struct {
+	resourceName string
+	resourceType string
+	resource     *pluginsdk.Resource
+
+	// ignore are the fields specified within the ImportStep, which (matching `ImportStateVerifyIgnore`) are
+	// ignored when the key within the state starts with the field
+	ignore []string
+
+	// writeOnly are the fields marked as Write-Only within the schema (see `pluginsdk.WriteOnly`) where a `*`
+	// matches the index of any item within a Set or List - these are only ignored for the exact field within
+	// each item, rather than the containing block
+	writeOnly []string
+
+	existing *terraform.InstanceState
+}
+
+func newImportVerification(resourceName string, resource *pluginsdk.Resource, ignore []string) *importVerification {
+	v := &importVerification{
+		resourceName: resourceName,
+		resourceType: resourceTypeFromName(resourceName),
+		resource:     resource,
+		ignore:       ignore,
+	}
+	if resource != nil {
+		v.writeOnly = pluginsdk.WriteOnlyAttributes(resource.Schema)
+	}
+	return v
+}
+
+// importId returns the Resource ID to import, retaining the existing state of the Resource to verify the import against
+func (v *importVerification) importId(state *terraform.State) (string, error) {
+	for _, module := range state.Modules {
+		if existing, ok := module.Resources[v.resourceName]; ok && existing.Primary != nil {
+			v.existing = existing.Primary.DeepCopy()
+			return existing.Primary.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("Resource specified by ResourceName couldn't be found: %s", v.resourceName)
+}
+
+// check verifies the imported state - see importStateCheck - and then that the imported state matches the existing
+// state for the Resource, excluding any ignored or Write-Only fields
+func (v *importVerification) check(states []*terraform.InstanceState) error {
+	if err := importStateCheck(v.resourceType, v.resource, v.writeOnly)(states); err != nil {
+		return err
+	}
+
+	if v.existing == nil {
+		return fmt.Errorf("the existing state for %s wasn't retained prior to the import", v.resourceName)
+	}
+
+	for _, state := range states {
+		if state.Ephemeral.Type != v.resourceType {
+			continue
+		}
+		if state.ID != v.existing.ID {
+			return fmt.Errorf("Failed state verification, resource with ID %s not found", state.ID)
+		}
+
+		// fields ignored within the ImportStep may only be returned by the Read function for some configurations
+		// (for example, depending on the SKU) - so unlike the Write-Only fields these are only flagged as a warning
+		if returned := returnedFields(v.resource, state.Attributes, v.ignore); len(returned) > 0 {
+			log.Printf("[WARN] the field(s) %s are ignored when verifying the import of %s but are set by the Read function - consider removing these from the ImportStep", strings.Join(returned, ", "), v.resourceType)
+		}
+
+		expected := v.verifiedAttributes(v.existing.Attributes)
+		actual := v.verifiedAttributes(state.Attributes)
+		if diff := attributesDiff(expected, actual); diff != "" {
+			return fmt.Errorf("ImportStateVerify attributes not equivalent. Difference is shown below. The - symbol indicates attributes missing after import.\n\n%s", diff)
+		}
+	}
+
+	return nil
+}
+
+// verifiedAttributes returns the attributes within the state which are verified, excluding empty blocks and maps,
+// the timeouts (which are only sometimes set into the state) and any ignored or Write-Only fields
+func (v *importVerification) verifiedAttributes(input map[string]string) map[string]string {
+	out := make(map[string]string)
+
+	for key, value := range input {
+		if (strings.HasSuffix(key, ".#") || strings.HasSuffix(key, ".%")) && value == "0" {
+			continue
+		}
+		if key == "timeouts" || strings.HasPrefix(key, "timeouts.") {
+			continue
+		}
+		if v.isIgnored(key) {
+			continue
+		}
+
+		out[key] = value
+	}
+
+	return out
+}
+
+func (v *importVerification) isIgnored(key string) bool {
+	for _, field := range v.ignore {
+		if strings.HasPrefix(key, field) {
+			return true
+		}
+	}
+	for _, field := range v.writeOnly {
+		if keyWithinField(key, field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attributesDiff returns the (sorted) attributes which differ between the expected and actual attributes, if any
+func attributesDiff(expected map[string]string, actual map[string]string) string {
+	keys := make([]string, 0)
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0)
+	for _, key := range keys {
+		expectedValue, inExpected := expected[key]
+		actualValue, inActual := actual[key]
+		if inExpected && inActual && expectedValue == actualValue {
+			continue
+		}
+
+		if inExpected {
+			lines = append(lines, fmt.Sprintf("- %s: %q", key, expectedValue))
+		}
+		if inActual {
+			lines = append(lines, fmt.Sprintf("+ %s: %q", key, actualValue))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// importStateCheck returns an ImportStateCheckFunc which verifies that the Resource ID of the imported resource
+// can be parsed (case-sensitively) using the resource's ID parser, and that none of the specified Write-Only fields
+// are returned by the Read function - since otherwise these shouldn't be marked as Write-Only. A `*` within a field
+// matches any item within a Set or List, so these are checked per item.
+func importStateCheck(resourceType string, resource *pluginsdk.Resource, writeOnly []string) func([]*terraform.InstanceState) error {
+	return func(states []*terraform.InstanceState) error {
+		for _, state := range states {
+			if state.Ephemeral.Type != resourceType {
+				continue
+			}
+
+			if resource != nil {
+				if validateFunc, ok := pluginsdk.ImporterIDValidationFunc(resource.Importer); ok {
+					if err := validateFunc(state.ID); err != nil {
+						return fmt.Errorf("the Resource ID %q for the imported %s couldn't be parsed - the Read function should set the ID using the Resource ID parser: %+v", state.ID, resourceType, err)
+					}
+				}
+			}
+
+			if returned := returnedFields(resource, state.Attributes, writeOnly); len(returned) > 0 {
+				return fmt.Errorf("the field(s) %s are marked as Write-Only within the schema for %s but are set by the Read function - these shouldn't be marked as Write-Only", strings.Join(returned, ", "), resourceType)
+			}
+		}
+
+		return nil
+	}
+}
+
+// returnedFields returns the fields which have a value within the imported state
+func returnedFields(resource *pluginsdk.Resource, attributes map[string]string, fields []string) []string {
+	returned := make([]string, 0)
+	for _, field := range fields {
+		if isFieldReturned(resource, attributes, field) {
+			returned = append(returned, field)
+		}
+	}
+
+	return returned
+}
+
+// isFieldReturned returns whether the field (or any field within it) has a value in the imported state
+func isFieldReturned(resource *pluginsdk.Resource, attributes map[string]string, field string) bool {
+	for key, value := range attributes {
+		if !keyWithinField(key, field) {
+			continue
+		}
+
+		// empty blocks and maps are represented by their count
+		if strings.HasSuffix(key, ".#") || strings.HasSuffix(key, ".%") {
+			continue
+		}
+
+		var fieldSchema *pluginsdk.Schema
+		if resource != nil {
+			fieldSchema = schemaForKey(resource.Schema, key)
+		}
+		if !isZeroValue(fieldSchema, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keyWithinField returns whether the key within the state (e.g. `certificate.1.password`) is, or is within, the
+// field (e.g. `certificate.*.password`) - where a `*` matches the index of any item within a Set or List
+func keyWithinField(key string, field string) bool {
+	keySegments := strings.Split(key, ".")
+	fieldSegments := strings.Split(field, ".")
+	if len(keySegments) < len(fieldSegments) {
+		return false
+	}
+
+	for i, segment := range fieldSegments {
+		if segment != "*" && segment != keySegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isZeroValue returns whether the value within the state is the zero (or default) value for the field, which
+// is set into the state for fields which aren't returned from the API
+func isZeroValue(fieldSchema *pluginsdk.Schema, value string) bool {
+	if value == "" {
+		return true
+	}
+	if fieldSchema == nil {
+		return false
+	}
+
+	if fieldSchema.Default != nil && fmt.Sprintf("%v", fieldSchema.Default) == value {
+		return true
+	}
+
+	switch fieldSchema.Type {
+	case pluginsdk.TypeBool:
+		return value == "false"
+	case pluginsdk.TypeInt, pluginsdk.TypeFloat:
+		return value == "0"
+	}
+
+	return false
+}
+
+// schemaForKey returns the schema for the key within the state (e.g. `os_profile.0.admin_password`), if it exists
+func schemaForKey(input map[string]*pluginsdk.Schema, key string) *pluginsdk.Schema {
+	segments := strings.Split(key, ".")
+	current := input
+
+	for i := 0; i < len(segments); i++ {
+		field, ok := current[segments[i]]
+		if !ok {
+			return nil
+		}
+		if i == len(segments)-1 {
+			return field
+		}
+
+		switch elem := field.Elem.(type) {
+		case *pluginsdk.Resource:
+			// skip the index of the item within the List/Set
+			i++
+			if i == len(segments)-1 {
+				return nil
+			}
+			current = elem.Schema
+
+		case *pluginsdk.Schema:
+			// the items within a List/Set/Map of primitive types
+			if i+1 == len(segments)-1 {
+				return elem
+			}
+			return nil
+
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,389 @@
+package acceptance
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testImportResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if !strings.HasPrefix(id, "/subscriptions/") {
+				return fmt.Errorf("expected the ID to start with `/subscriptions/` but got %q", id)
+			}
+			return nil
+		}),
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+			"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			}),
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+			"profile": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						}),
+					},
+				},
+			},
+			"rule_timeout": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+			"rule": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+						},
+						"key": pluginsdk.WriteOnly(&pluginsdk.Schema{
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						}),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestWriteOnlyAttributes(t *testing.T) {
+	expected := []string{"password", "profile.0.secret", "rule.*.key"}
+	actual := pluginsdk.WriteOnlyAttributes(testImportResource().Schema)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestImportVerification(t *testing.T) {
+	existing := map[string]string{
+		"name":             "example",
+		"password":         "s3cr3t",
+		"enabled":          "true",
+		"profile.#":        "1",
+		"profile.0.secret": "s3cr3t",
+		"rule.#":           "2",
+		"rule.0.name":      "first",
+		"rule.0.key":       "s3cr3t",
+		"rule.1.name":      "second",
+		"rule.1.key":       "s3cr3t",
+		"rule_timeout":     "PT1H30M",
+		"timeouts.create":  "30m",
+	}
+
+	cases := []struct {
+		name        string
+		ignore      []string
+		attributes  map[string]string
+		expectError bool
+	}{
+		{
+			name: "write-only fields aren't returned",
+			attributes: map[string]string{
+				"name":         "example",
+				"enabled":      "true",
+				"profile.#":    "1",
+				"rule.#":       "2",
+				"rule.0.name":  "first",
+				"rule.1.name":  "second",
+				"rule_timeout": "PT1H30M",
+			},
+		},
+		{
+			name: "a field alongside the block containing a write-only field is verified",
+			attributes: map[string]string{
+				"name":         "example",
+				"enabled":      "true",
+				"profile.#":    "1",
+				"rule.#":       "2",
+				"rule.0.name":  "first",
+				"rule.1.name":  "second",
+				"rule_timeout": "PT1H",
+			},
+			expectError: true,
+		},
+		{
+			name: "a field within the block containing a write-only field is verified",
+			attributes: map[string]string{
+				"name":         "example",
+				"enabled":      "true",
+				"profile.#":    "1",
+				"rule.#":       "2",
+				"rule.0.name":  "first",
+				"rule.1.name":  "third",
+				"rule_timeout": "PT1H30M",
+			},
+			expectError: true,
+		},
+		{
+			name:   "an ignored field isn't returned",
+			ignore: []string{"enabled"},
+			attributes: map[string]string{
+				"name":         "example",
+				"profile.#":    "1",
+				"rule.#":       "2",
+				"rule.0.name":  "first",
+				"rule.1.name":  "second",
+				"rule_timeout": "PT1H30M",
+			},
+		},
+		{
+			name:   "an ignored field which is returned only logs a warning",
+			ignore: []string{"enabled"},
+			attributes: map[string]string{
+				"name":         "example",
+				"enabled":      "true",
+				"profile.#":    "1",
+				"rule.#":       "2",
+				"rule.0.name":  "first",
+				"rule.1.name":  "second",
+				"rule_timeout": "PT1H30M",
+			},
+		},
+		{
+			name: "a field which isn't ignored isn't returned",
+			attributes: map[string]string{
+				"name":         "example",
+				"profile.#":    "1",
+				"rule.#":       "2",
+				"rule.0.name":  "first",
+				"rule.1.name":  "second",
+				"rule_timeout": "PT1H30M",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.name, func(t *testing.T) {
+			verification := newImportVerification("azurerm_example.test", testImportResource(), v.ignore)
+
+			id, err := verification.importId(&terraform.State{
+				Modules: []*terraform.ModuleState{
+					{
+						Path: []string{"root"},
+						Resources: map[string]*terraform.ResourceState{
+							"azurerm_example.test": {
+								Type: "azurerm_example",
+								Primary: &terraform.InstanceState{
+									ID:         "/subscriptions/00000000-0000-0000-0000-000000000000",
+									Attributes: existing,
+								},
+							},
+						},
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("determining the ID to import: %+v", err)
+			}
+
+			state := &terraform.InstanceState{
+				ID:         id,
+				Attributes: v.attributes,
+				Ephemeral: terraform.EphemeralState{
+					Type: "azurerm_example",
+				},
+			}
+			err = verification.check([]*terraform.InstanceState{state})
+			if v.expectError && err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !v.expectError && err != nil {
+				t.Fatalf("expected no error but got %+v", err)
+			}
+		})
+	}
+}
+
+func TestImportStateCheck(t *testing.T) {
+	testResource := testImportResource()
+	writeOnly := pluginsdk.WriteOnlyAttributes(testResource.Schema)
+
+	cases := []struct {
+		id          string
+		attributes  map[string]string
+		expectError bool
+	}{
+		{
+			// none of the write-only fields are returned
+			id: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			attributes: map[string]string{
+				"name":      "example",
+				"enabled":   "false",
+				"profile.#": "0",
+			},
+		},
+		{
+			// the ID can't be parsed
+			id: "/Subscriptions/00000000-0000-0000-0000-000000000000",
+			attributes: map[string]string{
+				"name": "example",
+			},
+			expectError: true,
+		},
+		{
+			// a write-only field is returned
+			id: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			attributes: map[string]string{
+				"name":             "example",
+				"profile.#":        "1",
+				"profile.0.secret": "s3cr3t",
+			},
+			expectError: true,
+		},
+		{
+			// the items within a set are returned, but not the write-only field within them
+			id: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			attributes: map[string]string{
+				"name":        "example",
+				"rule.#":      "2",
+				"rule.0.name": "first",
+				"rule.1.name": "second",
+			},
+		},
+		{
+			// a write-only field within an item in a set is returned
+			id: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			attributes: map[string]string{
+				"name":        "example",
+				"rule.#":      "2",
+				"rule.0.name": "first",
+				"rule.1.name": "second",
+				"rule.1.key":  "s3cr3t",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		state := &terraform.InstanceState{
+			ID:         v.id,
+			Attributes: v.attributes,
+			Ephemeral: terraform.EphemeralState{
+				Type: "azurerm_example",
+			},
+		}
+
+		err := importStateCheck("azurerm_example", testResource, writeOnly)([]*terraform.InstanceState{state})
+		if v.expectError && err == nil {
+			t.Fatalf("expected an error for %q / %+v but didn't get one", v.id, v.attributes)
+		}
+		if !v.expectError && err != nil {
+			t.Fatalf("expected no error for %q / %+v but got %+v", v.id, v.attributes, err)
+		}
+	}
+}
+
+func TestKeyWithinField(t *testing.T) {
+	cases := []struct {
+		key      string
+		field    string
+		expected bool
+	}{
+		{key: "password", field: "password", expected: true},
+		{key: "password_hash", field: "password", expected: false},
+		{key: "profile.0.secret", field: "profile", expected: true},
+		{key: "profile.0.secret", field: "profile.0.secret", expected: true},
+		{key: "rule.3.key", field: "rule.*.key", expected: true},
+		{key: "rule.3.name", field: "rule.*.key", expected: false},
+		{key: "rule.#", field: "rule.*.key", expected: false},
+	}
+	for _, v := range cases {
+		if actual := keyWithinField(v.key, v.field); actual != v.expected {
+			t.Fatalf("expected %t for %q within %q but got %t", v.expected, v.key, v.field, actual)
+		}
+	}
+}
+
+func TestResourceTypeFromName(t *testing.T) {
+	cases := map[string]string{
+		"azurerm_resource_group.test":             "azurerm_resource_group",
+		"module.example.azurerm_resource_group.a": "azurerm_resource_group",
+	}
+	for input, expected := range cases {
+		if actual := resourceTypeFromName(input); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
+
+func TestImportVerificationVirtualMachineScaleSetExtensions(t *testing.T) {
+	existing := map[string]string{
+		"name":                           "acctestvmss",
+		"extension.#":                    "1",
+		"extension.0.name":               "CustomScript",
+		"extension.0.protected_settings": "{\"commandToExecute\":\"echo $HOSTNAME\"}",
+		"extensions_time_budget":         "PT1H30M",
+	}
+	imported := make(map[string]string)
+	for k, v := range existing {
+		if k != "extension.0.protected_settings" {
+			imported[k] = v
+		}
+	}
+
+	verify := func(attributes map[string]string) error {
+		verification := newImportVerification("azurerm_linux_virtual_machine_scale_set.test", resourceForType("azurerm_linux_virtual_machine_scale_set"), nil)
+		if _, err := verification.importId(&terraform.State{
+			Modules: []*terraform.ModuleState{
+				{
+					Path: []string{"root"},
+					Resources: map[string]*terraform.ResourceState{
+						"azurerm_linux_virtual_machine_scale_set.test": {
+							Type: "azurerm_linux_virtual_machine_scale_set",
+							Primary: &terraform.InstanceState{
+								ID:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachineScaleSets/acctestvmss",
+								Attributes: existing,
+							},
+						},
+					},
+				},
+			},
+		}); err != nil {
+			return err
+		}
+
+		return verification.check([]*terraform.InstanceState{
+			{
+				ID:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachineScaleSets/acctestvmss",
+				Attributes: attributes,
+				Ephemeral: terraform.EphemeralState{
+					Type: "azurerm_linux_virtual_machine_scale_set",
+				},
+			},
+		})
+	}
+
+	// the Write-Only `protected_settings` within each extension is ignored
+	if err := verify(imported); err != nil {
+		t.Fatalf("expected no error but got %+v", err)
+	}
+
+	// but `extensions_time_budget` (which starts with `extension`) is still verified
+	imported["extensions_time_budget"] = "PT1H"
+	if err := verify(imported); err == nil {
+		t.Fatalf("expected an error when `extensions_time_budget` differs but didn't get one")
+	}
+}
@@ -0,0 +1,85 @@
+package acceptance
+
+import (
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+const (
+	recordingVariableRandomInteger         = "random_integer"
+	recordingVariableRandomString          = "random_string"
+	recordingVariableLocationPrimary       = "location_primary"
+	recordingVariableLocationSecondary     = "location_secondary"
+	recordingVariableLocationTernary       = "location_ternary"
+	recordingVariableSubscriptionPrimary   = "subscription_primary"
+	recordingVariableSubscriptionSecondary = "subscription_secondary"
+)
+
+// recordingsDirectory returns the directory where Cassettes should be stored, which defaults to
+// `testdata/recordings` within the Service Package - but can be overridden via `ARM_TEST_RECORDINGS_DIR`
+func recordingsDirectory() string {
+	if v := os.Getenv("ARM_TEST_RECORDINGS_DIR"); v != "" {
+		return v
+	}
+
+	return filepath.Join("testdata", "recordings")
+}
+
+// startRecording starts recording (or replaying) the HTTP Interactions for this test to (or from) a Cassette
+//
+// Since the random values and locations used for this test form part of the Resource ID's, these are stored
+// in the Cassette when recording and then restored when replaying so that the same requests are made.
+func (td *TestData) startRecording(t *testing.T, mode common.RecordingMode) {
+	path := filepath.Join(recordingsDirectory(), strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	cassette, err := common.StartCassette(path, mode)
+	if err != nil {
+		t.Fatalf("starting cassette: %+v", err)
+		return
+	}
+	t.Cleanup(func() {
+		if err := cassette.Stop(); err != nil {
+			t.Errorf("stopping cassette: %+v", err)
+		}
+	})
+	td.cassette = cassette
+
+	variables := map[string]*string{
+		recordingVariableRandomString:          &td.RandomString,
+		recordingVariableLocationPrimary:       &td.Locations.Primary,
+		recordingVariableLocationSecondary:     &td.Locations.Secondary,
+		recordingVariableLocationTernary:       &td.Locations.Ternary,
+		recordingVariableSubscriptionPrimary:   &td.Subscriptions.Primary,
+		recordingVariableSubscriptionSecondary: &td.Subscriptions.Secondary,
+	}
+
+	switch mode {
+	case common.RecordingModeRecord:
+		cassette.SetVariable(recordingVariableRandomInteger, strconv.Itoa(td.RandomInteger))
+		for key, value := range variables {
+			cassette.SetVariable(key, *value)
+		}
+
+	case common.RecordingModeReplay:
+		v, _ := cassette.Variable(recordingVariableRandomInteger)
+		if td.RandomInteger, err = strconv.Atoi(v); err != nil {
+			t.Fatalf("parsing %q from cassette %q: %+v", recordingVariableRandomInteger, path, err)
+			return
+		}
+		for key, value := range variables {
+			*value, _ = cassette.Variable(key)
+		}
+
+		// the provider reads these from the environment, however no credentials are required when replaying
+		t.Setenv("ARM_SUBSCRIPTION_ID", td.Subscriptions.Primary)
+		t.Setenv("ARM_PROVIDER_ENHANCED_VALIDATION", "false")
+	}
+
+	// any further random values for this test need to be derived from the recorded values
+	td.random = rand.New(rand.NewSource(int64(td.RandomInteger))) // nolint gosec
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
@@ -26,6 +27,57 @@ func (td TestData) ApplyStep(config func(data TestData) string, testResource typ
 	}
 }
 
+// ExpectNoOpPlanStep returns a Test Step which applies a Configuration and asserts that
+// no changes are planned, for example to confirm that a difference in casing or formatting
+// is suppressed when the Configuration has been applied in a previous step.
+func (td TestData) ExpectNoOpPlanStep(config func(data TestData) string, testResource types.TestResource) resource.TestStep {
+	return resource.TestStep{
+		Config: config(td),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				check.That(td.ResourceName).ExpectNoOp(),
+			},
+		},
+		Check: ComposeTestCheckFunc(
+			check.That(td.ResourceName).ExistsInAzure(testResource),
+		),
+	}
+}
+
+// ExpectUpdateInPlaceStep returns a Test Step which applies a Configuration and asserts that
+// the resource is updated in-place (rather than replaced) with each of the specified attributes
+// being changed - confirming that these fields aren't ForceNew.
+func (td TestData) ExpectUpdateInPlaceStep(config func(data TestData) string, testResource types.TestResource, attributes ...string) resource.TestStep {
+	return resource.TestStep{
+		Config: config(td),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				check.That(td.ResourceName).ExpectUpdateInPlace(attributes...),
+			},
+		},
+		Check: ComposeTestCheckFunc(
+			check.That(td.ResourceName).ExistsInAzure(testResource),
+		),
+	}
+}
+
+// ExpectReplaceStep returns a Test Step which applies a Configuration and asserts that the
+// resource is replaced with each of the specified attributes being changed - confirming that
+// these fields (or a block containing them) are ForceNew within the schema for the resource.
+func (td TestData) ExpectReplaceStep(config func(data TestData) string, testResource types.TestResource, attributes ...string) resource.TestStep {
+	return resource.TestStep{
+		Config: config(td),
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				check.That(td.ResourceName).ExpectReplace(resourceForType(td.ResourceType), attributes...),
+			},
+		},
+		Check: ComposeTestCheckFunc(
+			check.That(td.ResourceName).ExistsInAzure(testResource),
+		),
+	}
+}
+
 type DisappearsStepData struct {
 	// Config is a function which returns the Terraform Configuration which should be used for this step
 	Config func(data TestData) string
@@ -112,7 +164,12 @@ func (td TestData) ImportStep(ignore ...string) resource.TestStep {
 
 // ImportStepFor returns a Test Step which Imports a given resource by name,
 // optionally ignoring any fields which may not be imported (for example, as they're
-// not returned from the API)
+// not returned from the API).
+//
+// Fields marked as Write-Only within the schema (see `pluginsdk.WriteOnly`) are ignored
+// automatically - and the step verifies that the imported Resource ID can be parsed by the
+// resource's ID parser, and that none of the Write-Only fields are returned by the Read function.
+// Ignored fields which are returned by the Read function are logged as a warning.
 func (td TestData) ImportStepFor(resourceName string, ignore ...string) resource.TestStep {
 	if strings.HasPrefix(resourceName, "data.") {
 		return resource.TestStep{
@@ -123,17 +180,15 @@ func (td TestData) ImportStepFor(resourceName string, ignore ...string) resource
 		}
 	}
 
-	step := resource.TestStep{
+	// the imported state is verified by the ImportStateCheck rather than `ImportStateVerify`, since
+	// `ImportStateVerifyIgnore` can only ignore a Write-Only field within a Set/List by ignoring the entire block
+	verification := newImportVerification(resourceName, resourceForType(resourceTypeFromName(resourceName)), ignore)
+	return resource.TestStep{
 		ResourceName:      resourceName,
 		ImportState:       true,
-		ImportStateVerify: true,
-	}
-
-	if len(ignore) > 0 {
-		step.ImportStateVerifyIgnore = ignore
+		ImportStateIdFunc: verification.importId,
+		ImportStateCheck:  verification.check,
 	}
-
-	return step
 }
 
 // RequiresImportErrorStep returns a Test Step which expects a Requires Import
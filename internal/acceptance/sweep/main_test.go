@@ -0,0 +1,12 @@
+package sweep
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestMain runs the Sweepers when `-sweep` is specified, otherwise the tests within this package
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
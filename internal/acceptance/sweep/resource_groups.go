@@ -0,0 +1,84 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// resourceGroupPrefixes are the prefixes used for the names of the Resource Groups created by the tests
+// (e.g. `acctestRG-{RandomInteger}`)
+var resourceGroupPrefixes = []string{
+	"acctest",
+	"amtestRG",
+	"testacc",
+}
+
+// sweepResourceGroups deletes the Resource Groups created by the tests within the specified Region, which
+// deletes the Resources within them.
+//
+// Since Resource Groups don't expose the time they were created, their age is determined from the random
+// integer within their name - Resource Groups without one are never swept.
+func sweepResourceGroups(ctx context.Context, client *clients.Client, region string, age time.Duration) error {
+	groupsClient := client.Resource.GroupsClient
+	groups, err := groupsClient.ListComplete(ctx, "", nil)
+	if err != nil {
+		return fmt.Errorf("listing Resource Groups: %+v", err)
+	}
+
+	futures := make(map[string]resources.GroupsDeleteFuture)
+	for groups.NotDone() {
+		group := groups.Value()
+		if err := groups.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing Resource Groups: %+v", err)
+		}
+
+		if group.Name == nil || !shouldSweepResourceGroup(group, region, age) {
+			continue
+		}
+
+		name := *group.Name
+		log.Printf("[DEBUG] Deleting Resource Group %q in %q..", name, region)
+		future, err := groupsClient.Delete(ctx, name, "")
+		if err != nil {
+			log.Printf("[WARN] Deleting Resource Group %q: %+v", name, err)
+			continue
+		}
+		futures[name] = future
+	}
+
+	// Resource Groups are deleted in parallel, since this can take some time
+	var result *multierror.Error
+	for name, future := range futures {
+		if err := future.WaitForCompletionRef(ctx, groupsClient.Client); err != nil {
+			result = multierror.Append(result, fmt.Errorf("waiting for the deletion of Resource Group %q: %+v", name, err))
+			continue
+		}
+		log.Printf("[DEBUG] Deleted Resource Group %q.", name)
+	}
+
+	return result.ErrorOrNil()
+}
+
+func shouldSweepResourceGroup(group resources.Group, region string, age time.Duration) bool {
+	if group.Name == nil || !hasTestPrefix(*group.Name, resourceGroupPrefixes) || !isInRegion(group.Location, region) {
+		return false
+	}
+
+	// Resource Groups managed by another Resource (e.g. the Node Resource Group for a Kubernetes Cluster) are
+	// deleted alongside that Resource, and those already being deleted don't need to be deleted again
+	if group.ManagedBy != nil && *group.ManagedBy != "" {
+		return false
+	}
+	if group.Properties != nil && group.Properties.ProvisioningState != nil && strings.EqualFold(*group.Properties.ProvisioningState, "Deleting") {
+		return false
+	}
+
+	return isOlderThan(createdTimeFromName(*group.Name), age)
+}
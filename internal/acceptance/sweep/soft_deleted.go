@@ -0,0 +1,177 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/dates"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/appconfiguration/2022-05-01/deletedconfigurationstores"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2022-10-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+var (
+	apiManagementPrefixes    = []string{"acctest"}
+	appConfigurationPrefixes = []string{"acctest", "testacc"}
+	cognitiveAccountPrefixes = []string{"acctest"}
+	keyVaultPrefixes         = []string{"acctest", "acckv", "acctkv", "testacc"}
+)
+
+func purgeDeletedApiManagementServices(ctx context.Context, client *clients.Client, region string, age time.Duration) error {
+	deletedClient := client.ApiManagement.DeletedServicesClient
+	services, err := deletedClient.ListBySubscriptionComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("listing soft-deleted API Management Services: %+v", err)
+	}
+
+	var result *multierror.Error
+	for services.NotDone() {
+		service := services.Value()
+		if err := services.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing soft-deleted API Management Services: %+v", err)
+		}
+
+		if service.Name == nil || !hasTestPrefix(*service.Name, apiManagementPrefixes) || !isInRegion(service.Location, region) {
+			continue
+		}
+		var deletionDate *time.Time
+		if props := service.DeletedServiceContractProperties; props != nil && props.DeletionDate != nil {
+			deletionDate = &props.DeletionDate.Time
+		}
+		if !shouldPurge(*service.Name, deletionDate, age) {
+			continue
+		}
+
+		name := *service.Name
+		log.Printf("[DEBUG] Purging soft-deleted API Management Service %q in %q..", name, region)
+		future, err := deletedClient.Purge(ctx, name, location.Normalize(*service.Location))
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("purging soft-deleted API Management Service %q: %+v", name, err))
+			continue
+		}
+		if err := future.WaitForCompletionRef(ctx, deletedClient.Client); err != nil {
+			result = multierror.Append(result, fmt.Errorf("waiting for the purge of soft-deleted API Management Service %q: %+v", name, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func purgeDeletedAppConfigurations(ctx context.Context, client *clients.Client, region string, age time.Duration) error {
+	deletedClient := client.AppConfiguration.DeletedConfigurationStoresClient
+	subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
+	stores, err := deletedClient.ConfigurationStoresListDeletedComplete(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("listing soft-deleted App Configurations: %+v", err)
+	}
+
+	var result *multierror.Error
+	for _, store := range stores.Items {
+		if store.Name == nil || store.Properties == nil || !hasTestPrefix(*store.Name, appConfigurationPrefixes) || !isInRegion(store.Properties.Location, region) {
+			continue
+		}
+
+		// items with Purge Protection enabled can't be purged, and are removed once the retention period has passed
+		if store.Properties.PurgeProtectionEnabled != nil && *store.Properties.PurgeProtectionEnabled {
+			continue
+		}
+		deletionDate, _ := store.Properties.GetDeletionDateAsTime()
+		if !shouldPurge(*store.Name, deletionDate, age) {
+			continue
+		}
+
+		id := deletedconfigurationstores.NewDeletedConfigurationStoreID(subscriptionId.SubscriptionId, location.Normalize(*store.Properties.Location), *store.Name)
+		log.Printf("[DEBUG] Purging %s..", id)
+		if err := deletedClient.ConfigurationStoresPurgeDeletedThenPoll(ctx, id); err != nil {
+			result = multierror.Append(result, fmt.Errorf("purging %s: %+v", id, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func purgeDeletedCognitiveAccounts(ctx context.Context, client *clients.Client, region string, age time.Duration) error {
+	accountsClient := client.Cognitive.AccountsClient
+	accounts, err := accountsClient.DeletedAccountsListComplete(ctx, commonids.NewSubscriptionID(client.Account.SubscriptionId))
+	if err != nil {
+		return fmt.Errorf("listing soft-deleted Cognitive Accounts: %+v", err)
+	}
+
+	var result *multierror.Error
+	for _, account := range accounts.Items {
+		if account.Id == nil || account.Name == nil || !hasTestPrefix(*account.Name, cognitiveAccountPrefixes) || !isInRegion(account.Location, region) {
+			continue
+		}
+
+		var deletionDate *time.Time
+		if account.Properties != nil {
+			deletionDate, _ = dates.ParseAsFormat(account.Properties.DeletionDate, time.RFC3339)
+		}
+		if !shouldPurge(*account.Name, deletionDate, age) {
+			continue
+		}
+
+		id, err := cognitiveservicesaccounts.ParseDeletedAccountIDInsensitively(*account.Id)
+		if err != nil {
+			result = multierror.Append(result, err)
+			continue
+		}
+
+		log.Printf("[DEBUG] Purging %s..", id)
+		if err := accountsClient.DeletedAccountsPurgeThenPoll(ctx, *id); err != nil {
+			result = multierror.Append(result, fmt.Errorf("purging %s: %+v", id, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func purgeDeletedKeyVaults(ctx context.Context, client *clients.Client, region string, age time.Duration) error {
+	vaultsClient := client.KeyVault.VaultsClient
+	vaults, err := vaultsClient.ListDeletedComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("listing soft-deleted Key Vaults: %+v", err)
+	}
+
+	var result *multierror.Error
+	for vaults.NotDone() {
+		vault := vaults.Value()
+		if err := vaults.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("listing soft-deleted Key Vaults: %+v", err)
+		}
+
+		if vault.Name == nil || vault.Properties == nil || !hasTestPrefix(*vault.Name, keyVaultPrefixes) || !isInRegion(vault.Properties.Location, region) {
+			continue
+		}
+
+		// items with Purge Protection enabled can't be purged, and are removed once the retention period has passed
+		if vault.Properties.PurgeProtectionEnabled != nil && *vault.Properties.PurgeProtectionEnabled {
+			continue
+		}
+		var deletionDate *time.Time
+		if vault.Properties.DeletionDate != nil {
+			deletionDate = &vault.Properties.DeletionDate.Time
+		}
+		if !shouldPurge(*vault.Name, deletionDate, age) {
+			continue
+		}
+
+		name := *vault.Name
+		log.Printf("[DEBUG] Purging soft-deleted Key Vault %q in %q..", name, region)
+		future, err := vaultsClient.PurgeDeleted(ctx, name, location.Normalize(*vault.Properties.Location))
+		if err != nil {
+			result = multierror.Append(result, fmt.Errorf("purging soft-deleted Key Vault %q: %+v", name, err))
+			continue
+		}
+		if err := future.WaitForCompletionRef(ctx, vaultsClient.Client); err != nil {
+			result = multierror.Append(result, fmt.Errorf("waiting for the purge of soft-deleted Key Vault %q: %+v", name, err))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
@@ -0,0 +1,158 @@
+// Package sweep contains the Sweepers used to remove resources leaked by failed (or cancelled) runs of the
+// Acceptance Tests, which can be run for one or more Azure Regions via:
+//
+//	go test ./internal/acceptance/sweep -v -sweep=westeurope,eastus2
+//
+// Resource Groups are swept first (which deletes the Resources within them), followed by any soft-deleted
+// items which remain once the Resource Group has been deleted (such as Key Vaults) - which are otherwise
+// retained until their retention period has passed.
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+const (
+	resourceGroupsSweeperName = "azurerm_resource_group"
+
+	// defaultMinimumAge is the default age at which leaked resources are swept - which is intentionally longer
+	// than any single test run, so that resources being used by tests which are in-progress aren't swept.
+	defaultMinimumAge = 24 * time.Hour
+
+	// sweeperTimeout is the maximum time taken by each Sweeper within a single Region
+	sweeperTimeout = 3 * time.Hour
+)
+
+func init() {
+	resource.AddTestSweepers(resourceGroupsSweeperName, &resource.Sweeper{
+		Name: resourceGroupsSweeperName,
+		F:    sweeper(sweepResourceGroups),
+	})
+
+	// soft-deleted items are only purged once the Resource Groups containing them have been deleted
+	resource.AddTestSweepers("azurerm_api_management_soft_deleted", &resource.Sweeper{
+		Name:         "azurerm_api_management_soft_deleted",
+		Dependencies: []string{resourceGroupsSweeperName},
+		F:            sweeper(purgeDeletedApiManagementServices),
+	})
+	resource.AddTestSweepers("azurerm_app_configuration_soft_deleted", &resource.Sweeper{
+		Name:         "azurerm_app_configuration_soft_deleted",
+		Dependencies: []string{resourceGroupsSweeperName},
+		F:            sweeper(purgeDeletedAppConfigurations),
+	})
+	resource.AddTestSweepers("azurerm_cognitive_account_soft_deleted", &resource.Sweeper{
+		Name:         "azurerm_cognitive_account_soft_deleted",
+		Dependencies: []string{resourceGroupsSweeperName},
+		F:            sweeper(purgeDeletedCognitiveAccounts),
+	})
+	resource.AddTestSweepers("azurerm_key_vault_soft_deleted", &resource.Sweeper{
+		Name:         "azurerm_key_vault_soft_deleted",
+		Dependencies: []string{resourceGroupsSweeperName},
+		F:            sweeper(purgeDeletedKeyVaults),
+	})
+}
+
+// sweeperFunc sweeps the leaked resources of a given type within the Region, which are at least the specified age
+type sweeperFunc func(ctx context.Context, client *clients.Client, region string, age time.Duration) error
+
+// sweeper returns a SweeperFunc which calls the sweeperFunc using the test client
+func sweeper(f sweeperFunc) resource.SweeperFunc {
+	return func(region string) error {
+		client, err := testclient.Build()
+		if err != nil {
+			return fmt.Errorf("building client: %+v", err)
+		}
+		age, err := minimumAge()
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), sweeperTimeout)
+		defer cancel()
+
+		return f(ctx, client, region, age)
+	}
+}
+
+// minimumAge returns the age at which leaked resources should be swept, which can be overridden using
+// the Environment Variable `ARM_TEST_SWEEP_MIN_AGE` (e.g. `6h`)
+func minimumAge() (time.Duration, error) {
+	v := os.Getenv("ARM_TEST_SWEEP_MIN_AGE")
+	if v == "" {
+		return defaultMinimumAge, nil
+	}
+
+	age, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("parsing `ARM_TEST_SWEEP_MIN_AGE` %q: %+v", v, err)
+	}
+
+	return age, nil
+}
+
+// hasTestPrefix returns whether the name begins with one of the (case-insensitive) prefixes used by the tests
+func hasTestPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// randomIntegerRegex matches the random integer generated by `acceptance.RandTimeInt`, which is in the
+// format `YYMMddHHmmsshhRRRR` (where `hh` is hundredths of a second and `RRRR` is random)
+var randomIntegerRegex = regexp.MustCompile(`(\d{12})\d{6}`)
+
+// createdTimeFromName returns the time that a resource was created, based on the random integer
+// (from `acceptance.RandTimeInt`) contained within the name - or nil if the name doesn't contain one
+func createdTimeFromName(name string) *time.Time {
+	match := randomIntegerRegex.FindStringSubmatch(name)
+	if len(match) != 2 {
+		return nil
+	}
+
+	// the random integer is generated using the local time of the machine running the tests
+	created, err := time.ParseInLocation("060102150405", match[1], time.Local)
+	if err != nil {
+		return nil
+	}
+
+	return &created
+}
+
+// isOlderThan returns whether the time is at least the specified age, where unknown times are never old enough
+func isOlderThan(input *time.Time, age time.Duration) bool {
+	if input == nil {
+		return false
+	}
+
+	return time.Since(*input) >= age
+}
+
+// isInRegion returns whether the (un-normalized) location matches the Region being swept
+func isInRegion(input *string, region string) bool {
+	return location.NormalizeNilable(input) == location.Normalize(region)
+}
+
+// shouldPurge returns whether a soft-deleted item is old enough to be purged - based on the random integer
+// within the name where possible (since these are deleted alongside their Resource Group by the Sweepers),
+// otherwise on when the item was deleted
+func shouldPurge(name string, deletionDate *time.Time, age time.Duration) bool {
+	if created := createdTimeFromName(name); created != nil {
+		return isOlderThan(created, age)
+	}
+
+	return isOlderThan(deletionDate, age)
+}
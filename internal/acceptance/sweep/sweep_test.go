@@ -0,0 +1,164 @@
+package sweep
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// randomInteger returns a random integer in the format used by `acceptance.RandTimeInt` for the specified time
+func randomInteger(input time.Time) string {
+	return input.Local().Format("060102150405") + "001234"
+}
+
+func TestCreatedTimeFromName(t *testing.T) {
+	expected := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+
+	testData := map[string]*time.Time{
+		fmt.Sprintf("acctestRG-%s", randomInteger(expected)):         &expected,
+		fmt.Sprintf("acctestRG-storage-%s", randomInteger(expected)): &expected,
+		fmt.Sprintf("vault%s", randomInteger(expected)):              &expected,
+		"acctestRG-abcde":    nil,
+		"acctestRG-12345678": nil,
+	}
+
+	for name, expected := range testData {
+		actual := createdTimeFromName(name)
+		if expected == nil {
+			if actual != nil {
+				t.Fatalf("expected no time for %q but got %s", name, actual)
+			}
+			continue
+		}
+
+		if actual == nil || !actual.Equal(*expected) {
+			t.Fatalf("expected %s for %q but got %v", expected, name, actual)
+		}
+	}
+}
+
+func TestShouldSweepResourceGroup(t *testing.T) {
+	old := randomInteger(time.Now().Add(-48 * time.Hour))
+	recent := randomInteger(time.Now().Add(-1 * time.Hour))
+
+	testData := []struct {
+		name     string
+		group    resources.Group
+		expected bool
+	}{
+		{
+			name: "old test resource group",
+			group: resources.Group{
+				Name:     utils.String("acctestRG-" + old),
+				Location: utils.String("westeurope"),
+			},
+			expected: true,
+		},
+		{
+			name: "old test resource group in a different region",
+			group: resources.Group{
+				Name:     utils.String("acctestRG-" + old),
+				Location: utils.String("eastus2"),
+			},
+			expected: false,
+		},
+		{
+			name: "recent test resource group",
+			group: resources.Group{
+				Name:     utils.String("acctestRG-" + recent),
+				Location: utils.String("westeurope"),
+			},
+			expected: false,
+		},
+		{
+			name: "test resource group without a random integer",
+			group: resources.Group{
+				Name:     utils.String("acctestRG-abcde"),
+				Location: utils.String("westeurope"),
+			},
+			expected: false,
+		},
+		{
+			name: "resource group not created by the tests",
+			group: resources.Group{
+				Name:     utils.String("production-" + old),
+				Location: utils.String("westeurope"),
+			},
+			expected: false,
+		},
+		{
+			name: "managed resource group",
+			group: resources.Group{
+				Name:      utils.String("acctestRG-nodes-" + old),
+				Location:  utils.String("westeurope"),
+				ManagedBy: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctestRG-" + old),
+			},
+			expected: false,
+		},
+		{
+			name: "resource group being deleted",
+			group: resources.Group{
+				Name:     utils.String("acctestRG-" + old),
+				Location: utils.String("westeurope"),
+				Properties: &resources.GroupProperties{
+					ProvisioningState: utils.String("Deleting"),
+				},
+			},
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		if actual := shouldSweepResourceGroup(v.group, "West Europe", 24*time.Hour); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestShouldPurge(t *testing.T) {
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now().Add(-1 * time.Hour)
+
+	// the time within the name is used when available, since items are soft-deleted by the Resource Group Sweeper
+	if !shouldPurge("acctestkv"+randomInteger(old), &recent, 24*time.Hour) {
+		t.Fatalf("expected an item created long ago to be purged")
+	}
+	if shouldPurge("acctestkv"+randomInteger(recent), &old, 24*time.Hour) {
+		t.Fatalf("expected a recently created item not to be purged")
+	}
+
+	// otherwise the deletion date is used
+	if !shouldPurge("acctestkv-abcde", &old, 24*time.Hour) {
+		t.Fatalf("expected an item deleted long ago to be purged")
+	}
+	if shouldPurge("acctestkv-abcde", &recent, 24*time.Hour) {
+		t.Fatalf("expected a recently deleted item not to be purged")
+	}
+	if shouldPurge("acctestkv-abcde", nil, 24*time.Hour) {
+		t.Fatalf("expected an item with an unknown age not to be purged")
+	}
+}
+
+func TestKeyVaultPrefixes(t *testing.T) {
+	testData := map[string]bool{
+		"acctestkv-abcde":  true,
+		"acckv12345":       true,
+		"acctkv12345":      true,
+		"testacc12345":     true,
+		"vault12345":       false,
+		"vault-production": false,
+	}
+
+	for name, expected := range testData {
+		t.Logf("[DEBUG] Testing %q..", name)
+
+		if actual := hasTestPrefix(name, keyVaultPrefixes); actual != expected {
+			t.Fatalf("expected %t for %q but got %t", expected, name, actual)
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package acceptance
 
 import (
+	"context"
+	"crypto/x509"
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
@@ -105,32 +108,55 @@ func RunTestsInSequence(t *testing.T, tests map[string]map[string]func(t *testin
 
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProviderFactories = td.providers()
+	testCase.ProtoV5ProviderFactories = td.providers()
+
+	// only a single Cassette can be active at once, so tests can't be run in parallel when recording/replaying
+	if td.cassette != nil {
+		resource.Test(t, testCase)
+		return
+	}
 
 	resource.ParallelTest(t, testCase)
 }
 
 func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.TestCase) {
 	testCase.ExternalProviders = td.externalProviders()
-	testCase.ProviderFactories = td.providers()
+	testCase.ProtoV5ProviderFactories = td.providers()
 
 	resource.Test(t, testCase)
 }
 
-func (td TestData) providers() map[string]func() (*schema.Provider, error) {
-	return map[string]func() (*schema.Provider, error){
-		"azurerm": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
-			return azurerm, nil
-		},
-		"azurerm-alt": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
-			return azurerm, nil
-		},
+func (td TestData) providers() map[string]func() (tfprotov5.ProviderServer, error) {
+	factory := func() (tfprotov5.ProviderServer, error) {
+		// the Provider trusts the self-signed certificate used by the fake Resource Manager server (when enabled)
+		var rootCertificates *x509.CertPool
+		if server := fakearm.Active(); server != nil {
+			pool, err := server.RootCertificates()
+			if err != nil {
+				return nil, err
+			}
+			rootCertificates = pool
+		}
+
+		providerServer, err := provider.TestProtoV5ProviderServerFactory(context.Background(), rootCertificates)
+		if err != nil {
+			return nil, err
+		}
+		return providerServer(), nil
+	}
+
+	return map[string]func() (tfprotov5.ProviderServer, error){
+		"azurerm":     factory,
+		"azurerm-alt": factory,
 	}
 }
 
 func (td TestData) externalProviders() map[string]resource.ExternalProvider {
+	// the AzureAD Provider can't be pointed at the fake Resource Manager server
+	if FakeServerEnabled() {
+		return nil
+	}
+
 	return map[string]resource.ExternalProvider{
 		"azuread": {
 			VersionConstraint: "=2.8.0",
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -36,6 +37,21 @@ func Build() (*clients.Client, error) {
 			envName = "public"
 		}
 
+		clientId := os.Getenv("ARM_CLIENT_ID")
+		clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+		subscriptionId := os.Getenv("ARM_SUBSCRIPTION_ID")
+		tenantId := os.Getenv("ARM_TENANT_ID")
+
+		// when the fake Resource Manager server is running, the test client points at it rather than Azure
+		if server := fakearm.Active(); server != nil {
+			metadataHost = server.MetadataHost()
+			envName = fakearm.EnvironmentName
+			clientId = fakearm.ClientId
+			clientSecret = fakearm.ClientSecret
+			subscriptionId = fakearm.SubscriptionId
+			tenantId = fakearm.TenantId
+		}
+
 		if metadataHost != "" {
 			if env, err = environments.FromEndpoint(ctx, fmt.Sprintf("https://%s", metadataHost), envName); err != nil {
 				return nil, fmt.Errorf("building test client: %+v", err)
@@ -46,12 +62,12 @@ func Build() (*clients.Client, error) {
 
 		authConfig := auth.Credentials{
 			Environment: *env,
-			ClientID:    os.Getenv("ARM_CLIENT_ID"),
-			TenantID:    os.Getenv("ARM_TENANT_ID"),
+			ClientID:    clientId,
+			TenantID:    tenantId,
 
 			ClientCertificatePath:     os.Getenv("ARM_CLIENT_CERTIFICATE_PATH"),
 			ClientCertificatePassword: os.Getenv("ARM_CLIENT_CERTIFICATE_PASSWORD"),
-			ClientSecret:              os.Getenv("ARM_CLIENT_SECRET"),
+			ClientSecret:              clientSecret,
 
 			EnableAuthenticatingUsingClientCertificate: true,
 			EnableAuthenticatingUsingClientSecret:      true,
@@ -66,8 +82,15 @@ func Build() (*clients.Client, error) {
 			SkipProviderRegistration: true,
 			TerraformVersion:         os.Getenv("TERRAFORM_CORE_VERSION"),
 			Features:                 features.Default(),
+			MetadataHost:             metadataHost,
 			StorageUseAzureAD:        false,
-			SubscriptionID:           os.Getenv("ARM_SUBSCRIPTION_ID"),
+			SubscriptionID:           subscriptionId,
+		}
+
+		if server := fakearm.Active(); server != nil {
+			if clientBuilder.RootCertificates, err = server.RootCertificates(); err != nil {
+				return nil, fmt.Errorf("building test client: %+v", err)
+			}
 		}
 
 		client, err := clients.Build(ctx, clientBuilder)
@@ -12,9 +12,15 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 func PreCheck(t *testing.T) {
+	// credentials and locations are sourced from the cassette when replaying
+	if common.RecordingModeFromEnvironment() == common.RecordingModeReplay {
+		return
+	}
+
 	variables := []string{
 		"ARM_CLIENT_ID",
 		"ARM_CLIENT_SECRET",
@@ -2,6 +2,7 @@ package clients
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"log"
 	"strings"
@@ -25,6 +26,13 @@ type ClientBuilder struct {
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 
+	// MaxRequestsPerSecond is the maximum number of requests per second sent to Resource Manager
+	// for this Subscription, where 0 is unlimited
+	MaxRequestsPerSecond int
+
+	// RootCertificates (when set) are trusted by the clients for autorest and go-azure-sdk, which is only used in testing
+	RootCertificates *x509.CertPool
+
 	CustomCorrelationRequestID string
 	MetadataHost               string
 	PartnerID                  string
@@ -58,23 +66,23 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	resourceManagerAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if _, ok := builder.AuthConfig.Environment.Synapse.ResourceIdentifier(); ok {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -83,7 +91,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if _, ok := builder.AuthConfig.Environment.Batch.ResourceIdentifier(); ok {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -93,7 +101,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(ctx, *builder.AuthConfig, api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -112,6 +120,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
+	recordAccount(account)
 
 	client := Client{
 		Account: account,
@@ -146,6 +155,8 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
 		SkipProviderReg:             builder.SkipProviderRegistration,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		Throttler:                   common.SubscriptionThrottler(account.SubscriptionId, *resourceManagerEndpoint, builder.MaxRequestsPerSecond),
+		RootCertificates:            builder.RootCertificates,
 
 		// TODO: remove when `Azure/go-autorest` is no longer used
 		AzureEnvironment:        *azureEnvironment,
@@ -155,6 +166,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
+	client.subscriptions = newSubscriptionClients(*o, builder.MaxRequestsPerSecond)
 
 	if features.EnhancedValidationEnabled() {
 		location.CacheSupportedLocations(ctx, *resourceManagerEndpoint)
@@ -17,6 +17,7 @@ import (
 	timeseriesinsights_v2020_05_15 "github.com/hashicorp/go-azure-sdk/resource-manager/timeseriesinsights/2020-05-15"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
 	advisor "github.com/hashicorp/terraform-provider-azurerm/internal/services/advisor/client"
 	analysisServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/analysisservices/client"
@@ -131,6 +132,8 @@ import (
 	vmware "github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/client"
 	voiceServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/voiceservices/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type Client struct {
@@ -142,6 +145,25 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// DefaultTags are the tags defined in the `default_tags` block within the Provider, which are merged
+	// into the tags for each Resource which supports tags
+	DefaultTags map[string]string
+
+	// IgnoredTags are the tags defined in the `ignore_tags` block within the Provider, which are removed from
+	// the tags for each Resource which supports tags
+	IgnoredTags tags.IgnoredTags
+
+	// DefaultTimeouts are the timeouts defined in the `default_timeouts` block within the Provider, which are used
+	// for each Resource Type matching the pattern where these aren't defined in the `timeouts` block for the resource
+	DefaultTimeouts timeouts.DefaultTimeouts
+
+	// ResourceProviderRegistrar registers the Resource Providers and Preview Features required by each
+	// Data Source and Resource on-demand - and is only set when the Provider is configured to do so
+	ResourceProviderRegistrar *resourceproviders.Registrar
+
+	// subscriptions builds and caches the Clients for other Subscriptions (see `ForSubscription`)
+	subscriptions *subscriptionClients
+
 	AadB2c                *aadb2c_v2021_04_01_preview.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisservices_v2017_08_01.Client
@@ -0,0 +1,129 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+)
+
+// subscriptionClients builds (and caches) the Clients for Subscriptions other than the one the Provider is
+// configured for, which are built on-demand using the same Authorizers (and as such Credentials) and options
+// as the Client for the Provider's Subscription.
+type subscriptionClients struct {
+	lock sync.Mutex
+
+	options              common.ClientOptions
+	maxRequestsPerSecond int
+
+	// clients are the Clients which have been (or are being) built, keyed by the (lower-cased) Subscription ID
+	clients map[string]*subscriptionClient
+}
+
+// subscriptionClient is the Client for a Subscription, which is built once - concurrent operations requiring
+// the same Subscription wait for this to be built rather than building it again
+type subscriptionClient struct {
+	done   chan struct{}
+	client *Client
+	err    error
+}
+
+func newSubscriptionClients(options common.ClientOptions, maxRequestsPerSecond int) *subscriptionClients {
+	return &subscriptionClients{
+		options:              options,
+		maxRequestsPerSecond: maxRequestsPerSecond,
+		clients:              make(map[string]*subscriptionClient),
+	}
+}
+
+// ForSubscription returns the Client which should be used for operations within the specified Subscription.
+//
+// When the Subscription ID is empty or matches the Subscription this Client is configured for this Client is
+// returned - otherwise a Client for the specified Subscription is built (reusing the Credentials used for this
+// Client) the first time it's requested, and then cached for the lifetime of the Provider.
+func (client *Client) ForSubscription(ctx context.Context, subscriptionId string) (*Client, error) {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, client.Account.SubscriptionId) {
+		return client, nil
+	}
+
+	if client.subscriptions == nil {
+		return nil, fmt.Errorf("building a Client for Subscription %q: this Client doesn't support targeting other Subscriptions", subscriptionId)
+	}
+
+	return client.subscriptions.forSubscription(ctx, client, subscriptionId)
+}
+
+func (s *subscriptionClients) forSubscription(ctx context.Context, parent *Client, subscriptionId string) (*Client, error) {
+	key := strings.ToLower(subscriptionId)
+
+	s.lock.Lock()
+	current, exists := s.clients[key]
+	if !exists {
+		current = &subscriptionClient{
+			done: make(chan struct{}),
+		}
+		s.clients[key] = current
+	}
+	s.lock.Unlock()
+
+	if exists {
+		select {
+		case <-current.done:
+			return current.client, current.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	// the Client is built outside of the lock, so that Clients for other Subscriptions can be built (or returned) meanwhile
+	current.client, current.err = s.build(ctx, parent, subscriptionId)
+	if current.err != nil {
+		// a Client which couldn't be built is removed, so that this is retried by the next operation
+		s.lock.Lock()
+		delete(s.clients, key)
+		s.lock.Unlock()
+	}
+	close(current.done)
+
+	return current.client, current.err
+}
+
+func (s *subscriptionClients) build(ctx context.Context, parent *Client, subscriptionId string) (*Client, error) {
+	log.Printf("[DEBUG] Building a Client for Subscription %q..", subscriptionId)
+
+	account := *parent.Account
+	account.SubscriptionId = subscriptionId
+
+	o := s.options
+	o.SubscriptionId = subscriptionId
+	o.Throttler = common.SubscriptionThrottler(subscriptionId, o.ResourceManagerEndpoint, s.maxRequestsPerSecond)
+
+	client := Client{
+		Account:         &account,
+		DefaultTags:     parent.DefaultTags,
+		DefaultTimeouts: parent.DefaultTimeouts,
+		IgnoredTags:     parent.IgnoredTags,
+		subscriptions:   s,
+	}
+
+	// the StopContext is used for propagating control from Terraform Core, rather than this operation
+	stopContext := parent.StopContext
+	if stopContext == nil {
+		stopContext = ctx
+	}
+	if err := client.Build(stopContext, &o); err != nil {
+		return nil, fmt.Errorf("building Client for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	// the Resource Providers and Preview Features are registered within each Subscription on-demand,
+	// when the Provider is configured to do so
+	if parent.ResourceProviderRegistrar != nil {
+		client.ResourceProviderRegistrar = resourceproviders.NewRegistrar(client.Resource.ProvidersClient, client.Resource.FeaturesClient)
+	}
+
+	return &client, nil
+}
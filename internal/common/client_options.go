@@ -1,13 +1,13 @@
 package common
 
 import (
+	"crypto/x509"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
@@ -47,6 +47,16 @@ type ClientOptions struct {
 	SkipProviderReg           bool
 	StorageUseAzureAD         bool
 
+	// Throttler is shared by all of the clients for this Subscription, to rate limit requests to Resource Manager
+	Throttler *Throttler
+
+	// RootCertificates (when set) are the certificates trusted for requests made using both autorest and go-azure-sdk -
+	// this is only used in testing, for example to trust the self-signed certificate used by the fake Resource Manager server.
+	//
+	// NOTE: these aren't used for the requests made to authenticate or to discover the Azure Environment, since
+	// the HTTP transports used for these can't be configured.
+	RootCertificates *x509.CertPool
+
 	// Keep these around for convenience with Autorest based clients, remove when we are no longer using autorest
 	AzureEnvironment        azure.Environment
 	ResourceManagerEndpoint string
@@ -65,7 +75,13 @@ func (o ClientOptions) Configure(c *resourcemanager.Client, authorizer auth.Auth
 	c.Authorizer = authorizer
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
-	requestMiddlewares := make([]client.RequestMiddleware, 0)
+	// the Span for each request includes any time spent waiting on the Throttler
+	requestMiddlewares := []client.RequestMiddleware{
+		tracingRequestMiddleware(),
+	}
+	if o.Throttler != nil {
+		requestMiddlewares = append(requestMiddlewares, throttlingRequestMiddleware(o.Throttler))
+	}
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
 		if id == "" {
@@ -74,11 +90,21 @@ func (o ClientOptions) Configure(c *resourcemanager.Client, authorizer auth.Auth
 		requestMiddlewares = append(requestMiddlewares, correlationRequestIDMiddleware(id))
 	}
 	requestMiddlewares = append(requestMiddlewares, requestLoggerMiddleware("AzureRM"))
+	// the recording middleware must run after the others, since it may re-route the request when replaying
+	requestMiddlewares = append(requestMiddlewares, recordingRequestMiddleware())
+	// go-azure-sdk doesn't allow the HTTP transport to be configured, so the request is re-routed via a local
+	// server to trust the Root Certificates - which must happen last, so that the original URL is logged/recorded
+	if o.RootCertificates != nil {
+		requestMiddlewares = append(requestMiddlewares, rootCertificatesRequestMiddleware(o.RootCertificates))
+	}
 	c.RequestMiddlewares = &requestMiddlewares
 
-	c.ResponseMiddlewares = &[]client.ResponseMiddleware{
-		responseLoggerMiddleware("AzureRM"),
+	responseMiddlewares := make([]client.ResponseMiddleware, 0)
+	if o.Throttler != nil {
+		responseMiddlewares = append(responseMiddlewares, throttlingResponseMiddleware(o.Throttler))
 	}
+	responseMiddlewares = append(responseMiddlewares, operationResponseMiddleware(), recordingResponseMiddleware(), responseLoggerMiddleware("AzureRM"), tracingResponseMiddleware())
+	c.ResponseMiddlewares = &responseMiddlewares
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -86,7 +112,7 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = tracingSender(operationSender(recordingSender(throttlingSender(o.Throttler, buildSender("AzureRM", o.RootCertificates)))))
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -2,6 +2,7 @@ package common
 
 import (
 	"log"
+	"net/http"
 	"sync"
 
 	"github.com/Azure/go-autorest/autorest"
@@ -20,8 +21,21 @@ var (
 
 // withCorrelationRequestID returns a PrepareDecorator that adds an HTTP extension header of
 // `x-ms-correlation-request-id` whose value is passed, undecorated UUID (e.g.,7F5A6223-F475-4A9C-B9D5-12575AA6B11B`).
+//
+// When the request's Context contains an Operation, the Correlation Request ID for that Operation is used instead.
 func withCorrelationRequestID(uuid string) autorest.PrepareDecorator {
-	return autorest.WithHeader(HeaderCorrelationRequestID, uuid)
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err == nil {
+				if r.Header == nil {
+					r.Header = make(http.Header)
+				}
+				r.Header.Set(HeaderCorrelationRequestID, correlationRequestIDForRequest(r, uuid))
+			}
+			return r, err
+		})
+	}
 }
 
 // correlationRequestID generates an UUID to pass through `x-ms-correlation-request-id` header.
@@ -1,56 +1,185 @@
 package common
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httputil"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 )
 
+type recordedRequestBodyKey struct{}
+
 func correlationRequestIDMiddleware(id string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
-		// ensure the `X-Correlation-ID` field is set
-		request.Header.Add(HeaderCorrelationRequestID, id)
+		// ensure the `X-Correlation-ID` field is set, using the ID for the current Operation where available
+		request.Header.Set(HeaderCorrelationRequestID, correlationRequestIDForRequest(request, id))
 		return request, nil
 	}
 }
 
 func requestLoggerMiddleware(providerName string) client.RequestMiddleware {
 	return func(request *http.Request) (*http.Request, error) {
-		// strip the authorization header prior to printing
-		authHeaderName := "Authorization"
-		auth := request.Header.Get(authHeaderName)
-		if auth != "" {
-			request.Header.Del(authHeaderName)
+		logRequest(providerName, request)
+		return request, nil
+	}
+}
+
+func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		logResponse(providerName, request, response)
+		return response, nil
+	}
+}
+
+// buildSender returns the autorest.Sender used for requests made using autorest, which logs
+// each request and response - trusting the specified Root Certificates when these are set
+func buildSender(providerName string, rootCertificates *x509.CertPool) autorest.Sender {
+	return autorest.DecorateSender(&http.Client{
+		Transport: buildTransport(rootCertificates),
+	}, withRequestLogging(providerName))
+}
+
+// buildTransport returns an HTTP transport which trusts the specified Root Certificates when these are set
+func buildTransport(rootCertificates *x509.CertPool) *http.Transport {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+	}
+	if rootCertificates != nil {
+		transport.TLSClientConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    rootCertificates,
 		}
+	}
+	return transport
+}
+
+func withRequestLogging(providerName string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+			logRequest(providerName, request)
+
+			response, err := s.Do(request)
+			if response != nil {
+				logResponse(providerName, request, response)
+			} else if err != nil {
+				log.Printf("[DEBUG] %s Response Error: %s for %s\n", providerName, err, redactURL(request.URL))
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", redactURL(request.URL))
+			}
+			return response, err
+		})
+	}
+}
+
+// logRequest logs the request in wire format, with any sensitive values redacted
+func logRequest(providerName string, request *http.Request) {
+	if dump, err := httputil.DumpRequestOut(request, true); err == nil {
+		log.Printf("[DEBUG] %s Request: \n%s\n", providerName, activeRedactionRules().Redact(request.URL, dump, sensitiveValuesForRequest(request)))
+	} else {
+		// fallback to basic message
+		log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, redactURL(request.URL))
+	}
+}
 
-		// dump request to wire format
-		if dump, err := httputil.DumpRequestOut(request, true); err == nil {
-			log.Printf("[DEBUG] %s Request: \n%s\n", providerName, dump)
-		} else {
-			// fallback to basic message
-			log.Printf("[DEBUG] %s Request: %s to %s\n", providerName, request.Method, request.URL)
+// logResponse logs the response in wire format, with any sensitive values redacted
+func logResponse(providerName string, request *http.Request, response *http.Response) {
+	if dump, err := httputil.DumpResponse(response, true); err == nil {
+		log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, redactURL(request.URL), activeRedactionRules().Redact(request.URL, dump, sensitiveValuesForRequest(request)))
+	} else {
+		// fallback to basic message
+		log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, redactURL(request.URL))
+	}
+}
+
+// recordingRequestMiddleware captures the request body when recording - and when replaying
+// routes the request to the replay server for the active Cassette
+func recordingRequestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		cassette := ActiveCassette()
+		if cassette == nil {
+			return request, nil
 		}
 
-		// add the auth header back
-		if auth != "" {
-			request.Header.Add(authHeaderName, auth)
+		switch cassette.Mode() {
+		case RecordingModeRecord:
+			if request.Body == nil {
+				return request, nil
+			}
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return nil, fmt.Errorf("reading request body for recording: %+v", err)
+			}
+			request.Body = io.NopCloser(bytes.NewBuffer(body))
+			return request.WithContext(context.WithValue(request.Context(), recordedRequestBodyKey{}, body)), nil
+
+		case RecordingModeReplay:
+			request.Header.Set(headerRecordingOriginalHost, fmt.Sprintf("%s://%s", request.URL.Scheme, request.URL.Host))
+			replayUrl := *request.URL
+			replayUrl.Scheme = "http"
+			replayUrl.Host = cassette.server.Listener.Addr().String()
+			request.URL = &replayUrl
+			request.Host = replayUrl.Host
 		}
 
 		return request, nil
 	}
 }
 
-func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
+// recordingResponseMiddleware appends the request and response to the active Cassette when recording
+func recordingResponseMiddleware() client.ResponseMiddleware {
 	return func(request *http.Request, response *http.Response) (*http.Response, error) {
-		// dump response to wire format
-		if dump, err2 := httputil.DumpResponse(response, true); err2 == nil {
-			log.Printf("[DEBUG] %s Response for %s: \n%s\n", providerName, request.URL, dump)
-		} else {
-			// fallback to basic message
-			log.Printf("[DEBUG] %s Response: %s for %s\n", providerName, response.Status, request.URL)
+		cassette := ActiveCassette()
+		if cassette == nil || cassette.Mode() != RecordingModeRecord {
+			return response, nil
 		}
+
+		body, _ := request.Context().Value(recordedRequestBodyKey{}).([]byte)
+		if err := cassette.record(request, body, response); err != nil {
+			return nil, fmt.Errorf("recording response: %+v", err)
+		}
+
 		return response, nil
 	}
 }
+
+// recordingSender wraps an autorest.Sender so that requests are recorded to, or replayed from, the active Cassette
+func recordingSender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		cassette := ActiveCassette()
+		if cassette == nil {
+			return sender.Do(request)
+		}
+
+		if cassette.Mode() == RecordingModeReplay {
+			return cassette.replayResponse(request)
+		}
+
+		var body []byte
+		if request.Body != nil {
+			var err error
+			if body, err = io.ReadAll(request.Body); err != nil {
+				return nil, fmt.Errorf("reading request body for recording: %+v", err)
+			}
+			request.Body = io.NopCloser(bytes.NewBuffer(body))
+		}
+
+		response, err := sender.Do(request)
+		if err != nil || response == nil {
+			return response, err
+		}
+
+		if err := cassette.record(request, body, response); err != nil {
+			return nil, fmt.Errorf("recording response: %+v", err)
+		}
+
+		return response, nil
+	})
+}
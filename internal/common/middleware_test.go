@@ -0,0 +1,32 @@
+package common
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBuildSenderTrustsRootCertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// the self-signed certificate used by the server isn't trusted by default
+	request, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := buildSender("AzureRM", nil).Do(request); err == nil {
+		t.Fatalf("expected an error when the certificate isn't trusted")
+	}
+
+	rootCertificates := x509.NewCertPool()
+	rootCertificates.AddCert(server.Certificate())
+	request, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	response, err := buildSender("AzureRM", rootCertificates).Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", response.StatusCode)
+	}
+}
@@ -0,0 +1,295 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// HeaderRequestID is the header returned by Resource Manager containing the unique ID for the request
+const HeaderRequestID = "x-ms-request-id"
+
+type operationContextKey struct{}
+
+// Operation tracks the requests made to Azure during a single Data Source/Resource operation (for example
+// creating a Resource), such that the Correlation Request ID, Request ID and Error Code can be surfaced
+// alongside any error returned to Terraform.
+type Operation struct {
+	// suffix is unique to this Operation, and is used to derive its Correlation Request ID
+	suffix string
+
+	lock                 sync.Mutex
+	correlationRequestID string
+
+	// failedRequests are the requests which failed during this Operation, in the order these were made
+	failedRequests []failedRequest
+
+	// sensitiveValues are redacted from the requests and responses logged during this Operation
+	sensitiveValues []string
+}
+
+// failedRequest is a request made during an Operation which returned an error
+type failedRequest struct {
+	// requestID is the unique ID for this request returned by Resource Manager, if any
+	requestID string
+
+	// resourceManagerError is the error returned for this request, where this could be decoded
+	resourceManagerError *azure.ResourceManagerError
+}
+
+// WithOperation returns a copy of the Context containing a new Operation - unless the Context
+// already contains an Operation, in which case the existing Operation is returned
+func WithOperation(ctx context.Context) (context.Context, *Operation) {
+	if existing := OperationFromContext(ctx); existing != nil {
+		return ctx, existing
+	}
+
+	suffix, err := uuid.GenerateUUID()
+	if err != nil {
+		log.Printf("[WARN] Failed to generate uuid for the Operation: %+v", err)
+	}
+
+	operation := &Operation{
+		suffix: strings.ReplaceAll(suffix, "-", ""),
+	}
+	return context.WithValue(ctx, operationContextKey{}, operation), operation
+}
+
+// OperationFromContext returns the Operation within the Context, if any
+func OperationFromContext(ctx context.Context) *Operation {
+	if ctx == nil {
+		return nil
+	}
+
+	operation, _ := ctx.Value(operationContextKey{}).(*Operation)
+	return operation
+}
+
+// Details returns the Correlation Request ID for this Operation, alongside the Request ID and Error Code for
+// the most recent failed request made during this Operation (where known) - which are intended to be appended
+// to an error returned to Terraform
+func (o *Operation) Details() string {
+	if o == nil {
+		return ""
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	var failed *failedRequest
+	if len(o.failedRequests) > 0 {
+		failed = &o.failedRequests[len(o.failedRequests)-1]
+	}
+
+	return o.details(failed)
+}
+
+// details returns the Correlation Request ID for this Operation, alongside the Request ID and Error Code for
+// the specified failed request (if any)
+func (o *Operation) details(failed *failedRequest) string {
+	lines := make([]string, 0)
+	if o.correlationRequestID != "" {
+		lines = append(lines, fmt.Sprintf("Correlation Request ID: %s", o.correlationRequestID))
+	}
+	if failed != nil {
+		if failed.requestID != "" {
+			lines = append(lines, fmt.Sprintf("Request ID: %s", failed.requestID))
+		}
+		if failed.resourceManagerError != nil {
+			lines = append(lines, fmt.Sprintf("Error Code: %s", failed.resourceManagerError.Code))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// AppendOperationDetails appends the Details for the Operation (if any) to the specified Diagnostic Detail
+func AppendOperationDetails(detail string, operation *Operation) string {
+	return appendDetails(detail, operation.Details())
+}
+
+func appendDetails(detail, details string) string {
+	if details == "" {
+		return detail
+	}
+	if detail == "" {
+		return details
+	}
+
+	return fmt.Sprintf("%s\n\n%s", detail, details)
+}
+
+// ErrorDiagnostic returns a Diagnostic for an error returned during this Operation (see EnrichDiagnostic)
+func (o *Operation) ErrorDiagnostic(err error, resourceSchema map[string]*pluginsdk.Schema) diag.Diagnostic {
+	return o.enrichDiagnostic(diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+	}, err, resourceSchema)
+}
+
+// EnrichDiagnostic decodes the Resource Manager error which caused the error described by the Diagnostic (where
+// this was returned from Azure during this Operation) into the Detail and Attribute Path for the Diagnostic - and
+// then appends the Details for the Operation and the failed request
+func (o *Operation) EnrichDiagnostic(input diag.Diagnostic, resourceSchema map[string]*pluginsdk.Schema) diag.Diagnostic {
+	return o.enrichDiagnostic(input, nil, resourceSchema)
+}
+
+func (o *Operation) enrichDiagnostic(input diag.Diagnostic, err error, resourceSchema map[string]*pluginsdk.Schema) diag.Diagnostic {
+	if o == nil {
+		return input
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	failed := o.failedRequestFor(err, input.Summary)
+	if failed != nil && failed.resourceManagerError != nil {
+		decoded := failed.resourceManagerError.Diagnostic(input.Summary, resourceSchema)
+		if input.Detail == "" || input.Detail == input.Summary {
+			input.Detail = decoded.Detail
+		} else if decoded.Detail != "" {
+			input.Detail = fmt.Sprintf("%s\n\n%s", input.Detail, decoded.Detail)
+		}
+
+		if input.AttributePath == nil {
+			input.AttributePath = decoded.AttributePath
+		}
+	}
+
+	input.Detail = appendDetails(input.Detail, o.details(failed))
+	return input
+}
+
+// failedRequestFor returns the failed request made during this Operation which caused the error `err` (with the
+// summary `summary`) - since an Operation can make requests which are expected to fail (for example checking for
+// an existing Resource) prior to returning an unrelated error.
+//
+// Where the error contains the response (e.g. an autorest.DetailedError) this is matched using the Request ID,
+// otherwise (since go-azure-sdk doesn't expose the response within the error) the most recent failed request
+// whose Error Code and Message are both contained within the summary is used.
+func (o *Operation) failedRequestFor(err error, summary string) *failedRequest {
+	var detailedError autorest.DetailedError
+	if err != nil && errors.As(err, &detailedError) && detailedError.Response != nil {
+		if requestID := detailedError.Response.Header.Get(HeaderRequestID); requestID != "" {
+			for i := len(o.failedRequests) - 1; i >= 0; i-- {
+				if o.failedRequests[i].requestID == requestID {
+					return &o.failedRequests[i]
+				}
+			}
+		}
+	}
+
+	for i := len(o.failedRequests) - 1; i >= 0; i-- {
+		resourceManagerError := o.failedRequests[i].resourceManagerError
+		if resourceManagerError == nil || resourceManagerError.Code == "" {
+			continue
+		}
+
+		if strings.Contains(summary, resourceManagerError.Code) && strings.Contains(summary, resourceManagerError.Message) {
+			return &o.failedRequests[i]
+		}
+	}
+
+	return nil
+}
+
+// correlationRequestIDFrom returns the Correlation Request ID for this Operation, which is derived from
+// the Provider-level Correlation Request ID so that the requests for a single run of Terraform can still
+// be correlated - whilst allowing the requests for this Operation to be isolated
+func (o *Operation) correlationRequestIDFrom(base string) string {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if o.correlationRequestID == "" {
+		o.correlationRequestID = deriveCorrelationRequestID(base, o.suffix)
+	}
+
+	return o.correlationRequestID
+}
+
+// deriveCorrelationRequestID retains the first three segments of the Provider-level Correlation Request ID
+// (when it's a UUID), replacing the remainder with a value unique to the Operation
+func deriveCorrelationRequestID(base, suffix string) string {
+	if len(suffix) < 16 {
+		return base
+	}
+
+	if _, err := uuid.ParseUUID(base); err == nil {
+		return fmt.Sprintf("%s-%s-%s", base[:18], suffix[:4], suffix[4:16])
+	}
+
+	return fmt.Sprintf("%s-%s", base, suffix[:8])
+}
+
+// observe records the Request ID and the Resource Manager error for a failed request from the response
+func (o *Operation) observe(response *http.Response) {
+	if o == nil || response == nil || response.StatusCode < http.StatusBadRequest {
+		return
+	}
+
+	failed := failedRequest{
+		requestID:            response.Header.Get(HeaderRequestID),
+		resourceManagerError: resourceManagerErrorFromResponse(response),
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.failedRequests = append(o.failedRequests, failed)
+}
+
+// resourceManagerErrorFromResponse decodes the Resource Manager error from the response, leaving
+// the response body intact so that this can also be read by the caller
+func resourceManagerErrorFromResponse(response *http.Response) *azure.ResourceManagerError {
+	if response.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err != nil {
+		return nil
+	}
+
+	return azure.ParseResourceManagerError(body)
+}
+
+// correlationRequestIDForRequest returns the Correlation Request ID for the Operation within the
+// request's Context - falling back to the Provider-level Correlation Request ID
+func correlationRequestIDForRequest(request *http.Request, base string) string {
+	if operation := OperationFromContext(request.Context()); operation != nil {
+		return operation.correlationRequestIDFrom(base)
+	}
+
+	return base
+}
+
+func operationResponseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		OperationFromContext(request.Context()).observe(response)
+		return response, nil
+	}
+}
+
+// operationSender wraps an autorest.Sender so that each response is recorded against the Operation
+func operationSender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		response, err := sender.Do(request)
+		OperationFromContext(request.Context()).observe(response)
+		return response, err
+	})
+}
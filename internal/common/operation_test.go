@@ -0,0 +1,202 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestWithOperationReusesExistingOperation(t *testing.T) {
+	ctx, first := WithOperation(context.TODO())
+	ctx, second := WithOperation(ctx)
+	if first != second {
+		t.Fatalf("expected the existing Operation to be reused")
+	}
+	if OperationFromContext(ctx) != first {
+		t.Fatalf("expected the Operation to be returned from the Context")
+	}
+	if OperationFromContext(context.TODO()) != nil {
+		t.Fatalf("expected no Operation to be returned from an empty Context")
+	}
+}
+
+func TestDeriveCorrelationRequestID(t *testing.T) {
+	base := "7f5a6223-f475-4a9c-b9d5-12575aa6b11b"
+
+	_, first := WithOperation(context.TODO())
+	_, second := WithOperation(context.TODO())
+
+	firstID := first.correlationRequestIDFrom(base)
+	secondID := second.correlationRequestIDFrom(base)
+	if firstID == base || firstID == secondID {
+		t.Fatalf("expected each Operation to have a distinct Correlation Request ID but got %q and %q", firstID, secondID)
+	}
+	if !strings.HasPrefix(firstID, "7f5a6223-f475-4a9c-") || len(firstID) != len(base) {
+		t.Fatalf("expected %q to be derived from %q", firstID, base)
+	}
+	if first.correlationRequestIDFrom(base) != firstID {
+		t.Fatalf("expected the Correlation Request ID to be consistent for the Operation")
+	}
+
+	if actual := deriveCorrelationRequestID("custom", "0123456789abcdef0123456789abcdef"); actual != "custom-01234567" {
+		t.Fatalf("expected a custom Correlation Request ID to be suffixed but got %q", actual)
+	}
+}
+
+func TestWithCorrelationRequestIDUsesOperation(t *testing.T) {
+	base := "7f5a6223-f475-4a9c-b9d5-12575aa6b11b"
+	ctx, operation := WithOperation(context.TODO())
+
+	req, _ := autorest.Prepare((&http.Request{}).WithContext(ctx), withCorrelationRequestID(base))
+	actual := req.Header.Get(HeaderCorrelationRequestID)
+	if actual == base || actual != operation.correlationRequestIDFrom(base) {
+		t.Fatalf("expected the Correlation Request ID for the Operation but got %q", actual)
+	}
+}
+
+func TestOperationDetails(t *testing.T) {
+	base := "7f5a6223-f475-4a9c-b9d5-12575aa6b11b"
+	ctx, operation := WithOperation(context.TODO())
+	request, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+	correlationRequestIDForRequest(request, base)
+
+	sender := operationSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header: http.Header{
+				"X-Ms-Request-Id": []string{"11111111-1111-1111-1111-111111111111"},
+			},
+			Body: io.NopCloser(strings.NewReader(`{"error": {"code": "AuthorizationFailed", "message": "The client does not have authorization"}}`)),
+		}, nil
+	}))
+	response, err := sender.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+
+	// the body must still be readable after extracting the Error Code
+	if body, _ := io.ReadAll(response.Body); !strings.Contains(string(body), "AuthorizationFailed") {
+		t.Fatalf("expected the response body to be preserved but got %q", string(body))
+	}
+
+	details := operation.Details()
+	for _, expected := range []string{
+		"Correlation Request ID: " + operation.correlationRequestIDFrom(base),
+		"Request ID: 11111111-1111-1111-1111-111111111111",
+		"Error Code: AuthorizationFailed",
+	} {
+		if !strings.Contains(details, expected) {
+			t.Fatalf("expected the details to contain %q but got %q", expected, details)
+		}
+	}
+}
+
+func TestOperationErrorDiagnostic(t *testing.T) {
+	ctx, operation := WithOperation(context.TODO())
+	request, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+
+	responses := []*http.Response{
+		{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"error": {"code": "ResourceNotFound", "message": "The Resource was not found"}}`)),
+		},
+		{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(strings.NewReader(`{"error": {"code": "InvalidParameter", "target": "properties.skuName", "message": "The SKU is invalid"}}`)),
+		},
+	}
+	for _, response := range responses {
+		response := response
+		sender := operationSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return response, nil
+		}))
+		if _, err := sender.Do(request); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	resourceSchema := map[string]*pluginsdk.Schema{
+		"sku_name": {
+			Type: pluginsdk.TypeString,
+		},
+	}
+
+	actual := operation.ErrorDiagnostic(fmt.Errorf(`creating Example: Code="InvalidParameter" Message="The SKU is invalid"`), resourceSchema)
+	if !actual.AttributePath.Equals(cty.GetAttrPath("sku_name")) {
+		t.Fatalf("expected the Attribute Path to be `sku_name` but got %+v", actual.AttributePath)
+	}
+	if !strings.HasPrefix(actual.Detail, "The SKU is invalid") || !strings.Contains(actual.Detail, "Error Code: InvalidParameter") {
+		t.Fatalf("expected the Detail to describe the error but got %q", actual.Detail)
+	}
+
+	// the Resource Manager error is only decoded when it caused the error
+	actual = operation.ErrorDiagnostic(fmt.Errorf("parsing ID"), resourceSchema)
+	if actual.AttributePath != nil || strings.Contains(actual.Detail, "The SKU is invalid") {
+		t.Fatalf("expected the Resource Manager error not to be decoded but got %+v", actual)
+	}
+}
+
+func TestOperationErrorDiagnosticUsesTheFailedRequest(t *testing.T) {
+	ctx, operation := WithOperation(context.TODO())
+	request, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+
+	failedResponse := func(requestID string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Header: http.Header{
+				"X-Ms-Request-Id": []string{requestID},
+			},
+			Body: io.NopCloser(strings.NewReader(`{"error": {"code": "InvalidParameter", "message": "The SKU is invalid"}}`)),
+		}
+	}
+	responses := []*http.Response{
+		failedResponse("11111111-1111-1111-1111-111111111111"),
+		failedResponse("22222222-2222-2222-2222-222222222222"),
+		{
+			// subsequent successful requests mustn't replace the Request ID of the failed request
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"X-Ms-Request-Id": []string{"33333333-3333-3333-3333-333333333333"},
+			},
+			Body: io.NopCloser(strings.NewReader(`{}`)),
+		},
+	}
+	for _, response := range responses {
+		response := response
+		sender := operationSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			return response, nil
+		}))
+		if _, err := sender.Do(request); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	// the error is matched using the Code and Message, to the most recent failed request
+	actual := operation.ErrorDiagnostic(fmt.Errorf(`creating Example: Code="InvalidParameter" Message="The SKU is invalid"`), nil)
+	if !strings.Contains(actual.Detail, "Request ID: 22222222-2222-2222-2222-222222222222") {
+		t.Fatalf("expected the Request ID for the failed request but got %q", actual.Detail)
+	}
+
+	// where the error contains the response, this is matched using the Request ID
+	err := fmt.Errorf("creating Example: %w", autorest.DetailedError{
+		Original: fmt.Errorf(`Code="InvalidParameter" Message="The SKU is invalid"`),
+		Response: responses[0],
+	})
+	actual = operation.ErrorDiagnostic(err, nil)
+	if !strings.Contains(actual.Detail, "Request ID: 11111111-1111-1111-1111-111111111111") {
+		t.Fatalf("expected the Request ID for the response within the error but got %q", actual.Detail)
+	}
+
+	// an error which only mentions the same Error Code wasn't caused by these requests
+	actual = operation.ErrorDiagnostic(fmt.Errorf("validating: InvalidParameter `name` must be set"), nil)
+	if strings.Contains(actual.Detail, "Request ID") || strings.Contains(actual.Detail, "Error Code") {
+		t.Fatalf("expected the error not to be matched to a failed request but got %q", actual.Detail)
+	}
+}
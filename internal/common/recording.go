@@ -0,0 +1,344 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// RecordingMode determines whether HTTP traffic to Azure should be recorded to, or replayed from, a Cassette
+type RecordingMode string
+
+const (
+	RecordingModeDisabled RecordingMode = ""
+	RecordingModeRecord   RecordingMode = "record"
+	RecordingModeReplay   RecordingMode = "replay"
+)
+
+// headerRecordingOriginalHost is used to pass the original scheme and host through to the replay server
+const headerRecordingOriginalHost = "X-Terraform-Recording-Original-Host"
+
+// RecordingModeFromEnvironment returns the RecordingMode configured via the Environment Variable
+// `ARM_TEST_RECORDING_MODE` - which can be set to either `record` or `replay`.
+func RecordingModeFromEnvironment() RecordingMode {
+	switch v := strings.ToLower(os.Getenv("ARM_TEST_RECORDING_MODE")); v {
+	case string(RecordingModeRecord):
+		return RecordingModeRecord
+	case string(RecordingModeReplay):
+		return RecordingModeReplay
+	}
+
+	return RecordingModeDisabled
+}
+
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// Cassette contains the HTTP Interactions and Variables (such as random values) recorded for a single test
+type Cassette struct {
+	// Variables is a set of arbitrary values which must be identical between recording and replaying
+	Variables map[string]string `json:"variables"`
+
+	// Interactions is the ordered list of HTTP Interactions which have been recorded
+	Interactions []Interaction `json:"interactions"`
+
+	mode RecordingMode
+	path string
+
+	lock sync.Mutex
+	// replayed tracks the number of times each request key has been served during replay
+	replayed map[string]int
+	server   *httptest.Server
+}
+
+var (
+	activeCassette     *Cassette
+	activeCassetteLock = &sync.RWMutex{}
+)
+
+// ActiveCassette returns the Cassette which is currently being recorded/replayed, if any
+func ActiveCassette() *Cassette {
+	activeCassetteLock.RLock()
+	defer activeCassetteLock.RUnlock()
+
+	return activeCassette
+}
+
+// StartCassette loads (when replaying) or creates (when recording) the Cassette at the specified path
+// and marks it as the active Cassette, so that HTTP traffic from all clients is routed through it.
+func StartCassette(path string, mode RecordingMode) (*Cassette, error) {
+	if mode == RecordingModeDisabled {
+		return nil, fmt.Errorf("a recording mode must be specified to start a cassette")
+	}
+
+	activeCassetteLock.Lock()
+	defer activeCassetteLock.Unlock()
+
+	if activeCassette != nil {
+		return nil, fmt.Errorf("the cassette %q is already active", activeCassette.path)
+	}
+
+	cassette := &Cassette{
+		Variables:    map[string]string{},
+		Interactions: make([]Interaction, 0),
+		mode:         mode,
+		path:         path,
+		replayed:     map[string]int{},
+	}
+
+	if mode == RecordingModeReplay {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading cassette %q: %+v", path, err)
+		}
+		if err := json.Unmarshal(contents, cassette); err != nil {
+			return nil, fmt.Errorf("deserializing cassette %q: %+v", path, err)
+		}
+
+		cassette.server = httptest.NewServer(http.HandlerFunc(cassette.serveReplay))
+	}
+
+	log.Printf("[DEBUG] Started %s of cassette %q", mode, path)
+	activeCassette = cassette
+	return cassette, nil
+}
+
+// Stop deactivates the Cassette - and when recording, writes the Cassette to disk
+func (c *Cassette) Stop() error {
+	activeCassetteLock.Lock()
+	defer activeCassetteLock.Unlock()
+
+	if activeCassette == c {
+		activeCassette = nil
+	}
+
+	if c.server != nil {
+		c.server.Close()
+	}
+
+	if c.mode != RecordingModeRecord {
+		return nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing cassette %q: %+v", c.path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("creating directory for cassette %q: %+v", c.path, err)
+	}
+	if err := os.WriteFile(c.path, contents, 0o600); err != nil {
+		return fmt.Errorf("writing cassette %q: %+v", c.path, err)
+	}
+
+	return nil
+}
+
+// Mode returns the RecordingMode this Cassette was started with
+func (c *Cassette) Mode() RecordingMode {
+	return c.mode
+}
+
+// Variable returns the recorded value for the specified key, if it exists
+func (c *Cassette) Variable(key string) (string, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	v, ok := c.Variables[key]
+	return v, ok
+}
+
+// SetVariable records the value for the specified key, this is a no-op when replaying
+func (c *Cassette) SetVariable(key, value string) {
+	if c.mode != RecordingModeRecord {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.Variables[key] = value
+}
+
+func (c *Cassette) record(request *http.Request, requestBody []byte, response *http.Response) error {
+	var responseBody []byte
+	if response.Body != nil {
+		var err error
+		responseBody, err = io.ReadAll(response.Body)
+		if err != nil {
+			return fmt.Errorf("reading response body: %+v", err)
+		}
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewBuffer(responseBody))
+	}
+
+	// the sensitive values are redacted using the same rules used for logging, prior to these being persisted
+	sensitiveValues := sensitiveValuesForRequest(request)
+	_, redactedRequestBody := redactForRecording(request.URL, nil, requestBody, sensitiveValues)
+	redactedHeaders, redactedResponseBody := redactForRecording(request.URL, response.Header, responseBody, sensitiveValues)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.Interactions = append(c.Interactions, Interaction{
+		Request: RecordedRequest{
+			Method: request.Method,
+			URL:    redactURL(request.URL),
+			Body:   redactedRequestBody,
+		},
+		Response: RecordedResponse{
+			StatusCode: response.StatusCode,
+			Headers:    redactedHeaders,
+			Body:       redactedResponseBody,
+		},
+	})
+
+	return nil
+}
+
+// redactForRecording redacts the sensitive values from the headers and body of a request or response (see
+// RedactionRules.Redact) prior to these being recorded, returning the redacted headers and body
+func redactForRecording(requestURL *url.URL, headers http.Header, body []byte, sensitiveValues []string) (http.Header, string) {
+	// the headers and body are converted into (and back from) the wire format which is redacted
+	var dump bytes.Buffer
+	dump.WriteString("RECORDED\r\n")
+	_ = headers.Write(&dump)
+	dump.WriteString("\r\n")
+	dump.Write(body)
+
+	redacted := activeRedactionRules().Redact(requestURL, dump.Bytes(), sensitiveValues)
+	head, redactedBody, _ := bytes.Cut(redacted, []byte("\r\n\r\n"))
+
+	var redactedHeaders http.Header
+	if headers != nil {
+		redactedHeaders = http.Header{}
+		for _, line := range strings.Split(string(head), "\r\n")[1:] {
+			if name, value, ok := strings.Cut(line, ":"); ok {
+				redactedHeaders[name] = append(redactedHeaders[name], strings.TrimSpace(value))
+			}
+		}
+	}
+
+	return redactedHeaders, string(redactedBody)
+}
+
+// replay returns the next recorded response for the specified method and URL - when the same request
+// has been made multiple times (for example when polling a long-running operation) the recorded
+// responses are returned in order, with the last response being repeated once they're exhausted.
+//
+// Since the signature for any Shared Access Signature is redacted from the recorded URLs, this is
+// also redacted from the URL prior to matching.
+func (c *Cassette) replay(method, uri string) (*RecordedResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := interactionKey(method, uri)
+	matches := make([]RecordedResponse, 0)
+	for _, interaction := range c.Interactions {
+		if interactionKey(interaction.Request.Method, interaction.Request.URL) == key {
+			matches = append(matches, interaction.Response)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no recorded interaction was found for %s %s in cassette %q", method, uri, c.path)
+	}
+
+	index := c.replayed[key]
+	if index >= len(matches) {
+		index = len(matches) - 1
+	}
+	c.replayed[key]++
+
+	return &matches[index], nil
+}
+
+func (c *Cassette) replayResponse(request *http.Request) (*http.Response, error) {
+	recorded, err := c.replay(request.Method, request.URL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	headers := recorded.Headers.Clone()
+	if headers == nil {
+		headers = http.Header{}
+	}
+	// there's no need to wait between polls when replaying
+	headers.Set("Retry-After", "0")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       request,
+	}, nil
+}
+
+func (c *Cassette) serveReplay(w http.ResponseWriter, r *http.Request) {
+	u := *r.URL
+	u.Scheme = "https"
+	u.Host = r.Host
+	if v := r.Header.Get(headerRecordingOriginalHost); v != "" {
+		u.Scheme, u.Host, _ = strings.Cut(v, "://")
+	}
+
+	recorded, err := c.replay(r.Method, u.String())
+	if err != nil {
+		// a 501 isn't retried by the SDK, so this surfaces immediately
+		log.Printf("[DEBUG] %+v", err)
+		http.Error(w, err.Error(), http.StatusNotImplemented)
+		return
+	}
+
+	for k, values := range recorded.Headers {
+		if strings.EqualFold(k, "Content-Length") {
+			continue
+		}
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+	// there's no need to wait between polls when replaying
+	w.Header().Set("Retry-After", "0")
+	w.WriteHeader(recorded.StatusCode)
+	_, _ = w.Write([]byte(recorded.Body))
+}
+
+// interactionKey returns the key used to match requests, the host is compared case-insensitively and
+// the signature for any Shared Access Signature is redacted
+func interactionKey(method, uri string) string {
+	uri = sharedAccessSignatureRegex.ReplaceAllString(uri, "${1}"+redactedValue)
+	scheme, remainder, _ := strings.Cut(uri, "://")
+	host, path, _ := strings.Cut(remainder, "/")
+	return fmt.Sprintf("%s %s://%s/%s", strings.ToUpper(method), strings.ToLower(scheme), strings.ToLower(host), path)
+}
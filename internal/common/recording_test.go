@@ -0,0 +1,202 @@
+package common
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRecordingRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestAccExample_basic.json")
+	uri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/acctestRG-1?api-version=2020-06-01"
+	pollingUri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/operationResults/abc?api-version=2020-06-01"
+
+	recorder, err := StartCassette(path, RecordingModeRecord)
+	if err != nil {
+		t.Fatalf("starting recording: %+v", err)
+	}
+	recorder.SetVariable("random_integer", "1234")
+
+	requestMiddleware := recordingRequestMiddleware()
+	responseMiddleware := recordingResponseMiddleware()
+	send := func(method, uri, body string, status int, responseBody string) {
+		request, _ := http.NewRequest(method, uri, strings.NewReader(body))
+		request, err := requestMiddleware(request)
+		if err != nil {
+			t.Fatalf("running request middleware: %+v", err)
+		}
+		response := &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Retry-After": []string{"10"}},
+			Body:       io.NopCloser(bytes.NewBufferString(responseBody)),
+		}
+		response, err = responseMiddleware(request, response)
+		if err != nil {
+			t.Fatalf("running response middleware: %+v", err)
+		}
+		if b, _ := io.ReadAll(response.Body); string(b) != responseBody {
+			t.Fatalf("expected the response body to be preserved but got %q", string(b))
+		}
+	}
+	send(http.MethodPut, uri, `{"location":"westeurope"}`, http.StatusAccepted, "")
+	send(http.MethodGet, pollingUri, "", http.StatusOK, `{"status":"InProgress"}`)
+	send(http.MethodGet, pollingUri, "", http.StatusOK, `{"status":"Succeeded"}`)
+
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("stopping recording: %+v", err)
+	}
+	if len(recorder.Interactions) != 3 {
+		t.Fatalf("expected 3 interactions but got %d", len(recorder.Interactions))
+	}
+	if recorder.Interactions[0].Request.Body != `{"location":"westeurope"}` {
+		t.Fatalf("expected the request body to be recorded but got %q", recorder.Interactions[0].Request.Body)
+	}
+
+	player, err := StartCassette(path, RecordingModeReplay)
+	if err != nil {
+		t.Fatalf("starting replay: %+v", err)
+	}
+	defer player.Stop() // nolint errcheck
+
+	if v, _ := player.Variable("random_integer"); v != "1234" {
+		t.Fatalf("expected the variable `random_integer` to be `1234` but got %q", v)
+	}
+
+	receive := func(method, uri string) (*http.Response, string) {
+		request, _ := http.NewRequest(method, strings.Replace(uri, "management.azure.com", "MANAGEMENT.azure.com", 1), nil)
+		request, err := requestMiddleware(request)
+		if err != nil {
+			t.Fatalf("running request middleware: %+v", err)
+		}
+		if request.URL.Scheme != "http" {
+			t.Fatalf("expected the request to be routed to the replay server but got %q", request.URL.String())
+		}
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("sending request to replay server: %+v", err)
+		}
+		defer response.Body.Close()
+		body, _ := io.ReadAll(response.Body)
+		return response, string(body)
+	}
+
+	if response, _ := receive(http.MethodPut, uri); response.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected status %d but got %d", http.StatusAccepted, response.StatusCode)
+	}
+
+	expected := []string{`{"status":"InProgress"}`, `{"status":"Succeeded"}`, `{"status":"Succeeded"}`}
+	for i, v := range expected {
+		response, body := receive(http.MethodGet, pollingUri)
+		if body != v {
+			t.Fatalf("poll %d: expected %q but got %q", i, v, body)
+		}
+		if response.Header.Get("Retry-After") != "0" {
+			t.Fatalf("poll %d: expected Retry-After to be zeroed but got %q", i, response.Header.Get("Retry-After"))
+		}
+	}
+
+	if response, _ := receive(http.MethodDelete, uri); response.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected an unrecorded request to return %d but got %d", http.StatusNotImplemented, response.StatusCode)
+	}
+}
+
+func TestRecordingSenderReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	uri := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers?api-version=2016-02-01"
+
+	recorder, err := StartCassette(path, RecordingModeRecord)
+	if err != nil {
+		t.Fatalf("starting recording: %+v", err)
+	}
+	sender := recordingSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBufferString(`{"value":[]}`))}, nil
+	}))
+	request, _ := http.NewRequest(http.MethodGet, uri, nil)
+	if _, err := sender.Do(request); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("stopping recording: %+v", err)
+	}
+
+	player, err := StartCassette(path, RecordingModeReplay)
+	if err != nil {
+		t.Fatalf("starting replay: %+v", err)
+	}
+	defer player.Stop() // nolint errcheck
+
+	sender = recordingSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		t.Fatalf("the underlying sender should not be called when replaying")
+		return nil, nil
+	}))
+	request, _ = http.NewRequest(http.MethodGet, uri, nil)
+	response, err := sender.Do(request)
+	if err != nil {
+		t.Fatalf("replaying request: %+v", err)
+	}
+	if body, _ := io.ReadAll(response.Body); string(body) != `{"value":[]}` {
+		t.Fatalf("expected the recorded body but got %q", string(body))
+	}
+}
+
+func TestRecordingRedactsSensitiveValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	uri := "https://example.blob.core.windows.net/container/blob?sv=2020-08-04&sig=c2VjcmV0U2lnbmF0dXJl"
+
+	recorder, err := StartCassette(path, RecordingModeRecord)
+	if err != nil {
+		t.Fatalf("starting recording: %+v", err)
+	}
+	sender := recordingSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type":        []string{"application/json"},
+				"X-Ms-Encryption-Key": []string{"c2VjcmV0S2V5"},
+			},
+			Body: io.NopCloser(bytes.NewBufferString(`{"name":"example","primaryKey":"c2VjcmV0UHJpbWFyeUtleQ=="}`)),
+		}, nil
+	}))
+	request, _ := http.NewRequest(http.MethodPut, uri, strings.NewReader(`{"properties":{"adminPassword":"P@ssw0rd1234!"}}`))
+	if _, err := sender.Do(request); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("stopping recording: %+v", err)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	for _, v := range []string{"c2VjcmV0U2lnbmF0dXJl", "c2VjcmV0S2V5", "c2VjcmV0UHJpbWFyeUtleQ==", "P@ssw0rd1234!"} {
+		if strings.Contains(string(contents), v) {
+			t.Fatalf("expected %q to be redacted from the cassette but got %s", v, string(contents))
+		}
+	}
+	if !strings.Contains(string(contents), "example") || !strings.Contains(string(contents), "application/json") {
+		t.Fatalf("expected the non-sensitive values to be recorded but got %s", string(contents))
+	}
+
+	player, err := StartCassette(path, RecordingModeReplay)
+	if err != nil {
+		t.Fatalf("starting replay: %+v", err)
+	}
+	defer player.Stop() // nolint errcheck
+
+	// the request (containing the signature) matches the recorded request (with the signature redacted)
+	request, _ = http.NewRequest(http.MethodPut, uri, nil)
+	response, err := player.replayResponse(request)
+	if err != nil {
+		t.Fatalf("replaying request: %+v", err)
+	}
+	if response.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("expected the recorded headers to be replayed but got %+v", response.Header)
+	}
+}
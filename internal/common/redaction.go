@@ -0,0 +1,372 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// redactedValue replaces any sensitive value within the requests and responses which are logged
+const redactedValue = "REDACTED"
+
+// minimumSensitiveValueLength is the minimum length of a sensitive value from the Schema which is redacted,
+// since redacting (very) short values would mask unrelated parts of the requests and responses
+const minimumSensitiveValueLength = 4
+
+// RedactionRule defines the values within the requests and responses for an API which are sensitive, and
+// should be redacted prior to these being logged
+type RedactionRule struct {
+	// URLPattern limits this rule to requests where the URL matches, when specified
+	URLPattern *regexp.Regexp
+
+	// JSONPaths are the (dot-separated) paths to sensitive values within the JSON body, where `*` matches
+	// any field - Arrays are traversed implicitly (e.g. `keys.value` matches `{"keys": [{"value": "..."}]}`)
+	JSONPaths []string
+
+	// FieldNames are the names of fields within the JSON body which are sensitive at any level (case-insensitive)
+	FieldNames []string
+}
+
+// RedactionRules is a set of RedactionRule's
+type RedactionRules []RedactionRule
+
+var (
+	redactionRulesLock    sync.RWMutex
+	serviceRedactionRules RedactionRules
+)
+
+// ConfigureRedactionRules configures the (Service specific) rules used to redact sensitive values from the
+// requests and responses which are logged, which are used in addition to the default rules
+func ConfigureRedactionRules(rules RedactionRules) {
+	redactionRulesLock.Lock()
+	defer redactionRulesLock.Unlock()
+
+	serviceRedactionRules = rules
+}
+
+func activeRedactionRules() RedactionRules {
+	redactionRulesLock.RLock()
+	defer redactionRulesLock.RUnlock()
+
+	rules := make(RedactionRules, 0)
+	rules = append(rules, defaultRedactionRules...)
+	return append(rules, serviceRedactionRules...)
+}
+
+// defaultRedactionRules are the fields which are sensitive regardless of the API being used
+var defaultRedactionRules = RedactionRules{
+	{
+		FieldNames: []string{
+			"accessKey",
+			"accountKey",
+			"adminPassword",
+			"administratorLoginPassword",
+			"clientSecret",
+			"connectionString",
+			"password",
+			"primaryConnectionString",
+			"primaryKey",
+			"sasToken",
+			"secondaryConnectionString",
+			"secondaryKey",
+			"sharedAccessKey",
+		},
+	},
+}
+
+// sensitiveHeaders are the headers which are redacted from the requests and responses which are logged
+var sensitiveHeaders = map[string]struct{}{
+	"authorization":                  {},
+	"ocp-apim-subscription-key":      {},
+	"proxy-authorization":            {},
+	"x-ms-authorization-auxiliary":   {},
+	"x-ms-copy-source-authorization": {},
+	"x-ms-encryption-key":            {},
+}
+
+// sharedAccessSignatureRegex matches the signature within a Shared Access Signature (e.g. within a URL)
+var sharedAccessSignatureRegex = regexp.MustCompile(`(?i)([?&]sig=)[^&\s"]+`)
+
+// Redact redacts the sensitive values from the wire format of a request or response (as returned from
+// httputil.DumpRequestOut and httputil.DumpResponse) for the specified URL - that is the sensitive headers,
+// the signature for any Shared Access Signature, the fields within the JSON body matching these rules and
+// any of the specified sensitive values
+func (r RedactionRules) Redact(requestURL *url.URL, dump []byte, sensitiveValues []string) []byte {
+	head, body, hasBody := bytes.Cut(dump, []byte("\r\n\r\n"))
+
+	lines := strings.Split(string(head), "\r\n")
+	for i, line := range lines {
+		// the first line is the Request/Status Line
+		if i == 0 {
+			continue
+		}
+
+		name, _, ok := strings.Cut(line, ":")
+		if _, sensitive := sensitiveHeaders[strings.ToLower(strings.TrimSpace(name))]; ok && sensitive {
+			lines[i] = fmt.Sprintf("%s: %s", name, redactedValue)
+		}
+	}
+	output := sharedAccessSignatureRegex.ReplaceAllString(strings.Join(lines, "\r\n"), "${1}"+redactedValue)
+
+	if hasBody {
+		body = r.redactBody(requestURL, body)
+		output = fmt.Sprintf("%s\r\n\r\n%s", output, sharedAccessSignatureRegex.ReplaceAllString(string(body), "${1}"+redactedValue))
+	}
+
+	// longer values are replaced first, in case a sensitive value contains another
+	values := make([]string, 0)
+	for _, v := range sensitiveValues {
+		if len(v) >= minimumSensitiveValueLength {
+			values = append(values, v)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return len(values[i]) > len(values[j])
+	})
+	for _, v := range values {
+		output = strings.ReplaceAll(output, v, redactedValue)
+		if encoded, err := json.Marshal(v); err == nil {
+			// values containing special characters are escaped within the JSON body
+			output = strings.ReplaceAll(output, strings.Trim(string(encoded), `"`), redactedValue)
+		}
+	}
+
+	return []byte(output)
+}
+
+// RedactionTestCase is a response from Azure used to verify that the Redaction Rules for a Service redact the
+// sensitive values within the response - whilst leaving the remainder of the response intact
+type RedactionTestCase struct {
+	Name string
+
+	// URL is the URL of the request
+	URL string
+
+	// Body is the JSON body of the response
+	Body string
+
+	// Redacted are the values which must be redacted from the response
+	Redacted []string
+
+	// Unredacted are the values which must remain in the response
+	Unredacted []string
+}
+
+// VerifyRedactionRules verifies that the Redaction Rules redact the sensitive values from each of the test cases,
+// which is intended to be used within the tests for each Service
+func VerifyRedactionRules(rules RedactionRules, testCases []RedactionTestCase) error {
+	for _, v := range testCases {
+		requestURL, err := url.Parse(v.URL)
+		if err != nil {
+			return fmt.Errorf("%s: parsing %q: %+v", v.Name, v.URL, err)
+		}
+
+		dump := fmt.Sprintf("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\n\r\n%s", v.Body)
+		actual := string(rules.Redact(requestURL, []byte(dump), nil))
+		for _, value := range v.Redacted {
+			if strings.Contains(actual, value) {
+				return fmt.Errorf("%s: expected %q to be redacted but got %s", v.Name, value, actual)
+			}
+		}
+		for _, value := range v.Unredacted {
+			if !strings.Contains(actual, value) {
+				return fmt.Errorf("%s: expected %q not to be redacted but got %s", v.Name, value, actual)
+			}
+		}
+	}
+
+	return nil
+}
+
+// redactBody redacts the fields within the JSON body matching these rules - the JSON document is located
+// within the body, since the body may be chunked
+func (r RedactionRules) redactBody(requestURL *url.URL, body []byte) []byte {
+	paths := make([][]string, 0)
+	fields := make(map[string]struct{})
+	for _, rule := range r {
+		if rule.URLPattern != nil && (requestURL == nil || !rule.URLPattern.MatchString(requestURL.Path)) {
+			continue
+		}
+
+		for _, v := range rule.JSONPaths {
+			paths = append(paths, strings.Split(v, "."))
+		}
+		for _, v := range rule.FieldNames {
+			fields[strings.ToLower(v)] = struct{}{}
+		}
+	}
+	if len(paths) == 0 && len(fields) == 0 {
+		return body
+	}
+
+	start := bytes.IndexAny(body, "{[")
+	end := bytes.LastIndexAny(body, "}]")
+	if start == -1 || end < start {
+		return body
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body[start : end+1]))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return body
+	}
+
+	for _, path := range paths {
+		redactJSONPath(document, path)
+	}
+	redactJSONFields(document, fields)
+
+	redacted, err := json.Marshal(document)
+	if err != nil {
+		return body
+	}
+
+	output := make([]byte, 0)
+	output = append(output, body[:start]...)
+	output = append(output, redacted...)
+	return append(output, body[end+1:]...)
+}
+
+func redactJSONPath(input interface{}, path []string) {
+	switch v := input.(type) {
+	case []interface{}:
+		for _, item := range v {
+			redactJSONPath(item, path)
+		}
+
+	case map[string]interface{}:
+		for key, value := range v {
+			if path[0] != "*" && !strings.EqualFold(key, path[0]) {
+				continue
+			}
+
+			if len(path) == 1 {
+				if value != nil {
+					v[key] = redactedValue
+				}
+				continue
+			}
+
+			redactJSONPath(value, path[1:])
+		}
+	}
+}
+
+func redactJSONFields(input interface{}, fields map[string]struct{}) {
+	switch v := input.(type) {
+	case []interface{}:
+		for _, item := range v {
+			redactJSONFields(item, fields)
+		}
+
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := fields[strings.ToLower(key)]; ok && value != nil {
+				v[key] = redactedValue
+				continue
+			}
+
+			redactJSONFields(value, fields)
+		}
+	}
+}
+
+// AddSensitiveValues records the values of the fields within the Schema which are marked as Sensitive for this
+// Operation, such that these are redacted from the requests and responses which are logged during this Operation
+func (o *Operation) AddSensitiveValues(resourceSchema map[string]*pluginsdk.Schema, d *pluginsdk.ResourceData) {
+	if o == nil || d == nil {
+		return
+	}
+
+	values := make([]string, 0)
+	for key, field := range resourceSchema {
+		values = append(values, sensitiveValues(field, d.Get(key), false)...)
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.sensitiveValues = append(o.sensitiveValues, values...)
+}
+
+// SensitiveValues returns the values which are redacted from the requests and responses logged during this Operation
+func (o *Operation) SensitiveValues() []string {
+	if o == nil {
+		return nil
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	return append([]string{}, o.sensitiveValues...)
+}
+
+func sensitiveValues(field *pluginsdk.Schema, input interface{}, parentIsSensitive bool) []string {
+	sensitive := parentIsSensitive || field.Sensitive
+
+	output := make([]string, 0)
+	switch v := input.(type) {
+	case string:
+		if sensitive && v != "" {
+			output = append(output, v)
+		}
+
+	case map[string]interface{}:
+		for _, item := range v {
+			if value, ok := item.(string); ok && sensitive && value != "" {
+				output = append(output, value)
+			}
+		}
+
+	case []interface{}:
+		output = append(output, sensitiveValuesForItems(field, v, sensitive)...)
+
+	case *pluginsdk.Set:
+		output = append(output, sensitiveValuesForItems(field, v.List(), sensitive)...)
+	}
+
+	return output
+}
+
+func sensitiveValuesForItems(field *pluginsdk.Schema, items []interface{}, sensitive bool) []string {
+	output := make([]string, 0)
+	for _, item := range items {
+		switch elem := field.Elem.(type) {
+		case *pluginsdk.Resource:
+			raw, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for key, nestedField := range elem.Schema {
+				output = append(output, sensitiveValues(nestedField, raw[key], sensitive)...)
+			}
+
+		case *pluginsdk.Schema:
+			output = append(output, sensitiveValues(elem, item, sensitive)...)
+		}
+	}
+	return output
+}
+
+// sensitiveValuesForRequest returns the sensitive values for the Operation within the request's Context
+func sensitiveValuesForRequest(request *http.Request) []string {
+	return OperationFromContext(request.Context()).SensitiveValues()
+}
+
+// redactURL returns the URL with the signature for any Shared Access Signature redacted
+func redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	return sharedAccessSignatureRegex.ReplaceAllString(input.String(), "${1}"+redactedValue)
+}
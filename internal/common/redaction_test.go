@@ -0,0 +1,139 @@
+package common
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestRedactRequest(t *testing.T) {
+	requestURL, _ := url.Parse("https://example.blob.core.windows.net/container/blob?sv=2021-06-08&sig=c2lnbmF0dXJl")
+	dump := strings.Join([]string{
+		"PUT /container/blob?sv=2021-06-08&sig=c2lnbmF0dXJl HTTP/1.1",
+		"Host: example.blob.core.windows.net",
+		"Authorization: Bearer ZXhhbXBsZQ==",
+		"X-Ms-Copy-Source: https://source.blob.core.windows.net/container/blob?sig=c291cmNl",
+		"X-Ms-Encryption-Key: ZW5jcnlwdGlvbg==",
+		"",
+		`{"name": "example", "properties": {"administratorLoginPassword": "p4ssw0rd!", "connectionStrings": [{"connectionString": "Server=example;Password=p4ssw0rd!"}]}}`,
+	}, "\r\n")
+
+	actual := string(activeRedactionRules().Redact(requestURL, []byte(dump), nil))
+	for _, value := range []string{"c2lnbmF0dXJl", "ZXhhbXBsZQ==", "c291cmNl", "ZW5jcnlwdGlvbg==", "p4ssw0rd!"} {
+		if strings.Contains(actual, value) {
+			t.Fatalf("expected %q to be redacted but got %s", value, actual)
+		}
+	}
+	for _, value := range []string{"Host: example.blob.core.windows.net", "Authorization: REDACTED", `"name":"example"`} {
+		if !strings.Contains(actual, value) {
+			t.Fatalf("expected %q not to be redacted but got %s", value, actual)
+		}
+	}
+}
+
+func TestRedactResponseMatchingURLPattern(t *testing.T) {
+	rules := RedactionRules{
+		{
+			URLPattern: regexp.MustCompile(`(?i)/listKeys$`),
+			JSONPaths:  []string{"keys.value"},
+		},
+	}
+	// the body is chunked, since the length of the response wasn't known
+	dump := "HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n4a\r\n" + `{"keys": [{"keyName": "key1", "value": "a2V5MQ=="}, {"keyName": "key2", "value": "a2V5Mg=="}]}` + "\r\n0\r\n\r\n"
+
+	matching, _ := url.Parse("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Example/listKeys")
+	actual := string(rules.Redact(matching, []byte(dump), nil))
+	if strings.Contains(actual, "a2V5MQ==") || strings.Contains(actual, "a2V5Mg==") || !strings.Contains(actual, `"keyName":"key1"`) {
+		t.Fatalf("expected the keys to be redacted but got %s", actual)
+	}
+	if !strings.HasSuffix(actual, "\r\n0\r\n\r\n") {
+		t.Fatalf("expected the chunked encoding to be retained but got %s", actual)
+	}
+
+	other, _ := url.Parse("https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Example")
+	actual = string(rules.Redact(other, []byte(dump), nil))
+	if !strings.Contains(actual, "a2V5MQ==") {
+		t.Fatalf("expected the rule not to apply to other URLs but got %s", actual)
+	}
+}
+
+func TestRedactSensitiveValues(t *testing.T) {
+	resourceSchema := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+		"value": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"short": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"settings": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"token": {
+						Type:      pluginsdk.TypeString,
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		"secrets": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"name":  "example",
+		"value": `s3cr3t"value`,
+		"short": "abc",
+		"settings": []interface{}{
+			map[string]interface{}{
+				"token": "nested-token",
+			},
+		},
+		"secrets": map[string]interface{}{
+			"key": "map-secret",
+		},
+	})
+
+	_, operation := WithOperation(context.TODO())
+	operation.AddSensitiveValues(resourceSchema, d)
+
+	requestURL, _ := url.Parse("https://example.vault.azure.net/secrets/example")
+	dump := "PUT /secrets/example HTTP/1.1\r\nHost: example.vault.azure.net\r\n\r\n" + `{"name": "example", "value": "s3cr3t\"value", "tags": {"token": "nested-token", "other": "map-secret"}, "short": "abc"}`
+	actual := string(RedactionRules{}.Redact(requestURL, []byte(dump), operation.SensitiveValues()))
+	for _, value := range []string{`s3cr3t\"value`, "nested-token", "map-secret"} {
+		if strings.Contains(actual, value) {
+			t.Fatalf("expected %q to be redacted but got %s", value, actual)
+		}
+	}
+	if !strings.Contains(actual, `"short": "abc"`) || !strings.Contains(actual, `"name": "example"`) {
+		t.Fatalf("expected the short and non-sensitive values not to be redacted but got %s", actual)
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	input, _ := url.Parse("https://example.blob.core.windows.net/container?restype=container&sig=c2lnbmF0dXJl&sv=2021-06-08")
+	expected := "https://example.blob.core.windows.net/container?restype=container&sig=REDACTED&sv=2021-06-08"
+	if actual := redactURL(input); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+const headerForwardedOriginalHost = "X-Terraform-Forwarded-Original-Host"
+
+var (
+	// rootCertificateForwarders are the local servers which forward requests using a transport trusting each
+	// set of Root Certificates, which are started on first use and shared by all clients for the process
+	rootCertificateForwarders     = map[*x509.CertPool]*httptest.Server{}
+	rootCertificateForwardersLock = &sync.Mutex{}
+)
+
+// rootCertificatesRequestMiddleware ensures that requests made using go-azure-sdk trust the specified Root Certificates.
+//
+// go-azure-sdk builds a new HTTP transport for each request (which can't be configured), so instead each HTTPS
+// request is re-routed (in the same manner as when replaying a Cassette) to a local server, which sends the
+// request on to the original host using an HTTP transport trusting the Root Certificates.
+func rootCertificatesRequestMiddleware(rootCertificates *x509.CertPool) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		// requests which have already been re-routed (e.g. to the active Cassette) aren't sent using TLS
+		if !strings.EqualFold(request.URL.Scheme, "https") {
+			return request, nil
+		}
+
+		forwarder := rootCertificatesForwarder(rootCertificates)
+		request.Header.Set(headerForwardedOriginalHost, fmt.Sprintf("%s://%s", request.URL.Scheme, request.URL.Host))
+		forwardedUrl := *request.URL
+		forwardedUrl.Scheme = "http"
+		forwardedUrl.Host = forwarder.Listener.Addr().String()
+		request.URL = &forwardedUrl
+		request.Host = forwardedUrl.Host
+
+		return request, nil
+	}
+}
+
+// rootCertificatesForwarder returns (starting if necessary) the local server which forwards requests using
+// an HTTP transport trusting the specified Root Certificates
+func rootCertificatesForwarder(rootCertificates *x509.CertPool) *httptest.Server {
+	rootCertificateForwardersLock.Lock()
+	defer rootCertificateForwardersLock.Unlock()
+
+	if forwarder, ok := rootCertificateForwarders[rootCertificates]; ok {
+		return forwarder
+	}
+
+	transport := buildTransport(rootCertificates)
+	forwarder := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwardRequest(transport, w, r)
+	}))
+	rootCertificateForwarders[rootCertificates] = forwarder
+	return forwarder
+}
+
+// forwardRequest sends the request on to the original host using the specified transport, without following
+// any redirects (since these are handled by go-azure-sdk) and writes the response
+func forwardRequest(transport http.RoundTripper, w http.ResponseWriter, r *http.Request) {
+	scheme, host, ok := strings.Cut(r.Header.Get(headerForwardedOriginalHost), "://")
+	if !ok {
+		http.Error(w, fmt.Sprintf("the header %q was not set for the forwarded request", headerForwardedOriginalHost), http.StatusBadRequest)
+		return
+	}
+
+	request := r.Clone(r.Context())
+	request.RequestURI = ""
+	request.URL.Scheme = scheme
+	request.URL.Host = host
+	request.Host = host
+	request.Header.Del(headerForwardedOriginalHost)
+
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		log.Printf("[DEBUG] Forwarding request to %s: %+v", redactURL(request.URL), err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer response.Body.Close()
+
+	for k, values := range response.Header {
+		for _, v := range values {
+			w.Header().Add(k, v)
+		}
+	}
+	w.WriteHeader(response.StatusCode)
+	_, _ = io.Copy(w, response.Body)
+}
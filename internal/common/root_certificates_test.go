@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+)
+
+type testResourceGroup struct {
+	Location string `json:"location"`
+}
+
+func testResourceGroupRequest(ctx context.Context, c *resourcemanager.Client, method string, model *testResourceGroup) (*testResourceGroup, error) {
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK, http.StatusCreated},
+		HttpMethod:          method,
+		Path:                fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-certificates", fakearm.SubscriptionId),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+	if model != nil {
+		if err := req.Marshal(model); err != nil {
+			return nil, fmt.Errorf("marshaling request: %+v", err)
+		}
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %+v", err)
+	}
+
+	var result testResourceGroup
+	if err := resp.Unmarshal(&result); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %+v", err)
+	}
+	return &result, nil
+}
+
+func TestRootCertificatesTrustedByResourceManagerClients(t *testing.T) {
+	server, err := fakearm.Start()
+	if err != nil {
+		t.Fatalf("starting the fake Resource Manager server: %+v", err)
+	}
+	defer server.Close()
+
+	rootCertificates, err := server.RootCertificates()
+	if err != nil {
+		t.Fatalf("loading the root certificates: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	buildClient := func(o ClientOptions) *resourcemanager.Client {
+		c, err := resourcemanager.NewResourceManagerClient(environments.ResourceManagerAPI(server.URL()), "Resources", "2022-09-01")
+		if err != nil {
+			t.Fatalf("building client: %+v", err)
+		}
+		o.Configure(c, nil)
+		return c
+	}
+
+	// the self-signed certificate used by the server isn't trusted by default - since go-azure-sdk retries
+	// the failed TLS handshake a shorter timeout is used here
+	untrustedCtx, untrustedCancel := context.WithTimeout(ctx, 2*time.Second)
+	defer untrustedCancel()
+	if _, err := testResourceGroupRequest(untrustedCtx, buildClient(ClientOptions{DisableCorrelationRequestID: true}), http.MethodGet, nil); err == nil {
+		t.Fatalf("expected an error when the certificate isn't trusted")
+	}
+
+	c := buildClient(ClientOptions{
+		DisableCorrelationRequestID: true,
+		RootCertificates:            rootCertificates,
+	})
+	if _, err := testResourceGroupRequest(ctx, c, http.MethodPut, &testResourceGroup{Location: "westeurope"}); err != nil {
+		t.Fatalf("creating the Resource Group: %+v", err)
+	}
+
+	existing, err := testResourceGroupRequest(ctx, c, http.MethodGet, nil)
+	if err != nil {
+		t.Fatalf("retrieving the Resource Group: %+v", err)
+	}
+	if existing.Location != "westeurope" {
+		t.Fatalf("expected the location to be `westeurope` but got %q", existing.Location)
+	}
+
+	if _, ok := server.Resource(fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-certificates", fakearm.SubscriptionId)); !ok {
+		t.Fatalf("expected the Resource Group to exist within the fake Resource Manager server")
+	}
+}
@@ -0,0 +1,272 @@
+package common
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+type requestKind string
+
+const (
+	requestKindRead   requestKind = "reads"
+	requestKindWrite  requestKind = "writes"
+	requestKindDelete requestKind = "deletes"
+)
+
+// headerRateLimitRemainingPrefix is the prefix for the headers returned by Resource Manager containing the
+// number of requests (of each kind) remaining for this Subscription, e.g. `x-ms-ratelimit-remaining-subscription-reads`
+const headerRateLimitRemainingPrefix = "X-Ms-Ratelimit-Remaining-Subscription-"
+
+var (
+	// rateLimitLowWatermarks is the number of remaining requests (of each kind) below which
+	// requests are slowed down, to avoid the Subscription being throttled
+	rateLimitLowWatermarks = map[requestKind]int{
+		requestKindRead:   100,
+		requestKindWrite:  50,
+		requestKindDelete: 50,
+	}
+
+	// maximumSlowdownInterval is the interval between requests once there are no requests remaining
+	maximumSlowdownInterval = 2 * time.Second
+
+	// defaultRetryAfter is used when Resource Manager returns a 429 without a (valid) `Retry-After` header
+	defaultRetryAfter = 10 * time.Second
+)
+
+// Throttler is a rate limiter shared by all of the requests made to Resource Manager for a Subscription, which:
+//
+// * limits the number of requests sent per second (when configured),
+// * slows down all requests once the `x-ms-ratelimit-remaining-subscription-*` headers show the Subscription
+// is approaching its limit, and
+// * pauses all requests for the duration specified in the `Retry-After` header when a request is throttled.
+type Throttler struct {
+	// resourceManagerHost is used to only throttle requests sent to Resource Manager
+	resourceManagerHost string
+
+	lock sync.Mutex
+
+	// minimumInterval is the minimum interval between requests, derived from the maximum requests per second
+	minimumInterval time.Duration
+
+	// next is the earliest time at which the next request can be sent
+	next time.Time
+
+	// pausedUntil is the time until which all requests are paused after a request has been throttled
+	pausedUntil time.Time
+
+	// slowdownIntervals is the interval between requests (of each kind) whilst approaching the limit
+	slowdownIntervals map[requestKind]time.Duration
+}
+
+var (
+	throttlers     = map[string]*Throttler{}
+	throttlersLock = &sync.Mutex{}
+)
+
+// SubscriptionThrottler returns the Throttler shared by all of the clients for the specified Subscription, limiting
+// the number of requests sent to the Resource Manager endpoint to maxRequestsPerSecond (where 0 is unlimited)
+func SubscriptionThrottler(subscriptionId, resourceManagerEndpoint string, maxRequestsPerSecond int) *Throttler {
+	throttlersLock.Lock()
+	defer throttlersLock.Unlock()
+
+	host := resourceManagerEndpoint
+	if u, err := url.Parse(resourceManagerEndpoint); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	key := strings.ToLower(subscriptionId + "|" + host)
+	throttler, ok := throttlers[key]
+	if !ok {
+		throttler = &Throttler{
+			resourceManagerHost: host,
+			slowdownIntervals:   map[requestKind]time.Duration{},
+		}
+		throttlers[key] = throttler
+	}
+
+	throttler.lock.Lock()
+	throttler.minimumInterval = 0
+	if maxRequestsPerSecond > 0 {
+		throttler.minimumInterval = time.Second / time.Duration(maxRequestsPerSecond)
+	}
+	throttler.lock.Unlock()
+
+	return throttler
+}
+
+// Wait blocks until the specified request can be sent, or the context is cancelled
+func (t *Throttler) Wait(ctx context.Context, request *http.Request) error {
+	if t == nil || !t.applies(request) {
+		return nil
+	}
+
+	delay := t.reserve(time.Now(), kindForRequest(request))
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Throttling: delaying %s request to %s by %s", request.Method, request.URL.Path, delay)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Observe updates the Throttler from the rate limit headers (and status code) returned in the response
+func (t *Throttler) Observe(request *http.Request, response *http.Response) {
+	if t == nil || response == nil || !t.applies(request) {
+		return
+	}
+
+	now := time.Now()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for kind, lowWatermark := range rateLimitLowWatermarks {
+		v := response.Header.Get(headerRateLimitRemainingPrefix + string(kind))
+		if v == "" {
+			continue
+		}
+
+		remaining, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+
+		if remaining >= lowWatermark {
+			delete(t.slowdownIntervals, kind)
+			continue
+		}
+
+		if remaining < 0 {
+			remaining = 0
+		}
+		interval := maximumSlowdownInterval * time.Duration(lowWatermark-remaining) / time.Duration(lowWatermark)
+		if _, ok := t.slowdownIntervals[kind]; !ok {
+			log.Printf("[DEBUG] Throttling: %d %s remaining for this Subscription, slowing down to one request every %s", remaining, kind, interval)
+		}
+		t.slowdownIntervals[kind] = interval
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		retryAfter := parseRetryAfter(response.Header.Get("Retry-After"), now)
+		if retryAfter <= 0 {
+			retryAfter = defaultRetryAfter
+		}
+
+		if until := now.Add(retryAfter); until.After(t.pausedUntil) {
+			log.Printf("[DEBUG] Throttling: request to %s was throttled, pausing all requests for %s", request.URL.Path, retryAfter)
+			t.pausedUntil = until
+		}
+	}
+}
+
+// reserve returns how long the caller must wait before sending a request of the specified kind,
+// reserving the slot for this request
+func (t *Throttler) reserve(now time.Time, kind requestKind) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	start := now
+	if t.next.After(start) {
+		start = t.next
+	}
+	if t.pausedUntil.After(start) {
+		start = t.pausedUntil
+	}
+
+	interval := t.minimumInterval
+	if v := t.slowdownIntervals[kind]; v > interval {
+		interval = v
+	}
+	t.next = start.Add(interval)
+
+	return start.Sub(now)
+}
+
+func (t *Throttler) applies(request *http.Request) bool {
+	return request != nil && request.URL != nil && strings.EqualFold(request.URL.Host, t.resourceManagerHost)
+}
+
+func kindForRequest(request *http.Request) requestKind {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead:
+		return requestKindRead
+	case http.MethodDelete:
+		return requestKindDelete
+	}
+
+	return requestKindWrite
+}
+
+// parseRetryAfter parses the `Retry-After` header, which is either a number of seconds or a HTTP Date
+func parseRetryAfter(input string, now time.Time) time.Duration {
+	if input == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(input); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(input); err == nil {
+		return date.Sub(now)
+	}
+
+	return 0
+}
+
+// throttlingRequestMiddleware waits until the Throttler allows the request to be sent to Resource Manager.
+//
+// NOTE: go-azure-sdk retries requests (e.g. those which have been throttled) using its own HTTP client, which can't
+// be wrapped (unlike the autorest Sender, see throttlingSender) - as such the middlewares are called once for each
+// request rather than for each attempt, and go-azure-sdk waits for the `Retry-After` header itself before retrying.
+func throttlingRequestMiddleware(throttler *Throttler) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := throttler.Wait(request.Context(), request); err != nil {
+			return nil, err
+		}
+
+		return request, nil
+	}
+}
+
+// throttlingResponseMiddleware updates the Throttler from the final response to the request
+func throttlingResponseMiddleware(throttler *Throttler) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		throttler.Observe(request, response)
+		return response, nil
+	}
+}
+
+// throttlingSender wraps an autorest.Sender so that each request (including retries) is throttled
+func throttlingSender(throttler *Throttler, sender autorest.Sender) autorest.Sender {
+	if throttler == nil {
+		return sender
+	}
+
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		if err := throttler.Wait(request.Context(), request); err != nil {
+			return nil, err
+		}
+
+		response, err := sender.Do(request)
+		throttler.Observe(request, response)
+		return response, err
+	})
+}
@@ -0,0 +1,233 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+)
+
+func newTestThrottler(maxRequestsPerSecond int) *Throttler {
+	throttler := &Throttler{
+		resourceManagerHost: "management.azure.com",
+		slowdownIntervals:   map[requestKind]time.Duration{},
+	}
+	if maxRequestsPerSecond > 0 {
+		throttler.minimumInterval = time.Second / time.Duration(maxRequestsPerSecond)
+	}
+	return throttler
+}
+
+func testRequest(method, host string) *http.Request {
+	return &http.Request{
+		Method: method,
+		URL: &url.URL{
+			Scheme: "https",
+			Host:   host,
+			Path:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		},
+		Header: http.Header{},
+	}
+}
+
+func TestThrottlerMaxRequestsPerSecond(t *testing.T) {
+	throttler := newTestThrottler(4)
+	now := time.Now()
+
+	for i, expected := range []time.Duration{0, 250 * time.Millisecond, 500 * time.Millisecond} {
+		if actual := throttler.reserve(now, requestKindRead); actual != expected {
+			t.Fatalf("expected request %d to be delayed by %s but got %s", i, expected, actual)
+		}
+	}
+}
+
+func TestThrottlerUnlimited(t *testing.T) {
+	throttler := newTestThrottler(0)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		if actual := throttler.reserve(now, requestKindWrite); actual != 0 {
+			t.Fatalf("expected request %d not to be delayed but got %s", i, actual)
+		}
+	}
+}
+
+func TestThrottlerSlowsDownWhenApproachingLimit(t *testing.T) {
+	throttler := newTestThrottler(0)
+	request := testRequest(http.MethodPut, "management.azure.com")
+
+	response := &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"X-Ms-Ratelimit-Remaining-Subscription-Writes": []string{"25"},
+			"X-Ms-Ratelimit-Remaining-Subscription-Reads":  []string{"11999"},
+		},
+	}
+	throttler.Observe(request, response)
+
+	if expected, actual := maximumSlowdownInterval/2, throttler.slowdownIntervals[requestKindWrite]; expected != actual {
+		t.Fatalf("expected writes to be slowed down to %s but got %s", expected, actual)
+	}
+	if _, ok := throttler.slowdownIntervals[requestKindRead]; ok {
+		t.Fatalf("expected reads not to be slowed down")
+	}
+
+	now := time.Now()
+	throttler.reserve(now, requestKindWrite)
+	if actual := throttler.reserve(now, requestKindRead); actual != maximumSlowdownInterval/2 {
+		t.Fatalf("expected the next request to be delayed by %s but got %s", maximumSlowdownInterval/2, actual)
+	}
+
+	// once the limit has been replenished, requests shouldn't be slowed down
+	response.Header.Set("X-Ms-Ratelimit-Remaining-Subscription-Writes", "1199")
+	throttler.Observe(request, response)
+	if _, ok := throttler.slowdownIntervals[requestKindWrite]; ok {
+		t.Fatalf("expected writes not to be slowed down")
+	}
+}
+
+func TestThrottlerHonoursRetryAfter(t *testing.T) {
+	throttler := newTestThrottler(0)
+	request := testRequest(http.MethodGet, "management.azure.com")
+
+	throttler.Observe(request, &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"Retry-After": []string{"30"},
+		},
+	})
+
+	delay := throttler.reserve(time.Now(), requestKindRead)
+	if delay <= 25*time.Second || delay > 30*time.Second {
+		t.Fatalf("expected the request to be paused for around 30s but got %s", delay)
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	if err := throttler.Wait(ctx, request); err == nil {
+		t.Fatalf("expected an error when the context is cancelled whilst paused")
+	}
+
+	// requests to other hosts (e.g. data plane APIs) aren't throttled
+	if err := throttler.Wait(ctx, testRequest(http.MethodGet, "example.vault.azure.net")); err != nil {
+		t.Fatalf("expected requests to other hosts not to be throttled but got: %+v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]time.Duration{
+		"":                              0,
+		"invalid":                       0,
+		"17":                            17 * time.Second,
+		"Sun, 01 Jan 2023 12:00:45 GMT": 45 * time.Second,
+	}
+
+	for input, expected := range testCases {
+		if actual := parseRetryAfter(input, now); actual != expected {
+			t.Fatalf("expected %q to be parsed as %s but got %s", input, expected, actual)
+		}
+	}
+}
+
+func TestSubscriptionThrottlerIsShared(t *testing.T) {
+	first := SubscriptionThrottler("00000000-0000-0000-0000-000000000000", "https://management.azure.com/", 10)
+	second := SubscriptionThrottler("00000000-0000-0000-0000-000000000000", "https://management.azure.com/", 5)
+	if first != second {
+		t.Fatalf("expected the Throttler to be shared for the same Subscription")
+	}
+	if first.minimumInterval != 200*time.Millisecond {
+		t.Fatalf("expected the minimum interval to be 200ms but got %s", first.minimumInterval)
+	}
+
+	other := SubscriptionThrottler("11111111-1111-1111-1111-111111111111", "https://management.azure.com/", 5)
+	if first == other {
+		t.Fatalf("expected a different Throttler for a different Subscription")
+	}
+}
+
+func testResourceManagerClient(serverUrl string, throttler *Throttler) *resourcemanager.Client {
+	c := &resourcemanager.Client{
+		Client: client.NewClient(serverUrl, "Example", "2020-01-01"),
+	}
+	c.RequestMiddlewares = &[]client.RequestMiddleware{
+		throttlingRequestMiddleware(throttler),
+	}
+	c.ResponseMiddlewares = &[]client.ResponseMiddleware{
+		throttlingResponseMiddleware(throttler),
+	}
+	return c
+}
+
+func executeTestRequest(ctx context.Context, c *resourcemanager.Client) error {
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                "/example",
+	})
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+	_, err = req.Execute(ctx)
+	return err
+}
+
+func TestThrottlingMiddlewaresObserveResponse(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-Ms-Ratelimit-Remaining-Subscription-Reads", "0")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverUrl, _ := url.Parse(server.URL)
+	throttler := newTestThrottler(0)
+	throttler.resourceManagerHost = serverUrl.Host
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+	if err := executeTestRequest(ctx, testResourceManagerClient(server.URL, throttler)); err != nil {
+		t.Fatalf("executing request: %+v", err)
+	}
+
+	if requests != 1 {
+		t.Fatalf("expected a single request but got %d", requests)
+	}
+	if _, ok := throttler.slowdownIntervals[requestKindRead]; !ok {
+		t.Fatalf("expected the rate limit headers in the response to slow down reads")
+	}
+}
+
+func TestThrottlingRequestMiddlewareReturnsErrorWaiting(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverUrl, _ := url.Parse(server.URL)
+	throttler := newTestThrottler(0)
+	throttler.resourceManagerHost = serverUrl.Host
+	// another request has paused the Throttler for longer than the deadline
+	throttler.pausedUntil = time.Now().Add(time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 200*time.Millisecond)
+	defer cancel()
+	err := executeTestRequest(ctx, testResourceManagerClient(server.URL, throttler))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the error waiting to send the request to be returned but got %+v", err)
+	}
+	if requests != 0 {
+		t.Fatalf("expected the request not to be sent but got %d requests", requests)
+	}
+}
@@ -0,0 +1,259 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/version"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// EnvTracingFile is the Environment Variable specifying the path to a (local) file which the
+	// spans for each Data Source/Resource operation and each request to Azure are written to as JSON
+	EnvTracingFile = "ARM_TRACING_FILE"
+
+	tracerName = "github.com/hashicorp/terraform-provider-azurerm"
+)
+
+// envTracingOTLPEndpoints are the (standard) Environment Variables specifying the OTLP endpoint which
+// the spans are exported to, which are also read by the OTLP exporter
+var envTracingOTLPEndpoints = []string{
+	"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+	"OTEL_EXPORTER_OTLP_ENDPOINT",
+}
+
+// pollingURLRegex matches the URLs used to poll Long Running Operations in Resource Manager
+var pollingURLRegex = regexp.MustCompile(`(?i)/(asyncOperations|operationResults|operationStatuses|operations)/[^/]+$`)
+
+var (
+	tracerProviderLock sync.Mutex
+	tracerProvider     *sdktrace.TracerProvider
+)
+
+type httpSpanContextKey struct{}
+
+// ConfigureTracing configures the OpenTelemetry Tracer Provider when tracing is enabled - that is when either
+// an OTLP endpoint (via `OTEL_EXPORTER_OTLP_TRACES_ENDPOINT` or `OTEL_EXPORTER_OTLP_ENDPOINT`) or a file (via
+// `ARM_TRACING_FILE`) is specified. The returned function flushes and shuts down the Tracer Provider.
+func ConfigureTracing(ctx context.Context) (func(context.Context) error, error) {
+	tracerProviderLock.Lock()
+	defer tracerProviderLock.Unlock()
+
+	noop := func(context.Context) error { return nil }
+	if tracerProvider != nil {
+		return noop, nil
+	}
+
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "terraform-provider-azurerm"),
+			attribute.String("service.version", version.ProviderVersion),
+		)),
+	}
+
+	if path := os.Getenv(EnvTracingFile); path != "" {
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return noop, fmt.Errorf("opening the tracing file %q: %+v", path, err)
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return noop, fmt.Errorf("building the file exporter for tracing: %+v", err)
+		}
+
+		// the spans are written as they complete, since the Provider can be terminated without being shut down
+		options = append(options, sdktrace.WithSyncer(exporter))
+	}
+
+	for _, v := range envTracingOTLPEndpoints {
+		if os.Getenv(v) == "" {
+			continue
+		}
+
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return noop, fmt.Errorf("building the OTLP exporter for tracing: %+v", err)
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+		break
+	}
+
+	// tracing is disabled unless an exporter has been configured
+	if len(options) == 1 {
+		return noop, nil
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(tracerProvider)
+	log.Printf("[DEBUG] Tracing has been enabled")
+
+	return func(ctx context.Context) error {
+		tracerProviderLock.Lock()
+		defer tracerProviderLock.Unlock()
+
+		if tracerProvider == nil {
+			return nil
+		}
+
+		err := tracerProvider.Shutdown(ctx)
+		tracerProvider = nil
+		return err
+	}, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName, trace.WithInstrumentationVersion(version.ProviderVersion))
+}
+
+// StartOperationSpan starts a Span for an operation on a Data Source/Resource (e.g. `Create`), returning the Context
+// containing the Span and a function which ends the Span - recording the error (if any) returned from the operation
+func StartOperationSpan(ctx context.Context, resourceType, operationName string, d *pluginsdk.ResourceData) (context.Context, func(err error)) {
+	attributes := []attribute.KeyValue{
+		attribute.String("terraform.resource_type", resourceType),
+		attribute.String("terraform.operation", operationName),
+	}
+	if d != nil && d.Id() != "" {
+		attributes = append(attributes, attribute.String("terraform.resource_id", d.Id()))
+	}
+
+	ctx, span := tracer().Start(ctx, fmt.Sprintf("%s.%s", resourceType, operationName), trace.WithAttributes(attributes...))
+	return ctx, func(err error) {
+		if operation := OperationFromContext(ctx); operation != nil {
+			operation.lock.Lock()
+			if operation.correlationRequestID != "" {
+				span.SetAttributes(attribute.String("azure.correlation_request_id", operation.correlationRequestID))
+			}
+			operation.lock.Unlock()
+		}
+		if d != nil && d.Id() != "" {
+			span.SetAttributes(attribute.String("terraform.resource_id", d.Id()))
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+// startHTTPSpan starts a Span for a request to Azure - where the request is polling a Long Running Operation
+// the Span is named (and attributed) as such, so that the time spent waiting on these can be identified
+func startHTTPSpan(request *http.Request) (context.Context, trace.Span) {
+	name := fmt.Sprintf("HTTP %s", request.Method)
+	poll := request.Method == http.MethodGet && pollingURLRegex.MatchString(request.URL.Path)
+	if poll {
+		name = "Poll Long Running Operation"
+	}
+
+	return tracer().Start(request.Context(), name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("http.method", request.Method),
+		attribute.String("http.url", redactURL(request.URL)),
+		attribute.String("net.peer.name", request.URL.Hostname()),
+		attribute.Bool("azure.long_running_operation.poll", poll),
+	))
+}
+
+// endHTTPSpan ends the Span for a request to Azure, recording the response (or error) for the request
+func endHTTPSpan(span trace.Span, request *http.Request, response *http.Response, err error) {
+	if correlationRequestID := request.Header.Get(HeaderCorrelationRequestID); correlationRequestID != "" {
+		span.SetAttributes(attribute.String("azure.correlation_request_id", correlationRequestID))
+	}
+
+	if response != nil {
+		span.SetAttributes(attribute.Int("http.status_code", response.StatusCode))
+		if requestID := response.Header.Get(HeaderRequestID); requestID != "" {
+			span.SetAttributes(attribute.String("azure.request_id", requestID))
+		}
+		if response.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, strings.TrimSpace(fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode))))
+		}
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// httpSpan is a Span for a request sent using go-azure-sdk, which is ended either once a response is received
+// or once the Context for the request is done - whichever happens first
+type httpSpan struct {
+	once  sync.Once
+	span  trace.Span
+	ended chan struct{}
+}
+
+func (s *httpSpan) end(request *http.Request, response *http.Response, err error) {
+	s.once.Do(func() {
+		endHTTPSpan(s.span, request, response, err)
+		close(s.ended)
+	})
+}
+
+// tracingRequestMiddleware starts a Span for each request sent using go-azure-sdk - which is ended within the
+// tracingResponseMiddleware. Since the request and response middlewares are only called once per request these
+// Spans cover any retries made for the request.
+//
+// go-azure-sdk doesn't call the response middlewares when no response is received (for example when the
+// connection fails) - as such the Span is also ended once the Context for the request is done, which happens
+// when the operation making the request returns.
+func tracingRequestMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		ctx, span := startHTTPSpan(request)
+		s := &httpSpan{
+			span:  span,
+			ended: make(chan struct{}),
+		}
+		request = request.WithContext(context.WithValue(ctx, httpSpanContextKey{}, s))
+
+		if done := ctx.Done(); done != nil {
+			go func() {
+				select {
+				case <-done:
+					s.end(request, nil, fmt.Errorf("no response was received for the request: %+v", ctx.Err()))
+				case <-s.ended:
+				}
+			}()
+		}
+
+		return request, nil
+	}
+}
+
+func tracingResponseMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		if s, ok := request.Context().Value(httpSpanContextKey{}).(*httpSpan); ok {
+			s.end(request, response, nil)
+		}
+		return response, nil
+	}
+}
+
+// tracingSender wraps an autorest.Sender so that a Span is created for each request sent using autorest
+func tracingSender(sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		ctx, span := startHTTPSpan(request)
+		response, err := sender.Do(request.WithContext(ctx))
+		endHTTPSpan(span, request, response, err)
+		return response, err
+	})
+}
@@ -0,0 +1,111 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	existing := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(existing)
+
+	ctx, endSpan := StartOperationSpan(context.TODO(), "azurerm_example", "Create", nil)
+
+	// autorest
+	sender := tracingSender(autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}, nil
+	}))
+	for _, uri := range []string{
+		"https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Example/locations/westeurope/operationResults/abc123?api-version=2022-01-01",
+		"https://example.blob.core.windows.net/container?sig=c2lnbmF0dXJl",
+	} {
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+		if _, err := sender.Do(request); err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+	}
+
+	// go-azure-sdk
+	request, _ := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+	request, err := tracingRequestMiddleware()(request)
+	if err != nil {
+		t.Fatalf("running request middleware: %+v", err)
+	}
+	var responseMiddleware client.ResponseMiddleware = tracingResponseMiddleware()
+	if _, err := responseMiddleware(request, &http.Response{StatusCode: http.StatusConflict, Header: http.Header{}}); err != nil {
+		t.Fatalf("running response middleware: %+v", err)
+	}
+
+	endSpan(fmt.Errorf("creating Example"))
+
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans but got %d", len(spans))
+	}
+
+	parent := spans[3]
+	if parent.Name() != "azurerm_example.Create" || parent.Status().Code != codes.Error {
+		t.Fatalf("expected the failed operation span but got %q (%+v)", parent.Name(), parent.Status())
+	}
+
+	expected := []string{"Poll Long Running Operation", "HTTP GET", "HTTP PUT"}
+	for i, name := range expected {
+		span := spans[i]
+		if span.Name() != name {
+			t.Fatalf("expected span %d to be %q but got %q", i, name, span.Name())
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Fatalf("expected span %q to be a child of the operation span", name)
+		}
+	}
+
+	for _, v := range spans[1].Attributes() {
+		if v.Key == attribute.Key("http.url") && v.Value.AsString() != "https://example.blob.core.windows.net/container?sig=REDACTED" {
+			t.Fatalf("expected the url to be redacted but got %q", v.Value.AsString())
+		}
+	}
+	if spans[2].Status().Code != codes.Error {
+		t.Fatalf("expected the failed request to have an error status but got %+v", spans[2].Status())
+	}
+}
+
+func TestTracingSpanEndedWhenNoResponseIsReceived(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	existing := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(existing)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	request, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+	if _, err := tracingRequestMiddleware()(request); err != nil {
+		t.Fatalf("running request middleware: %+v", err)
+	}
+
+	// go-azure-sdk doesn't call the response middlewares when the request fails, so the Span is ended once the operation returns
+	cancel()
+
+	for i := 0; i < 100 && len(recorder.Ended()) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span but got %d", len(spans))
+	}
+	if spans[0].Status().Code != codes.Error {
+		t.Fatalf("expected the request without a response to have an error status but got %+v", spans[0].Status())
+	}
+}
@@ -12,6 +12,7 @@ type UserFeatures struct {
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
 	ResourceGroup          ResourceGroupFeatures
 	ManagedDisk            ManagedDiskFeatures
+	Network                NetworkFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -67,6 +68,10 @@ type ManagedDiskFeatures struct {
 	ExpandWithoutDowntime bool
 }
 
+type NetworkFeatures struct {
+	RelaxedLocking bool
+}
+
 type AppConfigurationFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 	RecoverSoftDeleted       bool
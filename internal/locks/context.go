@@ -0,0 +1,60 @@
+package locks
+
+import (
+	"context"
+	"log"
+)
+
+// Logger is used to report how long locks have been waited on - this is a subset of `sdk.Logger`
+// so that the Logger for a Typed Resource can be used directly
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+}
+
+type contextKeyHolder struct{}
+
+type contextKeyLogger struct{}
+
+// WithHolder returns a copy of the Context which identifies the operation acquiring any locks
+// (for example `creating azurerm_subnet "example"`), which is surfaced when a lock is contended
+func WithHolder(ctx context.Context, holder string) context.Context {
+	return context.WithValue(ctx, contextKeyHolder{}, holder)
+}
+
+// WithLogger returns a copy of the Context which reports how long locks have been waited on to the specified Logger
+func WithLogger(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, contextKeyLogger{}, logger)
+}
+
+func holderFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(contextKeyHolder{}).(string); ok && v != "" {
+		return v
+	}
+
+	return "an unknown operation"
+}
+
+func loggerFromContext(ctx context.Context) Logger {
+	if v, ok := ctx.Value(contextKeyLogger{}).(Logger); ok && v != nil {
+		return v
+	}
+
+	return defaultLogger{}
+}
+
+// defaultLogger writes to the standard logger, which is used when no Logger is available in the Context
+type defaultLogger struct{}
+
+func (defaultLogger) Debugf(format string, args ...interface{}) {
+	log.Printf("[DEBUG] "+format, args...)
+}
+
+func (defaultLogger) Infof(format string, args ...interface{}) {
+	log.Printf("[INFO] "+format, args...)
+}
+
+func (defaultLogger) Warnf(format string, args ...interface{}) {
+	log.Printf("[WARN] "+format, args...)
+}
@@ -1,12 +1,75 @@
 package locks
 
+import "context"
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = newMutexKV()
 
+// Stats returns the cumulative statistics for how often (and how long) locks have been waited on,
+// which are logged when the Provider is shut down
+func Stats() WaitStats {
+	return armMutexKV.WaitStats()
+}
+
 func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+func MultipleByID(ids *[]string) {
+	newSlice := removeDuplicatesFromStringArray(*ids)
+
+	for _, id := range newSlice {
+		ByID(id)
+	}
+}
+
+// ByIDWithContext locks the specified ID, waiting until the lock is acquired or the Context
+// is cancelled - in which case an error containing the current holder of the lock is returned
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id)
+}
+
+// MultipleByIDWithContext locks each of the specified ID's, releasing any locks which have been
+// acquired should the Context be cancelled before all of the locks have been acquired
+func MultipleByIDWithContext(ctx context.Context, ids *[]string) error {
+	newSlice := removeDuplicatesFromStringArray(*ids)
+
+	for i, id := range newSlice {
+		if err := ByIDWithContext(ctx, id); err != nil {
+			for _, acquired := range newSlice[:i] {
+				UnlockByID(acquired)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ByNameWithContext locks the specified name for the given resource type, waiting until the lock is
+// acquired or the Context is cancelled - in which case an error containing the current holder of the lock is returned
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return armMutexKV.LockWithContext(ctx, updatedName)
+}
+
+// MultipleByNameWithContext locks each of the specified names for the given resource type, releasing any locks
+// which have been acquired should the Context be cancelled before all of the locks have been acquired
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	newSlice := removeDuplicatesFromStringArray(*names)
+
+	for i, name := range newSlice {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			for _, acquired := range newSlice[:i] {
+				UnlockByName(acquired, resourceType)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
@@ -25,6 +88,14 @@ func UnlockByID(id string) {
 	armMutexKV.Unlock(id)
 }
 
+func UnlockMultipleByID(ids *[]string) {
+	newSlice := removeDuplicatesFromStringArray(*ids)
+
+	for _, id := range newSlice {
+		UnlockByID(id)
+	}
+}
+
 func UnlockByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Unlock(updatedName)
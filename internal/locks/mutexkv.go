@@ -1,8 +1,11 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"sync"
+	"time"
 )
 
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
@@ -10,39 +13,188 @@ import (
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyedMutex
+	stats WaitStats
+}
+
+// WaitStats are the cumulative statistics for the locks which have been acquired
+type WaitStats struct {
+	// Acquired is the number of locks which have been acquired
+	Acquired int64
+
+	// Contended is the number of locks which were held by another operation when requested
+	Contended int64
+
+	// TimedOut is the number of locks which weren't acquired before the Context was cancelled
+	TimedOut int64
+
+	// TotalWait is the total duration spent waiting for contended locks
+	TotalWait time.Duration
+
+	// LongestWait is the longest duration spent waiting for a single lock
+	LongestWait time.Duration
+}
+
+func (s WaitStats) String() string {
+	return fmt.Sprintf("%d acquired, %d contended (waiting %s in total, %s at most), %d timed out", s.Acquired, s.Contended, s.TotalWait, s.LongestWait, s.TimedOut)
+}
+
+// lockWaitWarningThreshold is the duration after which waiting on a lock is surfaced as a Warning
+const lockWaitWarningThreshold = 5 * time.Minute
+
+// keyedMutex is a mutex which can be acquired with a Context and which tracks
+// who's currently holding it, so that this can be surfaced when the lock is contended
+type keyedMutex struct {
+	// sem is a buffered channel with a capacity of 1, which is held whilst the mutex is locked
+	sem chan struct{}
+
+	lock       sync.Mutex
+	holder     string
+	acquiredAt time.Time
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// a Context without a deadline can't be cancelled, so this can't fail
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, waiting until either the lock is
+// acquired or the Context is cancelled (for example when the Deadline from the resource's
+// timeout has passed). Caller is responsible for calling Unlock for the same key when
+// this doesn't return an error.
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
+	holder := holderFromContext(ctx)
+	logger := loggerFromContext(ctx)
+
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	mutex := m.get(key)
+	start := time.Now()
+
+	// attempt to take the lock without waiting, so that uncontended locks aren't reported as waiting
+	select {
+	case mutex.sem <- struct{}{}:
+		mutex.acquired(holder)
+		m.recordAcquired(0, false)
+		log.Printf("[DEBUG] Locked %q", key)
+		return nil
+	default:
+	}
+
+	currentHolder, heldSince := mutex.currentHolder()
+	logger.Debugf("Waiting for the lock %q, which has been held by %s since %s", key, currentHolder, heldSince.Format(time.RFC3339))
+
+	select {
+	case mutex.sem <- struct{}{}:
+		mutex.acquired(holder)
+		waited := time.Since(start)
+		m.recordAcquired(waited, true)
+		log.Printf("[DEBUG] Locked %q", key)
+		logger.Infof("Acquired the lock %q after waiting %s", key, waited)
+		if waited >= lockWaitWarningThreshold {
+			logger.Warnf("waited %s for the lock %q which was held by %s", waited.Round(time.Second), key, currentHolder)
+		}
+		return nil
+
+	case <-ctx.Done():
+		waited := time.Since(start)
+		currentHolder, heldSince = mutex.currentHolder()
+		m.recordTimedOut(waited)
+		logger.Debugf("Gave up waiting for the lock %q after %s", key, waited)
+		return fmt.Errorf("waiting %s for the lock %q held by %s (since %s): %+v", waited.Round(time.Second), key, currentHolder, heldSince.Format(time.RFC3339), ctx.Err())
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+	mutex := m.get(key)
+	mutex.released()
+	select {
+	case <-mutex.sem:
+	default:
+		panic(fmt.Sprintf("unlock of unlocked mutex %q", key))
+	}
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
+// WaitStats returns the cumulative statistics for the locks which have been acquired
+func (m *mutexKV) WaitStats() WaitStats {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.stats
+}
+
+func (m *mutexKV) recordAcquired(waited time.Duration, contended bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.stats.Acquired++
+	if contended {
+		m.stats.Contended++
+		m.recordWait(waited)
+	}
+}
+
+func (m *mutexKV) recordTimedOut(waited time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.stats.Contended++
+	m.stats.TimedOut++
+	m.recordWait(waited)
+}
+
+// recordWait records the duration spent waiting for a lock - the caller must hold `m.lock`
+func (m *mutexKV) recordWait(waited time.Duration) {
+	m.stats.TotalWait += waited
+	if waited > m.stats.LongestWait {
+		m.stats.LongestWait = waited
+	}
+}
+
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *keyedMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &keyedMutex{
+			sem: make(chan struct{}, 1),
+		}
 		m.store[key] = mutex
 	}
 	return mutex
 }
 
+func (m *keyedMutex) acquired(holder string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.holder = holder
+	m.acquiredAt = time.Now()
+}
+
+func (m *keyedMutex) released() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.holder = ""
+	m.acquiredAt = time.Time{}
+}
+
+func (m *keyedMutex) currentHolder() (string, time.Time) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.holder, m.acquiredAt
+}
+
 // newMutexKV returns a properly initialized mutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyedMutex),
 	}
 }
@@ -0,0 +1,127 @@
+package locks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type testLogger struct {
+	debugs   []string
+	infos    []string
+	warnings []string
+}
+
+func (l *testLogger) Debugf(format string, args ...interface{}) {
+	l.debugs = append(l.debugs, fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) Infof(format string, args ...interface{}) {
+	l.infos = append(l.infos, fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) Warnf(format string, args ...interface{}) {
+	l.warnings = append(l.warnings, fmt.Sprintf(format, args...))
+}
+
+func TestMutexKVLockWithContextTimesOut(t *testing.T) {
+	kv := newMutexKV()
+	key := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+
+	holderCtx := WithHolder(context.Background(), `creating azurerm_subnet "subnet1"`)
+	if err := kv.LockWithContext(holderCtx, key); err != nil {
+		t.Fatalf("acquiring uncontended lock: %+v", err)
+	}
+	defer kv.Unlock(key)
+
+	logger := &testLogger{}
+	ctx, cancel := context.WithTimeout(WithLogger(context.Background(), logger), 50*time.Millisecond)
+	defer cancel()
+
+	err := kv.LockWithContext(ctx, key)
+	if err == nil {
+		t.Fatalf("expected an error when the Context expired but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `creating azurerm_subnet "subnet1"`) {
+		t.Fatalf("expected the error to contain the holder of the lock but got %q", err.Error())
+	}
+	if !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+		t.Fatalf("expected the error to contain the Context error but got %q", err.Error())
+	}
+	if len(logger.debugs) == 0 {
+		t.Fatalf("expected the wait to be logged but nothing was")
+	}
+
+	stats := kv.WaitStats()
+	if stats.Acquired != 1 || stats.Contended != 1 || stats.TimedOut != 1 {
+		t.Fatalf("expected 1 lock to be acquired, 1 to be contended and 1 to time out but got %+v", stats)
+	}
+	if stats.TotalWait < 50*time.Millisecond {
+		t.Fatalf("expected the wait to be recorded but got %+v", stats)
+	}
+}
+
+func TestMutexKVLockWithContextWaits(t *testing.T) {
+	kv := newMutexKV()
+	key := "azurerm_subnet.subnet1"
+
+	kv.Lock(key)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		kv.Unlock(key)
+	}()
+
+	logger := &testLogger{}
+	ctx, cancel := context.WithTimeout(WithLogger(context.Background(), logger), 5*time.Second)
+	defer cancel()
+
+	if err := kv.LockWithContext(ctx, key); err != nil {
+		t.Fatalf("expected the lock to be acquired once released but got: %+v", err)
+	}
+	kv.Unlock(key)
+
+	if len(logger.debugs) != 1 {
+		t.Fatalf("expected the wait to be logged at the debug level but got %+v", logger.debugs)
+	}
+	if len(logger.infos) != 1 {
+		t.Fatalf("expected the acquisition to be logged but got %+v", logger.infos)
+	}
+
+	stats := kv.WaitStats()
+	if stats.Acquired != 2 || stats.Contended != 1 || stats.TimedOut != 0 {
+		t.Fatalf("expected 2 locks to be acquired and 1 to be contended but got %+v", stats)
+	}
+	if stats.LongestWait == 0 || stats.LongestWait != stats.TotalWait {
+		t.Fatalf("expected the wait to be recorded but got %+v", stats)
+	}
+	if !strings.HasPrefix(stats.String(), "2 acquired, 1 contended") {
+		t.Fatalf("expected the stats to be summarised but got %q", stats.String())
+	}
+	if len(logger.warnings) != 0 {
+		t.Fatalf("expected no warnings for a short wait but got %+v", logger.warnings)
+	}
+}
+
+func TestMultipleByNameWithContextReleasesOnFailure(t *testing.T) {
+	names := []string{"first", "second"}
+	resourceType := "azurerm_test_resource"
+
+	ByName("second", resourceType)
+	defer UnlockByName("second", resourceType)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := MultipleByNameWithContext(ctx, &names, resourceType); err == nil {
+		t.Fatalf("expected an error when one of the locks couldn't be acquired but didn't get one")
+	}
+
+	// the first lock should have been released, so this shouldn't block
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := ByNameWithContext(ctx, "first", resourceType); err != nil {
+		t.Fatalf("expected the first lock to have been released but got: %+v", err)
+	}
+	UnlockByName("first", resourceType)
+}
@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func schemaDefaultTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": {
+					Type:         pluginsdk.TypeMap,
+					Optional:     true,
+					ValidateFunc: tags.Validate,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+	for k, v := range raw["tags"].(map[string]interface{}) {
+		value, _ := tags.TagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+// supportsDefaultTags returns whether the Default Tags from the Provider block should be applied to this Resource
+func supportsDefaultTags(resource *pluginsdk.Resource) bool {
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != pluginsdk.TypeMap || !v.Optional {
+		return false
+	}
+
+	_, exists := resource.Schema["tags_all"]
+	return !exists
+}
+
+// addDefaultTagsToResource exposes the `tags_all` attribute for a Resource which supports tags, containing both
+// the tags defined on the resource and those from the `default_tags` block in the Provider.
+//
+// The Default Tags are merged into the `tags` field when the diff is calculated, such that the merged tags are
+// planned (and so `d.HasChange("tags")` is true) whenever these differ from the tags currently assigned to the
+// resource - as such the tags returned from Azure contain the Default Tags, which are removed from the `tags`
+// field when this resource is read.
+//
+// Tags matching the `ignore_tags` block in the Provider are also removed from the `tags` field when this resource
+// is read - but remain in `tags_all`, such that these can be sent back to Azure (and retained) when the resource
+// is updated, since the tags are replaced rather than merged by most APIs
+func addDefaultTagsToResource(resource *pluginsdk.Resource) {
+	// the merged tags can only be planned for a Computed field - the schema is copied since this can be shared
+	tagsSchema := *resource.Schema["tags"]
+	tagsSchema.Computed = true
+	resource.Schema["tags"] = &tagsSchema
+
+	resource.Schema["tags_all"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+
+	// the deprecated (non-context aware) CRUD functions are still used by the majority of Resources
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if resource.Create != nil { //nolint:staticcheck
+		resource.Create = defaultTagsFunc(resource.Create, true) //nolint:staticcheck
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = defaultTagsContextFunc(resource.CreateContext, true)
+	}
+	if resource.Read != nil { //nolint:staticcheck
+		resource.Read = defaultTagsFunc(resource.Read, false) //nolint:staticcheck
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = defaultTagsContextFunc(resource.ReadContext, false)
+	}
+	if resource.Update != nil { //nolint:staticcheck
+		resource.Update = defaultTagsFunc(resource.Update, true) //nolint:staticcheck
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = defaultTagsContextFunc(resource.UpdateContext, true)
+	}
+
+	// when the tags can't be updated in-place, planning the Default Tags into `tags` would recreate the resource
+	supportsUpdate := (resource.Update != nil || resource.UpdateContext != nil) && !tagsSchema.ForceNew //nolint:staticcheck
+	existing := resource.CustomizeDiff
+	resource.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if existing != nil {
+			if err := existing(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+
+		defaultTags, ignoredTags := tagsConfigurationFromMeta(meta)
+		return customizeDiffForDefaultTags(diff, defaultTags, ignoredTags, supportsUpdate)
+	}
+}
+
+// tagsConfigurationFromMeta returns the Default Tags and Ignored Tags for the configured Provider
+func tagsConfigurationFromMeta(meta interface{}) (map[string]string, tags.IgnoredTags) {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.DefaultTags, client.IgnoredTags
+	}
+
+	return map[string]string{}, tags.IgnoredTags{}
+}
+
+func customizeDiffForDefaultTags(diff *schema.ResourceDiff, defaultTags map[string]string, ignoredTags tags.IgnoredTags, supportsUpdate bool) error {
+	configured, known := configuredTagsFromRawConfig(diff.GetRawConfig())
+	if !known {
+		if err := diff.SetNewComputed("tags"); err != nil {
+			return fmt.Errorf("setting `tags` to computed: %+v", err)
+		}
+		return diff.SetNewComputed("tags_all")
+	}
+	if configured == nil {
+		configured = diff.Get("tags").(map[string]interface{})
+	}
+
+	// since `tags` is Computed, the tags currently assigned are planned when these are removed from the configuration
+	existingTags, _ := diff.GetChange("tags")
+	tagsChanged := !reflect.DeepEqual(existingTags, configured)
+
+	// when the resource can't be updated in-place, the tags can only be updated when the resource is recreated
+	if !supportsUpdate && !tagsChanged {
+		return nil
+	}
+
+	// when no Default Tags are defined `tags_all` is updated alongside `tags`, which avoids an unnecessary diff
+	// where `tags_all` hasn't been populated yet (e.g. when refresh has been disabled)
+	if len(defaultTags) == 0 && !tagsChanged {
+		return nil
+	}
+
+	existingTagsAll, _ := diff.GetChange("tags_all")
+	expected := ignoredTags.MergeIgnoredTags(tags.MergeDefaultTags(defaultTags, configured), existingTagsAll.(map[string]interface{}))
+	if !tagsChanged && reflect.DeepEqual(existingTagsAll, expected) {
+		return nil
+	}
+
+	// the merged tags are planned for `tags` (rather than only `tags_all`) so that these are sent to Azure by resources
+	// which only update the tags when `d.HasChange("tags")` - these are then removed from `tags` when read
+	if err := diff.SetNew("tags", expected); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+	if err := diff.SetNew("tags_all", expected); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	return nil
+}
+
+// configuredTagsFromRawConfig returns the tags defined in the configuration for the resource, and whether these
+// are known - which is nil when the configuration isn't available (e.g. when the resource is read)
+func configuredTagsFromRawConfig(config cty.Value) (map[string]interface{}, bool) {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute("tags") {
+		return nil, true
+	}
+
+	raw := config.GetAttr("tags")
+	if !raw.IsWhollyKnown() {
+		return nil, false
+	}
+
+	output := make(map[string]interface{})
+	if raw.IsNull() {
+		return output, true
+	}
+	for k, v := range raw.AsValueMap() {
+		if !v.IsNull() {
+			output[k] = v.AsString()
+		}
+	}
+
+	return output, true
+}
+
+// configuredTags returns the tags defined on the resource, from the configuration where this is available (since
+// the Default Tags are planned into `tags` during Create/Update) and otherwise from the state
+func configuredTags(d *pluginsdk.ResourceData) map[string]interface{} {
+	if configured, _ := configuredTagsFromRawConfig(d.GetRawConfig()); configured != nil {
+		return configured
+	}
+
+	return d.Get("tags").(map[string]interface{})
+}
+
+// defaultTagsFunc wraps the CRUD function `in` - merging the Default Tags and the Ignored Tags currently assigned to
+// the resource into the `tags` field beforehand when `mergeDefaultTags` is set (e.g. for Create/Update), and removing
+// these from the `tags` field afterwards.
+//
+// NOTE: whilst the merged tags are planned when these change (see `customizeDiffForDefaultTags`), these are also
+// merged here since resources which always send the tags would otherwise remove the Default Tags when updated
+// for another reason
+func defaultTagsFunc(in func(d *pluginsdk.ResourceData, meta interface{}) error, mergeDefaultTags bool) func(d *pluginsdk.ResourceData, meta interface{}) error {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		defaultTags, ignoredTags := tagsConfigurationFromMeta(meta)
+		configured := configuredTags(d)
+		if mergeDefaultTags {
+			if err := d.Set("tags", tagsToApply(d, defaultTags, ignoredTags, configured)); err != nil {
+				return fmt.Errorf("setting `tags`: %+v", err)
+			}
+		}
+
+		if err := in(d, meta); err != nil {
+			// the Default Tags mustn't be persisted into the `tags` field should this fail
+			d.Set("tags", configured)
+			return err
+		}
+
+		return setTagsExcludingDefaultTags(d, defaultTags, ignoredTags, configured)
+	}
+}
+
+func defaultTagsContextFunc(in func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics, mergeDefaultTags bool) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		defaultTags, ignoredTags := tagsConfigurationFromMeta(meta)
+		configured := configuredTags(d)
+		if mergeDefaultTags {
+			if err := d.Set("tags", tagsToApply(d, defaultTags, ignoredTags, configured)); err != nil {
+				return diag.Errorf("setting `tags`: %+v", err)
+			}
+		}
+
+		diags := in(ctx, d, meta)
+		if diags.HasError() {
+			// the Default Tags mustn't be persisted into the `tags` field should this fail
+			d.Set("tags", configured)
+			return diags
+		}
+
+		if err := setTagsExcludingDefaultTags(d, defaultTags, ignoredTags, configured); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// tagsToApply returns the tags which should be assigned to the resource, containing the tags defined on the resource,
+// the Default Tags and any Ignored Tags currently assigned to the resource (which would otherwise be removed)
+func tagsToApply(d *pluginsdk.ResourceData, defaultTags map[string]string, ignoredTags tags.IgnoredTags, configured map[string]interface{}) map[string]interface{} {
+	existing, _ := d.GetChange("tags_all")
+	return ignoredTags.MergeIgnoredTags(tags.MergeDefaultTags(defaultTags, configured), existing.(map[string]interface{}))
+}
+
+// setTagsExcludingDefaultTags sets `tags_all` to the tags returned from Azure, and removes any Ignored Tags and
+// Default Tags which aren't defined on the resource from `tags`
+func setTagsExcludingDefaultTags(d *pluginsdk.ResourceData, defaultTags map[string]string, ignoredTags tags.IgnoredTags, configured map[string]interface{}) error {
+	// the resource has been removed
+	if d.Id() == "" {
+		return nil
+	}
+
+	all := d.Get("tags").(map[string]interface{})
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	if err := d.Set("tags", tags.RemoveDefaultTags(defaultTags, ignoredTags.RemoveIgnoredTags(all, configured), configured)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
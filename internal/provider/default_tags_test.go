@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestDefaultTagsMergedIntoTagsOnCreate(t *testing.T) {
+	meta := &clients.Client{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"owner":       "platform",
+		},
+	}
+
+	var expanded map[string]*string
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": tags.Schema(),
+		},
+		Create: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			expanded = tags.Expand(d.Get("tags").(map[string]interface{}))
+			d.SetId("example")
+
+			// the tags returned from Azure contain the Default Tags
+			return tags.FlattenAndSet(d, expanded)
+		},
+	}
+	addDefaultTagsToResource(resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"hello": "world",
+			"owner": "someone-else",
+		},
+	})
+
+	if err := resource.Create(d, meta); err != nil { //nolint:staticcheck
+		t.Fatalf("creating: %+v", err)
+	}
+
+	if len(expanded) != 3 || *expanded["cost-center"] != "1234" || *expanded["owner"] != "someone-else" {
+		t.Fatalf("expected the Default Tags to be merged into the expanded tags but got %+v", expanded)
+	}
+
+	expectedTags := map[string]interface{}{
+		"hello": "world",
+		"owner": "someone-else",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+}
+
+func TestDefaultTagsRemovedFromTagsOnRead(t *testing.T) {
+	meta := &clients.Client{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"owner":       "platform",
+		},
+	}
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": tags.Schema(),
+		},
+		Read: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			// the tags returned from Azure contain the Default Tags
+			return tags.FlattenAndSet(d, tags.Expand(tags.MergeDefaultTags(meta.DefaultTags, d.Get("tags").(map[string]interface{}))))
+		},
+	}
+	if !supportsDefaultTags(resource) {
+		t.Fatalf("expected the resource to support Default Tags")
+	}
+	addDefaultTagsToResource(resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"hello": "world",
+			"owner": "someone-else",
+		},
+	})
+	d.SetId("example")
+
+	if err := resource.Read(d, meta); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+
+	expectedTags := map[string]interface{}{
+		"hello": "world",
+		"owner": "someone-else",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+
+	expectedTagsAll := map[string]interface{}{
+		"cost-center": "1234",
+		"hello":       "world",
+		"owner":       "someone-else",
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTagsAll) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedTagsAll, actual)
+	}
+}
+
+func TestDefaultTagsChangeUpdatesTags(t *testing.T) {
+	ctx := context.TODO()
+	meta := &clients.Client{
+		DefaultTags: map[string]string{
+			"cost-center": "5678",
+		},
+	}
+
+	// the tags assigned to the resource in Azure, which are only updated when `tags` has changed
+	azureTags := map[string]*string{
+		"cost-center": utils.String("1234"),
+		"hello":       utils.String("world"),
+	}
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": tags.Schema(),
+		},
+		Read: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			return tags.FlattenAndSet(d, azureTags)
+		},
+		Update: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			if d.HasChange("tags") {
+				azureTags = tags.Expand(d.Get("tags").(map[string]interface{}))
+			}
+			return tags.FlattenAndSet(d, azureTags)
+		},
+		Delete: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			return nil
+		},
+	}
+	addDefaultTagsToResource(resource)
+
+	// only the value of the Default Tag has changed since the resource was last applied
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.NullVal(cty.String),
+		"tags": cty.MapVal(map[string]cty.Value{
+			"hello": cty.StringVal("world"),
+		}),
+		"tags_all": cty.NullVal(cty.Map(cty.String)),
+	})
+	config := terraform.NewResourceConfigShimmed(configVal, resource.CoreConfigSchema())
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                   "example",
+			"tags.%":               "1",
+			"tags.hello":           "world",
+			"tags_all.%":           "2",
+			"tags_all.cost-center": "1234",
+			"tags_all.hello":       "world",
+		},
+		RawConfig: configVal,
+	}
+
+	diff, err := resource.Diff(ctx, state, config, meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if diff.Empty() {
+		t.Fatalf("expected the change to the Default Tags to be planned")
+	}
+	newState, diags := resource.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("applying: %+v", diags)
+	}
+
+	if v := azureTags["cost-center"]; v == nil || *v != "5678" {
+		t.Fatalf("expected the Default Tag `cost-center` to be updated in Azure but got %+v", azureTags)
+	}
+	if v := azureTags["hello"]; v == nil || *v != "world" {
+		t.Fatalf("expected the tag `hello` to be retained in Azure but got %+v", azureTags)
+	}
+
+	if _, ok := newState.Attributes["tags.cost-center"]; ok {
+		t.Fatalf("expected the Default Tag `cost-center` to be removed from `tags` but got %+v", newState.Attributes)
+	}
+	if v := newState.Attributes["tags_all.cost-center"]; v != "5678" {
+		t.Fatalf("expected the Default Tag `cost-center` to be updated in `tags_all` but got %+v", newState.Attributes)
+	}
+
+	// a subsequent plan should be empty
+	newState.RawConfig = configVal
+	diff, err = resource.Diff(ctx, newState, config, meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("expected no changes but got %+v", diff)
+	}
+}
+
+func TestExpandDefaultTags(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"tags": map[string]interface{}{
+				"cost-center": "1234",
+			},
+		},
+	}
+	expected := map[string]string{
+		"cost-center": "1234",
+	}
+	if actual := expandDefaultTags(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := expandDefaultTags([]interface{}{}); len(actual) != 0 {
+		t.Fatalf("expected no Default Tags but got %+v", actual)
+	}
+}
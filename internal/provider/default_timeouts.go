@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func schemaDefaultTimeouts() *pluginsdk.Schema {
+	durationSchema := func(operation string) *pluginsdk.Schema {
+		return &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validateTimeoutDuration,
+			Description:  fmt.Sprintf("The default timeout for %s operations on the matching Resource Types, for example `90m`.", operation),
+		}
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validateResourceTypePattern,
+					Description:  "The Resource Type (e.g. `azurerm_kubernetes_cluster`) or a glob pattern matching the Resource Types (e.g. `azurerm_kubernetes_*`) which these Default Timeouts apply to.",
+				},
+				"create": durationSchema("Create"),
+				"read":   durationSchema("Read"),
+				"update": durationSchema("Update"),
+				"delete": durationSchema("Delete"),
+			},
+		},
+	}
+}
+
+func expandDefaultTimeouts(input []interface{}) timeouts.DefaultTimeouts {
+	output := make(timeouts.DefaultTimeouts)
+
+	duration := func(input interface{}) *time.Duration {
+		v, ok := input.(string)
+		if !ok || v == "" {
+			return nil
+		}
+
+		// this has been validated by the schema
+		duration, _ := time.ParseDuration(v)
+		return &duration
+	}
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		raw := item.(map[string]interface{})
+		output[raw["resource_type"].(string)] = timeouts.OperationTimeouts{
+			Create: duration(raw["create"]),
+			Read:   duration(raw["read"]),
+			Update: duration(raw["update"]),
+			Delete: duration(raw["delete"]),
+		}
+	}
+
+	return output
+}
+
+func validateResourceTypePattern(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if v == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+
+	if _, err := path.Match(v, ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a Resource Type or a valid glob pattern, got %q: %+v", k, v, err))
+	}
+
+	return warnings, errors
+}
+
+func validateTimeoutDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration (for example `90m`), got %q: %+v", k, v, err)}
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than zero, got %q", k, v))
+	}
+
+	return warnings, errors
+}
+
+// addDefaultTimeoutsToResource ensures that the context for each Create/Read/Update/Delete operation for a Data Source
+// or Resource is built using the `timeouts` package - so that the timeouts from the `default_timeouts` block in the
+// Provider are used for this Resource Type, where these aren't defined within the `timeouts` block for the resource.
+//
+// Since the Plugin SDK wraps the context for the context aware CRUD functions with the timeout defined by the Resource,
+// these are converted into their `WithoutTimeout` equivalents - as such this must be called once the (deprecated)
+// non-context aware CRUD functions have been converted (see `addOperationTrackingToResource`).
+func addDefaultTimeoutsToResource(resourceType string, resource *pluginsdk.Resource) {
+	if resource.CreateContext != nil {
+		resource.CreateWithoutTimeout = defaultTimeoutsContextFunc(resourceType, timeouts.ForCreate, resource.CreateContext)
+		resource.CreateContext = nil
+	}
+
+	if resource.ReadContext != nil {
+		resource.ReadWithoutTimeout = defaultTimeoutsContextFunc(resourceType, timeouts.ForRead, resource.ReadContext)
+		resource.ReadContext = nil
+	}
+
+	if resource.UpdateContext != nil {
+		resource.UpdateWithoutTimeout = defaultTimeoutsContextFunc(resourceType, timeouts.ForUpdate, resource.UpdateContext)
+		resource.UpdateContext = nil
+	}
+
+	if resource.DeleteContext != nil {
+		resource.DeleteWithoutTimeout = defaultTimeoutsContextFunc(resourceType, timeouts.ForDelete, resource.DeleteContext)
+		resource.DeleteContext = nil
+	}
+}
+
+type timeoutFunc = func(ctx context.Context, d *pluginsdk.ResourceData) (context.Context, context.CancelFunc)
+
+func defaultTimeoutsContextFunc(resourceType string, timeoutFunc timeoutFunc, in func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, cancel := timeoutFunc(timeouts.WithDefaultTimeouts(ctx, resourceType, defaultTimeoutsFromMeta(meta)), d)
+		defer cancel()
+
+		return in(ctx, d, meta)
+	}
+}
+
+// defaultTimeoutsFromMeta returns the Default Timeouts for the configured Provider
+func defaultTimeoutsFromMeta(meta interface{}) timeouts.DefaultTimeouts {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.DefaultTimeouts
+	}
+
+	return timeouts.DefaultTimeouts{}
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	actual := expandDefaultTimeouts([]interface{}{
+		map[string]interface{}{
+			"resource_type": "azurerm_kubernetes_*",
+			"create":        "90m",
+			"read":          "",
+			"update":        "1h30m",
+			"delete":        "",
+		},
+	})
+
+	v, ok := actual["azurerm_kubernetes_*"]
+	if !ok || len(actual) != 1 {
+		t.Fatalf("expected the Default Timeouts for `azurerm_kubernetes_*` but got %+v", actual)
+	}
+	if v.Create == nil || *v.Create != 90*time.Minute || v.Update == nil || *v.Update != 90*time.Minute {
+		t.Fatalf("expected the Create and Update timeouts to be 90m but got %+v", v)
+	}
+	if v.Read != nil || v.Delete != nil {
+		t.Fatalf("expected the Read and Delete timeouts not to be set but got %+v", v)
+	}
+}
+
+func TestValidateResourceTypePattern(t *testing.T) {
+	for input, valid := range map[string]bool{
+		"azurerm_resource_group": true,
+		"azurerm_kubernetes_*":   true,
+		"azurerm_[":              false,
+		"":                       false,
+	} {
+		_, errors := validateResourceTypePattern(input, "resource_type")
+		if (len(errors) == 0) != valid {
+			t.Fatalf("expected %q to be valid: %t but got %+v", input, valid, errors)
+		}
+	}
+}
+
+func TestDefaultTimeoutsAppliedToResource(t *testing.T) {
+	client := &clients.Client{
+		DefaultTimeouts: timeouts.DefaultTimeouts{
+			"azurerm_example_*": {
+				Read: pluginsdk.DefaultTimeout(2 * time.Hour),
+			},
+		},
+	}
+
+	var deadline time.Time
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+		},
+		ReadContext: func(ctx context.Context, _ *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			deadline, _ = ctx.Deadline()
+			return nil
+		},
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+	}
+	addDefaultTimeoutsToResource("azurerm_example_resource", resource)
+
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout == nil {
+		t.Fatalf("expected the ReadContext function to be converted into a ReadWithoutTimeout function")
+	}
+	if err := resource.InternalValidate(nil, false); err != nil {
+		t.Fatalf("validating the resource: %+v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	if diags := resource.ReadWithoutTimeout(context.TODO(), d, client); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+	if remaining := time.Until(deadline); remaining < time.Hour {
+		t.Fatalf("expected the Default Timeout of 2h to be used but got %s", remaining)
+	}
+
+	// the Default Timeouts are specific to each configured Provider (e.g. an aliased Provider)
+	if diags := resource.ReadWithoutTimeout(context.TODO(), d, &clients.Client{}); diags.HasError() {
+		t.Fatalf("reading: %+v", diags)
+	}
+	if remaining := time.Until(deadline); remaining > time.Hour {
+		t.Fatalf("expected the timeout for the resource to be used but got %s", remaining)
+	}
+}
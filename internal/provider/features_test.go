@@ -49,6 +49,9 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: true,
 				},
+				Network: features.NetworkFeatures{
+					RelaxedLocking: false,
+				},
 				TemplateDeployment: features.TemplateDeploymentFeatures{
 					DeleteNestedItemsDuringDeletion: true,
 				},
@@ -179,6 +182,9 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: true,
 				},
+				Network: features.NetworkFeatures{
+					RelaxedLocking: true,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
 				},
@@ -309,6 +315,9 @@ func TestExpandFeatures(t *testing.T) {
 				ManagedDisk: features.ManagedDiskFeatures{
 					ExpandWithoutDowntime: false,
 				},
+				Network: features.NetworkFeatures{
+					RelaxedLocking: false,
+				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: false,
 				},
@@ -1203,3 +1212,68 @@ func TestExpandFeaturesManagedDisk(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesNetwork(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					RelaxedLocking: false,
+				},
+			},
+		},
+		{
+			Name: "Relaxed Locking Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"relaxed_locking": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					RelaxedLocking: true,
+				},
+			},
+		},
+		{
+			Name: "Relaxed Locking Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"network": []interface{}{
+						map[string]interface{}{
+							"relaxed_locking": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Network: features.NetworkFeatures{
+					RelaxedLocking: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Network, testCase.Expected.Network) {
+			t.Fatalf("Expected %+v but got %+v", result.Network, testCase.Expected.Network)
+		}
+	}
+}
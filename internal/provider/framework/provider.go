@@ -0,0 +1,94 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ provider.Provider = &azureRmFrameworkProvider{}
+
+// azureRmFrameworkProvider is the Plugin Framework Provider, which is muxed alongside the Plugin SDKv2 Provider
+//
+// Since the Provider block must be identical in both Providers, the Schema is sourced from the Plugin SDKv2
+// Provider - and the Clients are obtained from the Plugin SDKv2 Provider once it's been configured.
+type azureRmFrameworkProvider struct {
+	sdkProvider *schema.Provider
+
+	// wrappedResources are the Plugin SDKv2 Resources served via the FrameworkResourceWrapper
+	wrappedResources []*sdk.FrameworkResourceWrapper
+
+	// nativeResources are the Resources implemented natively using the Plugin Framework
+	nativeResources []func() resource.Resource
+}
+
+// NewFrameworkProvider returns the Plugin Framework Provider, using the Plugin SDKv2 Provider `sdkProvider` for
+// both the Provider Schema and the Clients. The Plugin SDKv2 Resources `wrappedResources` (keyed by Resource Type)
+// are served via the FrameworkResourceWrapper - and `nativeResources` are served as-is.
+func NewFrameworkProvider(sdkProvider *schema.Provider, wrappedResources map[string]*schema.Resource, nativeResources []func() resource.Resource) provider.Provider {
+	wrappers := make([]*sdk.FrameworkResourceWrapper, 0)
+	for resourceType, r := range wrappedResources {
+		wrapper, err := sdk.NewFrameworkResourceWrapper(resourceType, r)
+		if err != nil {
+			panic(fmt.Errorf("creating Framework Wrapper for Resource %q: %+v", resourceType, err))
+		}
+		wrappers = append(wrappers, wrapper)
+	}
+
+	return &azureRmFrameworkProvider{
+		sdkProvider:      sdkProvider,
+		wrappedResources: wrappers,
+		nativeResources:  nativeResources,
+	}
+}
+
+func (p *azureRmFrameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "azurerm"
+}
+
+func (p *azureRmFrameworkProvider) Schema(ctx context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	sdkSchema, err := schema.NewGRPCProviderServer(p.sdkProvider).GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("retrieving the Plugin SDKv2 Provider Schema", err.Error())
+		return
+	}
+
+	providerSchema, err := sdk.FrameworkProviderSchema(sdkSchema.Provider)
+	if err != nil {
+		resp.Diagnostics.AddError("converting the Plugin SDKv2 Provider Schema", err.Error())
+		return
+	}
+
+	resp.Schema = *providerSchema
+}
+
+func (p *azureRmFrameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	// the Plugin SDKv2 Provider is configured separately (by the Mux Server) - so we pass this through and
+	// the Resources obtain the Clients from it when they're used, since the order isn't guaranteed
+	resp.ResourceData = p.sdkProvider
+	resp.DataSourceData = p.sdkProvider
+}
+
+func (p *azureRmFrameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (p *azureRmFrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	output := make([]func() resource.Resource, 0)
+	for _, v := range p.wrappedResources {
+		wrapper := v
+		output = append(output, func() resource.Resource {
+			// each instance is configured separately, so return a copy
+			instance := *wrapper
+			return &instance
+		})
+	}
+
+	return append(output, p.nativeResources...)
+}
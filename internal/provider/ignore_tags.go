@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func schemaIgnoreTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"keys": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"key_prefixes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandIgnoreTags(input []interface{}) (keys []string, keyPrefixes []string) {
+	keys = make([]string, 0)
+	keyPrefixes = make([]string, 0)
+	if len(input) == 0 || input[0] == nil {
+		return keys, keyPrefixes
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v, ok := raw["keys"].(*pluginsdk.Set); ok {
+		keys = *utils.ExpandStringSlice(v.List())
+	}
+	if v, ok := raw["key_prefixes"].(*pluginsdk.Set); ok {
+		keyPrefixes = *utils.ExpandStringSlice(v.List())
+	}
+
+	return keys, keyPrefixes
+}
+
+// supportsIgnoredTags returns whether the Ignored Tags from the Provider block should be removed from this Resource
+// or Data Source
+func supportsIgnoredTags(resource *pluginsdk.Resource) bool {
+	v, ok := resource.Schema["tags"]
+	return ok && v.Type == pluginsdk.TypeMap
+}
+
+// addIgnoredTagsToResource removes the tags matching the `ignore_tags` block in the Provider from the `tags`
+// field for a Resource (or Data Source) which doesn't support the Default Tags - for example where the tags are
+// Required or Computed - once these have been read.
+//
+// NOTE: the Ignored Tags are removed from Resources supporting the Default Tags alongside these (see
+// `addDefaultTagsToResource`), which also retains the Ignored Tags when the resource is updated
+func addIgnoredTagsToResource(resource *pluginsdk.Resource) {
+	// the deprecated (non-context aware) CRUD functions are still used by the majority of Resources
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if resource.Create != nil { //nolint:staticcheck
+		resource.Create = ignoredTagsFunc(resource.Create) //nolint:staticcheck
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = ignoredTagsContextFunc(resource.CreateContext)
+	}
+	if resource.Read != nil { //nolint:staticcheck
+		resource.Read = ignoredTagsFunc(resource.Read) //nolint:staticcheck
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = ignoredTagsContextFunc(resource.ReadContext)
+	}
+	if resource.Update != nil { //nolint:staticcheck
+		resource.Update = ignoredTagsFunc(resource.Update) //nolint:staticcheck
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = ignoredTagsContextFunc(resource.UpdateContext)
+	}
+}
+
+// ignoredTagsFunc wraps the CRUD function `in` - removing the Ignored Tags which aren't defined on the resource
+// from the `tags` field afterwards
+func ignoredTagsFunc(in func(d *pluginsdk.ResourceData, meta interface{}) error) func(d *pluginsdk.ResourceData, meta interface{}) error {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		configured := configuredTags(d)
+		if err := in(d, meta); err != nil {
+			return err
+		}
+
+		return setTagsExcludingIgnoredTags(d, meta, configured)
+	}
+}
+
+func ignoredTagsContextFunc(in func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		configured := configuredTags(d)
+		diags := in(ctx, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+
+		if err := setTagsExcludingIgnoredTags(d, meta, configured); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
+		return diags
+	}
+}
+
+// setTagsExcludingIgnoredTags removes the Ignored Tags which aren't defined within `configured` from the `tags` field
+func setTagsExcludingIgnoredTags(d *pluginsdk.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	// the resource has been removed
+	if d.Id() == "" {
+		return nil
+	}
+
+	_, ignoredTags := tagsConfigurationFromMeta(meta)
+	if err := d.Set("tags", ignoredTags.RemoveIgnoredTags(d.Get("tags").(map[string]interface{}), configured)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
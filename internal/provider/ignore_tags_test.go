@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestExpandIgnoreTags(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"keys":         pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"CreatedOnDate"}),
+			"key_prefixes": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"hidden-"}),
+		},
+	}
+
+	keys, keyPrefixes := expandIgnoreTags(input)
+	if expected := []string{"CreatedOnDate"}; !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("expected the keys to be %+v but got %+v", expected, keys)
+	}
+	if expected := []string{"hidden-"}; !reflect.DeepEqual(keyPrefixes, expected) {
+		t.Fatalf("expected the key prefixes to be %+v but got %+v", expected, keyPrefixes)
+	}
+
+	keys, keyPrefixes = expandIgnoreTags([]interface{}{})
+	if len(keys) != 0 || len(keyPrefixes) != 0 {
+		t.Fatalf("expected no ignored tags but got %+v and %+v", keys, keyPrefixes)
+	}
+}
+
+func TestIgnoredTagsRetainedOnUpdate(t *testing.T) {
+	ctx := context.TODO()
+	meta := &clients.Client{
+		IgnoredTags: tags.NewIgnoredTags([]string{"CreatedOnDate"}, nil),
+	}
+
+	// the tags assigned to the resource in Azure, which are replaced when the resource is updated
+	azureTags := map[string]*string{
+		"CreatedOnDate": utils.String("2023-01-01"),
+		"hello":         utils.String("world"),
+	}
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": tags.Schema(),
+		},
+		Read: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			return tags.FlattenAndSet(d, azureTags)
+		},
+		Update: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			azureTags = tags.Expand(d.Get("tags").(map[string]interface{}))
+			return tags.FlattenAndSet(d, azureTags)
+		},
+		Delete: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			return nil
+		},
+	}
+	addDefaultTagsToResource(resource)
+
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.NullVal(cty.String),
+		"tags": cty.MapVal(map[string]cty.Value{
+			"hello": cty.StringVal("there"),
+		}),
+		"tags_all": cty.NullVal(cty.Map(cty.String)),
+	})
+	config := terraform.NewResourceConfigShimmed(configVal, resource.CoreConfigSchema())
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                     "example",
+			"tags.%":                 "1",
+			"tags.hello":             "world",
+			"tags_all.%":             "2",
+			"tags_all.CreatedOnDate": "2023-01-01",
+			"tags_all.hello":         "world",
+		},
+		RawConfig: configVal,
+	}
+
+	diff, err := resource.Diff(ctx, state, config, meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	newState, diags := resource.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("applying: %+v", diags)
+	}
+
+	if v := azureTags["CreatedOnDate"]; v == nil || *v != "2023-01-01" {
+		t.Fatalf("expected the ignored tag `CreatedOnDate` to be retained in Azure but got %+v", azureTags)
+	}
+	if v := azureTags["hello"]; v == nil || *v != "there" {
+		t.Fatalf("expected the tag `hello` to be updated in Azure but got %+v", azureTags)
+	}
+
+	if _, ok := newState.Attributes["tags.CreatedOnDate"]; ok {
+		t.Fatalf("expected the ignored tag `CreatedOnDate` to be removed from `tags` but got %+v", newState.Attributes)
+	}
+	if v := newState.Attributes["tags_all.CreatedOnDate"]; v != "2023-01-01" {
+		t.Fatalf("expected the ignored tag `CreatedOnDate` to be in `tags_all` but got %+v", newState.Attributes)
+	}
+
+	// a subsequent plan should be empty
+	newState.RawConfig = configVal
+	diff, err = resource.Diff(ctx, newState, config, meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("expected no changes but got %+v", diff)
+	}
+}
+
+func TestIgnoredTagsDefinedOnResourceAreRetained(t *testing.T) {
+	ctx := context.TODO()
+	meta := &clients.Client{
+		IgnoredTags: tags.NewIgnoredTags([]string{"CreatedOnDate"}, nil),
+	}
+
+	azureTags := map[string]*string{
+		"CreatedOnDate": utils.String("2023-01-01"),
+		"hello":         utils.String("world"),
+	}
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": tags.Schema(),
+		},
+		Read: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			return tags.FlattenAndSet(d, azureTags)
+		},
+		Update: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			azureTags = tags.Expand(d.Get("tags").(map[string]interface{}))
+			return tags.FlattenAndSet(d, azureTags)
+		},
+		Delete: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			return nil
+		},
+	}
+	addDefaultTagsToResource(resource)
+
+	// the ignored tag `CreatedOnDate` is also defined on the resource
+	configVal := cty.ObjectVal(map[string]cty.Value{
+		"id": cty.NullVal(cty.String),
+		"tags": cty.MapVal(map[string]cty.Value{
+			"CreatedOnDate": cty.StringVal("2024-01-01"),
+			"hello":         cty.StringVal("world"),
+		}),
+		"tags_all": cty.NullVal(cty.Map(cty.String)),
+	})
+	config := terraform.NewResourceConfigShimmed(configVal, resource.CoreConfigSchema())
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                     "example",
+			"tags.%":                 "1",
+			"tags.hello":             "world",
+			"tags_all.%":             "2",
+			"tags_all.CreatedOnDate": "2023-01-01",
+			"tags_all.hello":         "world",
+		},
+		RawConfig: configVal,
+	}
+
+	diff, err := resource.Diff(ctx, state, config, meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	newState, diags := resource.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("applying: %+v", diags)
+	}
+
+	if v := azureTags["CreatedOnDate"]; v == nil || *v != "2024-01-01" {
+		t.Fatalf("expected the tag `CreatedOnDate` to be updated in Azure but got %+v", azureTags)
+	}
+	if v := newState.Attributes["tags.CreatedOnDate"]; v != "2024-01-01" {
+		t.Fatalf("expected the tag `CreatedOnDate` to be retained in `tags` but got %+v", newState.Attributes)
+	}
+
+	// the tag remains in `tags` when the resource is refreshed, so a subsequent plan should be empty
+	newState, diags = resource.RefreshWithoutUpgrade(ctx, newState, meta)
+	if diags.HasError() {
+		t.Fatalf("refreshing: %+v", diags)
+	}
+	if v := newState.Attributes["tags.CreatedOnDate"]; v != "2024-01-01" {
+		t.Fatalf("expected the tag `CreatedOnDate` to be retained in `tags` when refreshed but got %+v", newState.Attributes)
+	}
+
+	newState.RawConfig = configVal
+	diff, err = resource.Diff(ctx, newState, config, meta)
+	if err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("expected no changes but got %+v", diff)
+	}
+}
+
+func TestIgnoredTagsRemovedFromComputedTags(t *testing.T) {
+	ctx := context.TODO()
+	meta := &clients.Client{
+		IgnoredTags: tags.NewIgnoredTags(nil, []string{"hidden-"}),
+	}
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": tags.SchemaDataSource(),
+		},
+		Read: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			return tags.FlattenAndSet(d, map[string]*string{
+				"hidden-owner": utils.String("defender"),
+				"hello":        utils.String("world"),
+			})
+		},
+		Delete: func(d *pluginsdk.ResourceData, _ interface{}) error {
+			return nil
+		},
+	}
+	if supportsDefaultTags(resource) || !supportsIgnoredTags(resource) {
+		t.Fatalf("expected only the Ignored Tags to be supported for a resource with Computed tags")
+	}
+	addIgnoredTagsToResource(resource)
+
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id": "example",
+		},
+	}
+	newState, diags := resource.RefreshWithoutUpgrade(ctx, state, meta)
+	if diags.HasError() {
+		t.Fatalf("refreshing: %+v", diags)
+	}
+
+	expected := map[string]string{
+		"id":         "example",
+		"tags.%":     "1",
+		"tags.hello": "world",
+	}
+	if !reflect.DeepEqual(newState.Attributes, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, newState.Attributes)
+	}
+}
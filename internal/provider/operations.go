@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// addOperationTrackingToResource ensures that each Create/Read/Update/Delete operation for an Untyped Data Source or
+// Resource uses its own Correlation Request ID - and that the Correlation Request ID, Request ID and Error Code for
+// the operation are appended to any error returned to Terraform (Typed Resources handle this in the `sdk` package) -
+// where the error was returned from Azure the Resource Manager error is decoded into the diagnostic. The values of
+// any Sensitive fields are also redacted from the requests and responses logged during the operation, and (when tracing
+// is enabled) a Span is recorded for the operation.
+//
+// Since the (deprecated) non-context aware CRUD functions derive their context from the Client's StopContext, these
+// are converted into their context aware equivalents which are passed a copy of the Client containing the Operation.
+func addOperationTrackingToResource(resourceType string, resource *pluginsdk.Resource) {
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if resource.Create != nil { //nolint:staticcheck
+		resource.CreateContext = operationTrackingFunc(resourceType, "Create", resource.Schema, resource.Create) //nolint:staticcheck
+		resource.Create = nil                                                                                    //nolint:staticcheck
+	} else if resource.CreateContext != nil {
+		resource.CreateContext = operationTrackingContextFunc(resourceType, "Create", resource.Schema, resource.CreateContext)
+	}
+
+	if resource.Read != nil { //nolint:staticcheck
+		resource.ReadContext = operationTrackingFunc(resourceType, "Read", resource.Schema, resource.Read) //nolint:staticcheck
+		resource.Read = nil                                                                                //nolint:staticcheck
+	} else if resource.ReadContext != nil {
+		resource.ReadContext = operationTrackingContextFunc(resourceType, "Read", resource.Schema, resource.ReadContext)
+	}
+
+	if resource.Update != nil { //nolint:staticcheck
+		resource.UpdateContext = operationTrackingFunc(resourceType, "Update", resource.Schema, resource.Update) //nolint:staticcheck
+		resource.Update = nil                                                                                    //nolint:staticcheck
+	} else if resource.UpdateContext != nil {
+		resource.UpdateContext = operationTrackingContextFunc(resourceType, "Update", resource.Schema, resource.UpdateContext)
+	}
+
+	if resource.Delete != nil { //nolint:staticcheck
+		resource.DeleteContext = operationTrackingFunc(resourceType, "Delete", resource.Schema, resource.Delete) //nolint:staticcheck
+		resource.Delete = nil                                                                                    //nolint:staticcheck
+	} else if resource.DeleteContext != nil {
+		resource.DeleteContext = operationTrackingContextFunc(resourceType, "Delete", resource.Schema, resource.DeleteContext)
+	}
+}
+
+func operationTrackingFunc(resourceType, operationName string, resourceSchema map[string]*pluginsdk.Schema, in func(d *pluginsdk.ResourceData, meta interface{}) error) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		// the non-context aware functions derive their context from the StopContext, so this is replaced
+		// on a (shallow) copy of the Client to make the Operation (and the Resource Type, which is used to
+		// determine the Default Timeouts) available
+		client := *meta.(*clients.Client)
+		stopContext, operation := common.WithOperation(timeouts.WithDefaultTimeouts(client.StopContext, resourceType, client.DefaultTimeouts))
+		operation.AddSensitiveValues(resourceSchema, d)
+		stopContext, endSpan := common.StartOperationSpan(stopContext, resourceType, operationName, d)
+		client.StopContext = stopContext
+
+		err := in(d, &client)
+		endSpan(err)
+		if err != nil {
+			return diag.Diagnostics{operation.ErrorDiagnostic(err, resourceSchema)}
+		}
+
+		return nil
+	}
+}
+
+func operationTrackingContextFunc(resourceType, operationName string, resourceSchema map[string]*pluginsdk.Schema, in func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		ctx, operation := common.WithOperation(ctx)
+		operation.AddSensitiveValues(resourceSchema, d)
+		ctx, endSpan := common.StartOperationSpan(ctx, resourceType, operationName, d)
+
+		diags := in(ctx, d, meta)
+		endSpan(diagnosticsError(diags))
+		for i, v := range diags {
+			if v.Severity == diag.Error {
+				diags[i] = operation.EnrichDiagnostic(v, resourceSchema)
+			}
+		}
+
+		return diags
+	}
+}
+
+// diagnosticsError returns an error summarising the error Diagnostics (if any), for recording against the Span
+func diagnosticsError(diags diag.Diagnostics) error {
+	for _, v := range diags {
+		if v.Severity == diag.Error {
+			return fmt.Errorf("%s", v.Summary)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestAddOperationTrackingToResource(t *testing.T) {
+	var operation *common.Operation
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{},
+		Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			operation = common.OperationFromContext(meta.(*clients.Client).StopContext)
+			return fmt.Errorf("retrieving Example")
+		},
+	}
+
+	addOperationTrackingToResource("azurerm_example", resource)
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if resource.Read != nil || resource.ReadContext == nil { //nolint:staticcheck
+		t.Fatalf("expected the Read function to be converted into a ReadContext function")
+	}
+
+	client := &clients.Client{
+		StopContext: context.TODO(),
+	}
+	diags := resource.ReadContext(context.TODO(), resource.TestResourceData(), client)
+	if operation == nil {
+		t.Fatalf("expected the Client's StopContext to contain an Operation")
+	}
+	if common.OperationFromContext(client.StopContext) != nil {
+		t.Fatalf("expected the Operation not to be added to the shared Client")
+	}
+	if !diags.HasError() || diags[0].Summary != "retrieving Example" {
+		t.Fatalf("expected an error diagnostic but got %+v", diags)
+	}
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/base64"
 	"fmt"
 	"log"
@@ -14,8 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -120,6 +123,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Errorf("creating Wrapper for Data Source %q: %+v", key, err))
 			}
 
+			addResourceProviderRegistrationToResource(dataSource, resourceProviderRequirements(service, key))
 			dataSources[key] = dataSource
 		}
 
@@ -135,6 +139,10 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 			if err != nil {
 				panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", key, err))
 			}
+			addResourceProviderRegistrationToResource(resource, resourceProviderRequirements(service, key))
+			if sdk.SupportsSubscriptionTargeting(r) {
+				addSubscriptionTargetingToResource(key, resource)
+			}
 			resources[key] = resource
 		}
 	}
@@ -147,6 +155,8 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Data Source exists for %q", k))
 			}
 
+			addResourceProviderRegistrationToResource(v, resourceProviderRequirements(service, k))
+			addOperationTrackingToResource(k, v)
 			dataSources[k] = v
 		}
 
@@ -156,10 +166,38 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			addResourceProviderRegistrationToResource(v, resourceProviderRequirements(service, k))
+			addOperationTrackingToResource(k, v)
 			resources[k] = v
 		}
 	}
 
+	// redact the sensitive values for each Service from the requests and responses which are logged
+	common.ConfigureRedactionRules(redactionRulesForServices())
+
+	// expose `tags_all` for each Resource supporting tags, so that the Default Tags can be applied - otherwise
+	// only the Ignored Tags are removed from the tags
+	for _, resource := range resources {
+		if supportsDefaultTags(resource) {
+			addDefaultTagsToResource(resource)
+		} else if supportsIgnoredTags(resource) {
+			addIgnoredTagsToResource(resource)
+		}
+	}
+	for _, dataSource := range dataSources {
+		if supportsIgnoredTags(dataSource) {
+			addIgnoredTagsToResource(dataSource)
+		}
+	}
+
+	// build the context for each operation using the Default Timeouts from the Provider block (where defined)
+	for k, v := range dataSources {
+		addDefaultTimeoutsToResource(k, v)
+	}
+	for k, v := range resources {
+		addDefaultTimeoutsToResource(k, v)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -319,6 +357,12 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"default_timeouts": schemaDefaultTimeouts(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -327,6 +371,46 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"resource_provider_registrations": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", ""),
+				ValidateFunc: validation.StringInSlice([]string{
+					resourceProviderRegistrationsAll,
+					resourceProviderRegistrationsUsed,
+					resourceProviderRegistrationsNone,
+				}, false),
+				Description: "Which Resource Providers should be registered by the AzureRM Provider? Possible values are `all` (registers all of the Resource Providers supported by the Provider when it's configured), `used` (registers the Resource Providers required by each Data Source and Resource when it's first used) and `none`.",
+			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				Description: "A list of additional Resource Providers which should be registered when the AzureRM Provider is configured.",
+			},
+
+			"preview_features_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePreviewFeature,
+				},
+				Description: "A list of Preview Features (in the format `Namespace/FeatureName`) which should be registered when the AzureRM Provider is configured.",
+			},
+
+			"max_requests_per_second": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_REQUESTS_PER_SECOND", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of requests per second which should be sent to Resource Manager for this Subscription. Defaults to `0` (unlimited).",
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -339,12 +423,14 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		ResourcesMap:   resources,
 	}
 
-	p.ConfigureContextFunc = providerConfigure(p)
+	p.ConfigureContextFunc = providerConfigure(p, nil)
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+// providerConfigure returns the ConfigureContextFunc for the Provider - the Root Certificates are only
+// specified in testing, where these are trusted by the HTTP transport used by autorest
+func providerConfigure(p *schema.Provider, rootCertificates *x509.CertPool) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -417,21 +503,26 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			EnableAuthenticationUsingGitHubOIDC:        enableOidc,
 		}
 
-		return buildClient(ctx, p, d, authConfig)
+		return buildClient(ctx, p, d, authConfig, rootCertificates)
 	}
 }
 
-func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
-	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials, rootCertificates *x509.CertPool) (*clients.Client, diag.Diagnostics) {
+	registrationMode, err := resourceProviderRegistrationMode(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
+		MaxRequestsPerSecond:        d.Get("max_requests_per_second").(int),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
-		SkipProviderRegistration:    skipProviderRegistration,
+		RootCertificates:            rootCertificates,
+		SkipProviderRegistration:    registrationMode == resourceProviderRegistrationsNone,
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
@@ -453,8 +544,11 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	}
 
 	client.StopContext = stopCtx
+	client.DefaultTags = expandDefaultTags(d.Get("default_tags").([]interface{}))
+	client.IgnoredTags = tags.NewIgnoredTags(expandIgnoreTags(d.Get("ignore_tags").([]interface{})))
+	client.DefaultTimeouts = expandDefaultTimeouts(d.Get("default_timeouts").([]interface{}))
 
-	if !skipProviderRegistration {
+	if registrationMode == resourceProviderRegistrationsAll {
 		// List all the available providers and their registration state to avoid unnecessary
 		// requests. This also lets us check if the provider credentials are correct.
 		providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
@@ -472,6 +566,17 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		}
 	}
 
+	registrar := resourceproviders.NewRegistrar(client.Resource.ProvidersClient, client.Resource.FeaturesClient)
+	if registrationMode == resourceProviderRegistrationsUsed {
+		client.ResourceProviderRegistrar = registrar
+	}
+
+	if explicit := expandExplicitResourceProviderRequirements(d); len(explicit.ResourceProviders) > 0 || len(explicit.PreviewFeatures) > 0 {
+		if err := registrar.EnsureRegistered(ctx, explicit); err != nil {
+			return nil, diag.Errorf(resourceProviderRegistrationErrorFmt, err)
+		}
+	}
+
 	return client, nil
 }
 
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// ProtoV5ProviderServerFactory returns a Provider Server which muxes the Plugin SDKv2 Provider
+// and the Plugin Framework Provider, which serves any Typed Resources opted into the Plugin Framework
+// alongside any Resources implemented natively using the Plugin Framework
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	return protoV5ProviderServerFactory(ctx, AzureProvider())
+}
+
+// TestProtoV5ProviderServerFactory returns a muxed Provider Server for use in the Acceptance Tests - where
+// the Root Certificates (if specified) are trusted by the HTTP transport used by autorest
+func TestProtoV5ProviderServerFactory(ctx context.Context, rootCertificates *x509.CertPool) (func() tfprotov5.ProviderServer, error) {
+	sdkProvider := TestAzureProvider()
+	if rootCertificates != nil {
+		sdkProvider.ConfigureContextFunc = providerConfigure(sdkProvider, rootCertificates)
+	}
+
+	return protoV5ProviderServerFactory(ctx, sdkProvider)
+}
+
+func protoV5ProviderServerFactory(ctx context.Context, sdkProvider *schema.Provider) (func() tfprotov5.ProviderServer, error) {
+	// Resources opted into the Plugin Framework are registered in the Plugin SDKv2 Provider (so that these are
+	// available to the tooling) - but must only be served by the Plugin Framework Provider
+	wrappedResources := make(map[string]*schema.Resource)
+	wrappedResourceTypes := make([]string, 0)
+	for _, resourceType := range frameworkResourceTypes() {
+		r, ok := sdkProvider.ResourcesMap[resourceType]
+		if !ok {
+			return nil, fmt.Errorf("the Resource %q was not registered in the Plugin SDKv2 Provider", resourceType)
+		}
+
+		wrappedResources[resourceType] = r
+		wrappedResourceTypes = append(wrappedResourceTypes, resourceType)
+		delete(sdkProvider.ResourcesMap, resourceType)
+	}
+
+	frameworkProvider := framework.NewFrameworkProvider(sdkProvider, wrappedResources, frameworkNativeResources())
+
+	servers := []func() tfprotov5.ProviderServer{
+		func() tfprotov5.ProviderServer {
+			return schema.NewGRPCProviderServer(sdkProvider)
+		},
+		sdk.NewFrameworkPrivateStateServer(providerserver.NewProtocol5(frameworkProvider), wrappedResourceTypes),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, fmt.Errorf("building Mux Server: %+v", err)
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkResourceTypes returns the Typed Resources which should be served by the Plugin Framework
+func frameworkResourceTypes() []string {
+	resourceTypes := make([]string, 0)
+	for _, service := range SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			if sdk.IsServedByPluginFramework(r) {
+				resourceTypes = append(resourceTypes, r.ResourceType())
+			}
+		}
+	}
+
+	return resourceTypes
+}
+
+// frameworkNativeResources returns the Resources implemented natively using the Plugin Framework
+func frameworkNativeResources() []func() resource.Resource {
+	resources := make([]func() resource.Resource, 0)
+	for _, service := range SupportedTypedServices() {
+		if v, ok := service.(sdk.ServiceRegistrationWithFrameworkResources); ok {
+			resources = append(resources, v.FrameworkResources()...)
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		if v, ok := service.(sdk.ServiceRegistrationWithFrameworkResources); ok {
+			resources = append(resources, v.FrameworkResources()...)
+		}
+	}
+
+	return resources
+}
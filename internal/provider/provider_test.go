@@ -10,11 +10,13 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 func TestProvider(t *testing.T) {
@@ -77,10 +79,10 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 			// every Resource has to have a Create, Read & Destroy timeout
 
 			//lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
-			if resource.Timeouts.Create == nil && resource.Create != nil { //nolint:staticcheck
+			if resource.Timeouts.Create == nil && (resource.Create != nil || resource.CreateContext != nil) { //nolint:staticcheck
 				t.Fatalf("Resource %q defines a Create method but no Create Timeout", resourceName)
 			}
-			if resource.Timeouts.Delete == nil && resource.Delete != nil { //nolint:staticcheck
+			if resource.Timeouts.Delete == nil && (resource.Delete != nil || resource.DeleteContext != nil) { //nolint:staticcheck
 				t.Fatalf("Resource %q defines a Delete method but no Delete Timeout", resourceName)
 			}
 			if resource.Timeouts.Read == nil {
@@ -98,17 +100,83 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 			}
 
 			// Optional
-			if resource.Timeouts.Update == nil && resource.Update != nil { //nolint:staticcheck
+			if resource.Timeouts.Update == nil && (resource.Update != nil || resource.UpdateContext != nil) { //nolint:staticcheck
 				t.Fatalf("Resource %q defines a Update method but no Update Timeout", resourceName)
 			}
 		})
 	}
 }
 
+func TestResourcesExposeTheirImporterIDValidationFunc(t *testing.T) {
+	// Importers which don't validate the Resource ID prior to import
+	importersWithoutValidation := map[string]struct{}{
+		"azurerm_security_center_server_vulnerability_assessment": {},
+	}
+
+	provider := TestAzureProvider()
+	for resourceName, resource := range provider.ResourcesMap {
+		if resource.Importer == nil {
+			continue
+		}
+
+		if _, ok := pluginsdk.ImporterIDValidationFunc(resource.Importer); !ok {
+			if _, ok := importersWithoutValidation[resourceName]; !ok {
+				t.Fatalf("the Importer for Resource %q doesn't expose the function used to validate the Resource ID - use `pluginsdk.ImporterValidatingResourceId`", resourceName)
+			}
+		}
+	}
+}
+
+func TestResourcesWriteOnlyFieldsAreNotComputed(t *testing.T) {
+	var validate func(resourceName string, input map[string]*pluginsdk.Schema)
+	validate = func(resourceName string, input map[string]*pluginsdk.Schema) {
+		for fieldName, field := range input {
+			if pluginsdk.IsWriteOnly(field) && field.Computed {
+				t.Fatalf("the field %q within Resource %q is marked as Write-Only but is Computed - since the value is never returned it shouldn't be Computed", fieldName, resourceName)
+			}
+			if block, ok := field.Elem.(*pluginsdk.Resource); ok {
+				validate(resourceName, block.Schema)
+			}
+		}
+	}
+
+	provider := TestAzureProvider()
+	for resourceName, resource := range provider.ResourcesMap {
+		validate(resourceName, resource.Schema)
+	}
+}
+
 func TestProvider_impl(t *testing.T) {
 	_ = AzureProvider()
 }
 
+func TestProvider_muxServer(t *testing.T) {
+	ctx := context.TODO()
+	providerServer, err := TestProtoV5ProviderServerFactory(ctx, nil)
+	if err != nil {
+		t.Fatalf("building Mux Server: %+v", err)
+	}
+
+	resp, err := providerServer().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("retrieving Provider Schema: %+v", err)
+	}
+	for _, v := range resp.Diagnostics {
+		if v.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("retrieving Provider Schema: %s: %s", v.Summary, v.Detail)
+		}
+	}
+}
+
+func TestProvider_maxRequestsPerSecondFromEnvironment(t *testing.T) {
+	t.Setenv("ARM_MAX_REQUESTS_PER_SECOND", "20")
+
+	d := schema.TestResourceDataRaw(t, AzureProvider().Schema, map[string]interface{}{})
+	if actual := d.Get("max_requests_per_second").(int); actual != 20 {
+		t.Fatalf("expected `max_requests_per_second` to be 20 but got %d", actual)
+	}
+}
+
 func TestProvider_counts(t *testing.T) {
 	// @tombuildsstuff: this is less a unit test and more a useful placeholder tbh
 	provider := TestAzureProvider()
@@ -142,7 +210,7 @@ func TestAccProvider_cliAuth(t *testing.T) {
 			EnableAuthenticatingUsingAzureCLI: true,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -204,7 +272,7 @@ func TestAccProvider_clientCertificateAuth(t *testing.T) {
 			ClientCertificatePassword:                  d.Get("client_certificate_password").(string),
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -252,7 +320,7 @@ func TestAccProvider_clientSecretAuth(t *testing.T) {
 			ClientSecret:                          d.Get("client_secret").(string),
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -302,7 +370,7 @@ func TestAccProvider_genericOidcAuth(t *testing.T) {
 			OIDCAssertionToken:            *oidcToken,
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
@@ -351,7 +419,7 @@ func TestAccProvider_githubOidcAuth(t *testing.T) {
 			GitHubOIDCTokenRequestURL:           d.Get("oidc_request_url").(string),
 		}
 
-		return buildClient(ctx, provider, d, authConfig)
+		return buildClient(ctx, provider, d, authConfig, nil)
 	}
 
 	d := provider.Configure(ctx, terraform.NewResourceConfigRaw(nil))
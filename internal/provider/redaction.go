@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// redactionRulesForServices returns the rules used to redact sensitive values from the requests and responses
+// logged for each Service - since a Service can be both Typed and Untyped, each Service is only included once
+func redactionRulesForServices() common.RedactionRules {
+	services := make(map[string]interface{})
+	for _, service := range SupportedTypedServices() {
+		services[service.Name()] = service
+	}
+	for _, service := range SupportedUntypedServices() {
+		services[service.Name()] = service
+	}
+
+	rules := make(common.RedactionRules, 0)
+	for _, service := range services {
+		if v, ok := service.(sdk.ServiceRegistrationWithRedactionRules); ok {
+			rules = append(rules, v.RedactionRules()...)
+		}
+	}
+
+	return rules
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	// resourceProviderRegistrationsAll registers all of the Resource Providers supported by the Provider
+	// when the Provider is configured (see `resourceproviders.Required`)
+	resourceProviderRegistrationsAll = "all"
+
+	// resourceProviderRegistrationsUsed registers the Resource Providers (and Preview Features) required
+	// by each Data Source and Resource the first time it's used
+	resourceProviderRegistrationsUsed = "used"
+
+	// resourceProviderRegistrationsNone doesn't register any Resource Providers, other than those which
+	// are explicitly specified in the Provider block
+	resourceProviderRegistrationsNone = "none"
+)
+
+// resourceProviderRegistrationMode returns the mode used to register Resource Providers, which falls back to
+// the legacy `skip_provider_registration` field when `resource_provider_registrations` isn't specified
+func resourceProviderRegistrationMode(d *schema.ResourceData) (string, error) {
+	skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+
+	mode := d.Get("resource_provider_registrations").(string)
+	if mode == "" {
+		if skipProviderRegistration {
+			return resourceProviderRegistrationsNone, nil
+		}
+
+		return resourceProviderRegistrationsAll, nil
+	}
+
+	if skipProviderRegistration && mode != resourceProviderRegistrationsNone {
+		return "", fmt.Errorf("`skip_provider_registration` cannot be enabled when `resource_provider_registrations` is set to %q", mode)
+	}
+
+	return mode, nil
+}
+
+// expandExplicitResourceProviderRequirements returns the Resource Providers and Preview Features which
+// have been explicitly specified in the Provider block
+func expandExplicitResourceProviderRequirements(d *schema.ResourceData) resourceproviders.Requirements {
+	return resourceproviders.Requirements{
+		ResourceProviders: *utils.ExpandStringSlice(d.Get("resource_providers_to_register").([]interface{})),
+		PreviewFeatures:   *utils.ExpandStringSlice(d.Get("preview_features_to_register").([]interface{})),
+	}
+}
+
+func validatePreviewFeature(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, _, err := resourceproviders.ParsePreviewFeature(v); err != nil {
+		return nil, []error{fmt.Errorf("%q: %+v", k, err)}
+	}
+
+	return nil, nil
+}
+
+// resourceProviderRequirements returns the Resource Providers and Preview Features which must be registered
+// to use the specified Data Source or Resource, as defined by the Service Registration containing it
+func resourceProviderRequirements(service interface{}, resourceType string) resourceproviders.Requirements {
+	requirements := resourceproviders.Requirements{}
+	if v, ok := service.(sdk.ServiceRegistrationWithResourceProviders); ok {
+		requirements.ResourceProviders = v.ResourceProviders()
+	}
+	if v, ok := service.(sdk.ServiceRegistrationWithPreviewFeatures); ok {
+		requirements.PreviewFeatures = v.PreviewFeatures()[resourceType]
+	}
+
+	return requirements
+}
+
+// addResourceProviderRegistrationToResource ensures that the Resource Providers and Preview Features required by
+// this Data Source/Resource are registered prior to it being created, read or updated - this is a no-op unless the
+// Provider is configured to register the Resource Providers which are used
+func addResourceProviderRegistrationToResource(resource *pluginsdk.Resource, requirements resourceproviders.Requirements) {
+	if len(requirements.ResourceProviders) == 0 && len(requirements.PreviewFeatures) == 0 {
+		return
+	}
+
+	// the deprecated (non-context aware) CRUD functions are still used by the majority of Resources
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if resource.Create != nil { //nolint:staticcheck
+		resource.Create = resourceProviderRegistrationFunc(resource.Create, requirements) //nolint:staticcheck
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = resourceProviderRegistrationContextFunc(resource.CreateContext, requirements)
+	}
+	if resource.Read != nil { //nolint:staticcheck
+		resource.Read = resourceProviderRegistrationFunc(resource.Read, requirements) //nolint:staticcheck
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = resourceProviderRegistrationContextFunc(resource.ReadContext, requirements)
+	}
+	if resource.Update != nil { //nolint:staticcheck
+		resource.Update = resourceProviderRegistrationFunc(resource.Update, requirements) //nolint:staticcheck
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = resourceProviderRegistrationContextFunc(resource.UpdateContext, requirements)
+	}
+}
+
+func resourceProviderRegistrationFunc(in func(d *pluginsdk.ResourceData, meta interface{}) error, requirements resourceproviders.Requirements) func(d *pluginsdk.ResourceData, meta interface{}) error {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		client := meta.(*clients.Client)
+		if err := ensureResourceProvidersRegistered(client.StopContext, client, requirements); err != nil {
+			return err
+		}
+
+		return in(d, meta)
+	}
+}
+
+func resourceProviderRegistrationContextFunc(in func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics, requirements resourceproviders.Requirements) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := ensureResourceProvidersRegistered(ctx, meta.(*clients.Client), requirements); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return in(ctx, d, meta)
+	}
+}
+
+func ensureResourceProvidersRegistered(ctx context.Context, client *clients.Client, requirements resourceproviders.Requirements) error {
+	if client.ResourceProviderRegistrar == nil {
+		return nil
+	}
+
+	if err := client.ResourceProviderRegistrar.EnsureRegistered(ctx, requirements); err != nil {
+		return fmt.Errorf(resourceProviderRegistrationErrorFmt, err)
+	}
+
+	return nil
+}
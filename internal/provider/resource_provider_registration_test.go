@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestServicesDefineResourceProviders(t *testing.T) {
+	services := make(map[string]interface{})
+	for _, service := range SupportedTypedServices() {
+		services[service.Name()] = service
+	}
+	for _, service := range SupportedUntypedServices() {
+		services[service.Name()] = service
+	}
+
+	for name, service := range services {
+		t.Logf("Service %q..", name)
+		v, ok := service.(sdk.ServiceRegistrationWithResourceProviders)
+		if !ok {
+			t.Fatalf("the Service %q doesn't define the Resource Providers it requires", name)
+		}
+
+		if len(v.ResourceProviders()) == 0 {
+			t.Fatalf("the Service %q doesn't define any Resource Providers", name)
+		}
+	}
+}
+
+func TestRequiredResourceProvidersAreDefinedByServices(t *testing.T) {
+	defined := make(map[string]struct{})
+	for _, service := range SupportedTypedServices() {
+		if v, ok := service.(sdk.ServiceRegistrationWithResourceProviders); ok {
+			for _, rp := range v.ResourceProviders() {
+				defined[rp] = struct{}{}
+			}
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		if v, ok := service.(sdk.ServiceRegistrationWithResourceProviders); ok {
+			for _, rp := range v.ResourceProviders() {
+				defined[rp] = struct{}{}
+			}
+		}
+	}
+
+	for rp := range resourceproviders.Required() {
+		if _, ok := defined[rp]; !ok {
+			t.Fatalf("the Resource Provider %q is required but isn't defined by any Service", rp)
+		}
+	}
+}
+
+func TestServicePreviewFeaturesAreValid(t *testing.T) {
+	resources := AzureProvider().ResourcesMap
+	for _, service := range SupportedUntypedServices() {
+		v, ok := service.(sdk.ServiceRegistrationWithPreviewFeatures)
+		if !ok {
+			continue
+		}
+
+		t.Logf("Service %q..", service.Name())
+		for resourceType, previewFeatures := range v.PreviewFeatures() {
+			if _, ok := resources[resourceType]; !ok {
+				t.Fatalf("the Service %q defines Preview Features for the Resource %q which doesn't exist", service.Name(), resourceType)
+			}
+
+			for _, feature := range previewFeatures {
+				if _, _, err := resourceproviders.ParsePreviewFeature(feature); err != nil {
+					t.Fatalf("parsing the Preview Feature for %q: %+v", resourceType, err)
+				}
+			}
+		}
+	}
+}
+
+func TestResourceProviderRegistrationMode(t *testing.T) {
+	t.Setenv("ARM_SKIP_PROVIDER_REGISTRATION", "")
+	t.Setenv("ARM_RESOURCE_PROVIDER_REGISTRATIONS", "")
+
+	testCases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+		error    bool
+	}{
+		{
+			name:     "default",
+			raw:      map[string]interface{}{},
+			expected: resourceProviderRegistrationsAll,
+		},
+		{
+			name: "skip provider registration",
+			raw: map[string]interface{}{
+				"skip_provider_registration": true,
+			},
+			expected: resourceProviderRegistrationsNone,
+		},
+		{
+			name: "used",
+			raw: map[string]interface{}{
+				"resource_provider_registrations": resourceProviderRegistrationsUsed,
+			},
+			expected: resourceProviderRegistrationsUsed,
+		},
+		{
+			name: "skip provider registration and none",
+			raw: map[string]interface{}{
+				"skip_provider_registration":      true,
+				"resource_provider_registrations": resourceProviderRegistrationsNone,
+			},
+			expected: resourceProviderRegistrationsNone,
+		},
+		{
+			name: "skip provider registration and used",
+			raw: map[string]interface{}{
+				"skip_provider_registration":      true,
+				"resource_provider_registrations": resourceProviderRegistrationsUsed,
+			},
+			error: true,
+		},
+	}
+
+	providerSchema := AzureProvider().Schema
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.name)
+
+		d := schema.TestResourceDataRaw(t, providerSchema, testCase.raw)
+		actual, err := resourceProviderRegistrationMode(d)
+		if testCase.error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if actual != testCase.expected {
+			t.Fatalf("expected %q but got %q", testCase.expected, actual)
+		}
+	}
+}
+
+func TestResourceProviderRegistrationIsSkippedWithoutRegistrar(t *testing.T) {
+	called := false
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{},
+		Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			called = true
+			return nil
+		},
+	}
+
+	addResourceProviderRegistrationToResource(resource, resourceproviders.Requirements{
+		ResourceProviders: []string{"Microsoft.Example"},
+	})
+
+	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golanci-lint
+	if err := resource.Read(resource.TestResourceData(), &clients.Client{}); err != nil { //nolint:staticcheck
+		t.Fatalf("reading: %+v", err)
+	}
+
+	if !called {
+		t.Fatalf("expected the Read function to be called")
+	}
+}
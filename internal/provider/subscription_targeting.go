@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const subscriptionTargetingKey = "subscription_id"
+
+// addSubscriptionTargetingToResource exposes an optional `subscription_id` argument for a Resource which can be
+// managed within a Subscription other than the one the Provider is configured for (see the
+// `sdk.ResourceWithSubscriptionTargeting` interface), and ensures that each operation for the Resource is passed
+// the Client for the matching Subscription.
+//
+// The Subscription is determined from the Resource ID when it's available (e.g. during Read, Update, Delete and
+// Import) and otherwise from the `subscription_id` argument - as such this must be applied after the Resource
+// Provider Registration, so that any Resource Providers are registered within the matching Subscription.
+func addSubscriptionTargetingToResource(resourceType string, resource *pluginsdk.Resource) {
+	if _, exists := resource.Schema[subscriptionTargetingKey]; exists {
+		panic(fmt.Sprintf("Resource %q supports Subscription Targeting but already defines the field %q", resourceType, subscriptionTargetingKey))
+	}
+
+	resource.Schema[subscriptionTargetingKey] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsUUID,
+		Description:  "The ID of the Subscription where this Resource should exist. Defaults to the Subscription the Provider is configured for.",
+	}
+
+	if resource.CreateContext != nil {
+		resource.CreateContext = subscriptionTargetingContextFunc(resource.CreateContext)
+	}
+	if resource.ReadContext != nil {
+		resource.ReadContext = subscriptionTargetingContextFunc(resource.ReadContext)
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = subscriptionTargetingContextFunc(resource.UpdateContext)
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = subscriptionTargetingContextFunc(resource.DeleteContext)
+	}
+
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		resource.Importer = pluginsdk.ImporterWrapping(resource.Importer, func(in pluginsdk.ImporterFunc) pluginsdk.ImporterFunc {
+			return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+				client, err := clientForTargetSubscription(ctx, d, meta)
+				if err != nil {
+					return nil, err
+				}
+
+				return in(ctx, d, client)
+			}
+		})
+	}
+}
+
+func subscriptionTargetingContextFunc(in func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		client, err := clientForTargetSubscription(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		diags := in(ctx, d, client)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		// the Subscription is set into the state from the Resource ID, so that this is available when imported
+		subscriptionId, err := targetSubscriptionId(d)
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		if err := d.Set(subscriptionTargetingKey, subscriptionId); err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("setting `%s`: %+v", subscriptionTargetingKey, err))...)
+		}
+
+		return diags
+	}
+}
+
+// clientForTargetSubscription returns the Client for the Subscription this Resource exists (or should exist) within
+func clientForTargetSubscription(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) (*clients.Client, error) {
+	subscriptionId, err := targetSubscriptionId(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return meta.(*clients.Client).ForSubscription(ctx, subscriptionId)
+}
+
+// targetSubscriptionId returns the Subscription ID from the Resource ID when this is available, otherwise from
+// the `subscription_id` argument - where neither is available an empty string is returned, meaning the Subscription
+// the Provider is configured for should be used
+func targetSubscriptionId(d *pluginsdk.ResourceData) (string, error) {
+	if id := d.Id(); id != "" {
+		parsed, err := resourceids.ParseAzureResourceID(id)
+		if err != nil {
+			return "", fmt.Errorf("parsing the Subscription ID from the Resource ID %q: %+v", id, err)
+		}
+
+		return parsed.SubscriptionID, nil
+	}
+
+	return d.Get(subscriptionTargetingKey).(string), nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestAddSubscriptionTargetingToResource(t *testing.T) {
+	var used *clients.Client
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		CreateContext: func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			used = meta.(*clients.Client)
+			d.SetId("/subscriptions/" + used.Account.SubscriptionId + "/providers/Microsoft.Example")
+			return nil
+		},
+		ReadContext: func(_ context.Context, _ *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+			used = meta.(*clients.Client)
+			return nil
+		},
+		DeleteContext: func(_ context.Context, _ *pluginsdk.ResourceData, _ interface{}) diag.Diagnostics {
+			return nil
+		},
+	}
+	addSubscriptionTargetingToResource("azurerm_example", resource)
+
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("validating the resource: %+v", err)
+	}
+
+	client := &clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	// when the Subscription matches the Provider, the Provider's Client is used
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name":            "example",
+		"subscription_id": "00000000-0000-0000-0000-000000000000",
+	})
+	if diags := resource.CreateContext(context.TODO(), d, client); diags.HasError() {
+		t.Fatalf("creating: %+v", diags)
+	}
+	if used != client {
+		t.Fatalf("expected the Provider's Client to be used")
+	}
+	if v := d.Get("subscription_id").(string); v != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("expected `subscription_id` to be set from the Resource ID but got %q", v)
+	}
+
+	// a Client which can't build Clients for other Subscriptions returns an error, rather than using the wrong Subscription
+	d = schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"name": "example",
+	})
+	d.SetId("/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Example")
+	used = nil
+	if diags := resource.ReadContext(context.TODO(), d, client); !diags.HasError() {
+		t.Fatalf("expected an error when targeting another Subscription")
+	}
+	if used != nil {
+		t.Fatalf("expected the Read function not to be called")
+	}
+}
+
+func TestTargetSubscriptionId(t *testing.T) {
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{},
+	}
+	addSubscriptionTargetingToResource("azurerm_example", resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"subscription_id": "11111111-1111-1111-1111-111111111111",
+	})
+	if v, err := targetSubscriptionId(d); err != nil || v != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the Subscription from the configuration but got %q / %+v", v, err)
+	}
+
+	// the Resource ID takes precedence, since this is where the Resource exists
+	d.SetId("/subscriptions/22222222-2222-2222-2222-222222222222/resourceGroups/example")
+	if v, err := targetSubscriptionId(d); err != nil || v != "22222222-2222-2222-2222-222222222222" {
+		t.Fatalf("expected the Subscription from the Resource ID but got %q / %+v", v, err)
+	}
+
+	d.SetId("example")
+	if _, err := targetSubscriptionId(d); err == nil {
+		t.Fatalf("expected an error parsing a Resource ID without a Subscription")
+	}
+}
+
+func TestSubscriptionTargetingSupportedByResources(t *testing.T) {
+	provider := TestAzureProvider()
+	for _, resourceType := range []string{"azurerm_resource_provider_registration", "azurerm_user_assigned_identity"} {
+		resource, ok := provider.ResourcesMap[resourceType]
+		if !ok {
+			t.Fatalf("the Resource %q was not found", resourceType)
+		}
+		if _, ok := resource.Schema[subscriptionTargetingKey]; !ok {
+			t.Fatalf("expected the Resource %q to support Subscription Targeting", resourceType)
+		}
+	}
+}
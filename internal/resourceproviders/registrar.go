@@ -0,0 +1,292 @@
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2015-12-01/features" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	featureStatePending     = "Pending"
+	featureStateRegistering = "Registering"
+	featureStateRegistered  = "Registered"
+)
+
+// defaultRegistrationTimeout is used when the context used for registration doesn't have a deadline
+const defaultRegistrationTimeout = 30 * time.Minute
+
+// Requirements are the Resource Providers and Preview Features which must be registered
+// within the Subscription to be able to use a Data Source or Resource
+type Requirements struct {
+	// ResourceProviders is a list of Resource Provider Namespaces, e.g. `Microsoft.KeyVault`
+	ResourceProviders []string
+
+	// PreviewFeatures is a list of Preview Features in the format `Namespace/FeatureName`
+	PreviewFeatures []string
+}
+
+// Registrar registers the Resource Providers and Preview Features required by a Data Source or
+// Resource on-demand, ensuring that each is only checked (and registered, if necessary) once
+// during the lifetime of the Provider.
+type Registrar struct {
+	providersClient *resources.ProvidersClient
+	featuresClient  *features.Client
+
+	// lock protects the Resource Providers and Preview Features which have been registered (or are being
+	// registered) - but isn't held whilst registering, so that an operation requiring one Resource Provider
+	// isn't blocked by another operation waiting for a different Resource Provider to finish registering
+	lock      sync.RWMutex
+	providers registrations
+	features  registrations
+}
+
+// registrations tracks the Resource Providers (or Preview Features) which have been registered, and those
+// being registered - keyed by the lower-cased Resource Provider Namespace (or Preview Feature)
+type registrations struct {
+	registered map[string]struct{}
+	inProgress map[string]*registration
+}
+
+// registration is a registration in progress, which concurrent operations requiring the same Resource Provider
+// (or Preview Feature) wait for rather than registering it again
+type registration struct {
+	done chan struct{}
+	err  error
+}
+
+func NewRegistrar(providersClient *resources.ProvidersClient, featuresClient *features.Client) *Registrar {
+	return &Registrar{
+		providersClient: providersClient,
+		featuresClient:  featuresClient,
+		providers: registrations{
+			registered: make(map[string]struct{}),
+			inProgress: make(map[string]*registration),
+		},
+		features: registrations{
+			registered: make(map[string]struct{}),
+			inProgress: make(map[string]*registration),
+		},
+	}
+}
+
+// EnsureRegistered ensures that each of the Resource Providers and Preview Features within the
+// Requirements are registered, registering (and waiting for) any which aren't.
+func (r *Registrar) EnsureRegistered(ctx context.Context, requirements Requirements) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultRegistrationTimeout)
+		defer cancel()
+	}
+
+	for _, namespace := range requirements.ResourceProviders {
+		if err := r.ensureResourceProviderRegistered(ctx, namespace); err != nil {
+			return err
+		}
+	}
+
+	for _, feature := range requirements.PreviewFeatures {
+		if err := r.ensurePreviewFeatureRegistered(ctx, feature); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerOnce calls the register function unless the key has already been registered - where the key is
+// being registered by another operation this waits for (and returns the result of) that registration instead
+func (r *Registrar) registerOnce(ctx context.Context, registrations *registrations, key string, register func() error) error {
+	r.lock.RLock()
+	_, registered := registrations.registered[key]
+	r.lock.RUnlock()
+	if registered {
+		return nil
+	}
+
+	r.lock.Lock()
+	if _, ok := registrations.registered[key]; ok {
+		r.lock.Unlock()
+		return nil
+	}
+	if existing, ok := registrations.inProgress[key]; ok {
+		r.lock.Unlock()
+
+		select {
+		case <-existing.done:
+			return existing.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	current := &registration{
+		done: make(chan struct{}),
+	}
+	registrations.inProgress[key] = current
+	r.lock.Unlock()
+
+	current.err = register()
+
+	r.lock.Lock()
+	if current.err == nil {
+		registrations.registered[key] = struct{}{}
+	}
+	delete(registrations.inProgress, key)
+	r.lock.Unlock()
+	close(current.done)
+
+	return current.err
+}
+
+func (r *Registrar) ensureResourceProviderRegistered(ctx context.Context, namespace string) error {
+	return r.registerOnce(ctx, &r.providers, strings.ToLower(namespace), func() error {
+		resp, err := r.providersClient.Get(ctx, namespace, "")
+		if err != nil {
+			return fmt.Errorf("retrieving Resource Provider %q: %+v", namespace, err)
+		}
+
+		if resp.RegistrationState == nil || !strings.EqualFold(*resp.RegistrationState, "Registered") {
+			return r.registerResourceProvider(ctx, namespace)
+		}
+
+		return nil
+	})
+}
+
+func (r *Registrar) registerResourceProvider(ctx context.Context, namespace string) error {
+	log.Printf("[DEBUG] Registering Resource Provider %q..", namespace)
+	if _, err := r.providersClient.Register(ctx, namespace); err != nil {
+		return fmt.Errorf("registering Resource Provider %q: %+v", namespace, err)
+	}
+
+	deadline, _ := ctx.Deadline()
+	log.Printf("[DEBUG] Waiting for Resource Provider %q to finish registering..", namespace)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Processing"},
+		Target:     []string{"Registered"},
+		Refresh:    resourceProviderRegistrationRefreshFunc(ctx, r.providersClient, namespace),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Resource Provider %q to be registered: %+v", namespace, err)
+	}
+	log.Printf("[DEBUG] Registered Resource Provider %q.", namespace)
+
+	return nil
+}
+
+func (r *Registrar) ensurePreviewFeatureRegistered(ctx context.Context, input string) error {
+	namespace, featureName, err := ParsePreviewFeature(input)
+	if err != nil {
+		return err
+	}
+
+	return r.registerOnce(ctx, &r.features, strings.ToLower(input), func() error {
+		existing, err := r.featuresClient.Get(ctx, namespace, featureName)
+		if err != nil {
+			return fmt.Errorf("retrieving Preview Feature %q: %+v", input, err)
+		}
+
+		state := ""
+		if existing.Properties != nil && existing.Properties.State != nil {
+			state = *existing.Properties.State
+		}
+
+		if strings.EqualFold(state, featureStateRegistered) {
+			return r.ensureResourceProviderRegistered(ctx, namespace)
+		}
+
+		if strings.EqualFold(state, featureStatePending) {
+			return fmt.Errorf("the Preview Feature %q has been requested but is pending approval - this must be approved before it can be used", input)
+		}
+
+		log.Printf("[DEBUG] Registering Preview Feature %q..", input)
+		resp, err := r.featuresClient.Register(ctx, namespace, featureName)
+		if err != nil {
+			return fmt.Errorf("registering Preview Feature %q: %+v", input, err)
+		}
+
+		if resp.Properties != nil && resp.Properties.State != nil && strings.EqualFold(*resp.Properties.State, featureStatePending) {
+			return fmt.Errorf("the Preview Feature %q has been requested but requires approval - this must be approved before it can be used", input)
+		}
+
+		deadline, _ := ctx.Deadline()
+		log.Printf("[DEBUG] Waiting for Preview Feature %q to finish registering..", input)
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{featureStateRegistering},
+			Target:     []string{featureStateRegistered},
+			Refresh:    previewFeatureRegistrationRefreshFunc(ctx, r.featuresClient, namespace, featureName),
+			MinTimeout: 30 * time.Second,
+			Timeout:    time.Until(deadline),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for Preview Feature %q to be registered: %+v", input, err)
+		}
+
+		// the Resource Provider needs to be re-registered for the Preview Feature to be propagated
+		if err := r.registerResourceProvider(ctx, namespace); err != nil {
+			return err
+		}
+
+		r.lock.Lock()
+		r.providers.registered[strings.ToLower(namespace)] = struct{}{}
+		r.lock.Unlock()
+
+		return nil
+	})
+}
+
+// ParsePreviewFeature parses a Preview Feature in the format `Namespace/FeatureName`, returning
+// the Resource Provider Namespace and the name of the Feature
+func ParsePreviewFeature(input string) (string, string, error) {
+	segments := strings.Split(input, "/")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("expected the Preview Feature %q to be in the format `Namespace/FeatureName`", input)
+	}
+
+	return segments[0], segments[1], nil
+}
+
+func resourceProviderRegistrationRefreshFunc(ctx context.Context, client *resources.ProvidersClient, namespace string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, namespace, "")
+		if err != nil {
+			return resp, "Failed", err
+		}
+
+		if resp.RegistrationState != nil && strings.EqualFold(*resp.RegistrationState, "Registered") {
+			return resp, "Registered", nil
+		}
+
+		return resp, "Processing", nil
+	}
+}
+
+func previewFeatureRegistrationRefreshFunc(ctx context.Context, client *features.Client, namespace, featureName string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, namespace, featureName)
+		if err != nil {
+			return resp, "Failed", fmt.Errorf("retrieving Preview Feature %q: %+v", fmt.Sprintf("%s/%s", namespace, featureName), err)
+		}
+
+		if resp.Properties == nil || resp.Properties.State == nil {
+			return resp, "Failed", fmt.Errorf("retrieving Preview Feature %q: `properties.state` was nil", fmt.Sprintf("%s/%s", namespace, featureName))
+		}
+
+		// normalize the casing, since the API returns this inconsistently
+		for _, state := range []string{featureStateRegistering, featureStateRegistered} {
+			if strings.EqualFold(*resp.Properties.State, state) {
+				return resp, state, nil
+			}
+		}
+
+		return resp, *resp.Properties.State, nil
+	}
+}
@@ -0,0 +1,248 @@
+package resourceproviders
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2015-12-01/features" // nolint: staticcheck
+)
+
+type fakeRegistrationServer struct {
+	lock sync.Mutex
+
+	providers map[string]string
+	features  map[string]string
+
+	// requests is the number of requests made for each Method & Path
+	requests map[string]int
+
+	// registering (when set) blocks each request to register a Resource Provider until it's closed
+	registering chan struct{}
+}
+
+func (s *fakeRegistrationServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if s.registering != nil && req.Method == http.MethodPost && !strings.Contains(req.URL.Path, "/features/") {
+		<-s.registering
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.requests[req.Method+" "+req.URL.Path]++
+
+	// /subscriptions/{subscriptionId}/providers/Microsoft.Features/providers/{namespace}/features/{featureName}[/register]
+	// /subscriptions/{subscriptionId}/providers/{namespace}[/register]
+	segments := strings.Split(strings.TrimPrefix(req.URL.Path, "/"), "/")
+	register := segments[len(segments)-1] == "register"
+	if register {
+		segments = segments[:len(segments)-1]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if len(segments) == 8 && segments[3] == "Microsoft.Features" {
+		key := segments[5] + "/" + segments[7]
+		state, ok := s.features[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if register && state == "NotRegistered" {
+			state = "Registered"
+			s.features[key] = state
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"name": key,
+			"properties": map[string]interface{}{
+				"state": state,
+			},
+		})
+		return
+	}
+
+	if len(segments) == 4 {
+		state, ok := s.providers[segments[3]]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if register {
+			state = "Registered"
+			s.providers[segments[3]] = state
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"namespace":         segments[3],
+			"registrationState": state,
+		})
+		return
+	}
+
+	w.WriteHeader(http.StatusBadRequest)
+}
+
+func newTestRegistrar(t *testing.T, server *fakeRegistrationServer) *Registrar {
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	providersClient := resources.NewProvidersClientWithBaseURI(httpServer.URL, "00000000-0000-0000-0000-000000000000")
+	featuresClient := features.NewClientWithBaseURI(httpServer.URL, "00000000-0000-0000-0000-000000000000")
+	return NewRegistrar(&providersClient, &featuresClient)
+}
+
+func TestRegistrarEnsureRegistered(t *testing.T) {
+	server := &fakeRegistrationServer{
+		providers: map[string]string{
+			"Microsoft.Registered":   "Registered",
+			"Microsoft.Unregistered": "NotRegistered",
+		},
+		features: map[string]string{
+			"Microsoft.Registered/PreviewFeature": "NotRegistered",
+		},
+		requests: map[string]int{},
+	}
+	registrar := newTestRegistrar(t, server)
+
+	requirements := Requirements{
+		ResourceProviders: []string{"Microsoft.Registered", "Microsoft.Unregistered"},
+		PreviewFeatures:   []string{"Microsoft.Registered/PreviewFeature"},
+	}
+
+	// the second call should be served from the cache
+	for i := 0; i < 2; i++ {
+		if err := registrar.EnsureRegistered(context.TODO(), requirements); err != nil {
+			t.Fatalf("ensuring the Requirements are registered: %+v", err)
+		}
+	}
+
+	if server.providers["Microsoft.Unregistered"] != "Registered" {
+		t.Fatalf("expected the Resource Provider `Microsoft.Unregistered` to be registered")
+	}
+	if server.features["Microsoft.Registered/PreviewFeature"] != "Registered" {
+		t.Fatalf("expected the Preview Feature `Microsoft.Registered/PreviewFeature` to be registered")
+	}
+
+	expectedRequests := map[string]int{
+		"POST /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Registered/register":                                                      1,
+		"POST /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Unregistered/register":                                                    1,
+		"POST /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Features/providers/Microsoft.Registered/features/PreviewFeature/register": 1,
+	}
+	for request, expected := range expectedRequests {
+		if actual := server.requests[request]; actual != expected {
+			t.Fatalf("expected %d requests for %q but got %d", expected, request, actual)
+		}
+	}
+}
+
+func TestRegistrarEnsureRegisteredPendingApproval(t *testing.T) {
+	server := &fakeRegistrationServer{
+		providers: map[string]string{
+			"Microsoft.Registered": "Registered",
+		},
+		features: map[string]string{
+			"Microsoft.Registered/PreviewFeature": "Pending",
+		},
+		requests: map[string]int{},
+	}
+	registrar := newTestRegistrar(t, server)
+
+	err := registrar.EnsureRegistered(context.TODO(), Requirements{
+		PreviewFeatures: []string{"Microsoft.Registered/PreviewFeature"},
+	})
+	if err == nil {
+		t.Fatalf("expected an error for a Preview Feature which is pending approval but didn't get one")
+	}
+}
+
+func TestRegistrarEnsureRegisteredConcurrently(t *testing.T) {
+	server := &fakeRegistrationServer{
+		providers: map[string]string{
+			"Microsoft.Registered":   "Registered",
+			"Microsoft.Unregistered": "NotRegistered",
+		},
+		features:    map[string]string{},
+		requests:    map[string]int{},
+		registering: make(chan struct{}),
+	}
+	registrar := newTestRegistrar(t, server)
+
+	errs := make(chan error, 5)
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			errs <- registrar.EnsureRegistered(context.TODO(), Requirements{
+				ResourceProviders: []string{"Microsoft.Unregistered"},
+			})
+		}()
+	}
+
+	// whilst `Microsoft.Unregistered` is registering, operations requiring other Resource Providers aren't blocked
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	if err := registrar.EnsureRegistered(ctx, Requirements{ResourceProviders: []string{"Microsoft.Registered"}}); err != nil {
+		t.Fatalf("ensuring `Microsoft.Registered` is registered: %+v", err)
+	}
+
+	close(server.registering)
+	for i := 0; i < cap(errs); i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("ensuring `Microsoft.Unregistered` is registered: %+v", err)
+		}
+	}
+
+	// the concurrent operations requiring the same Resource Provider should only register it once
+	request := "POST /subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Unregistered/register"
+	if actual := server.requests[request]; actual != 1 {
+		t.Fatalf("expected 1 request for %q but got %d", request, actual)
+	}
+}
+
+func TestParsePreviewFeature(t *testing.T) {
+	testCases := []struct {
+		input     string
+		namespace string
+		name      string
+		valid     bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "Microsoft.ContainerService",
+			valid: false,
+		},
+		{
+			input: "Microsoft.ContainerService/",
+			valid: false,
+		},
+		{
+			input: "Microsoft.ContainerService/FleetResourcePreview/extra",
+			valid: false,
+		},
+		{
+			input:     "Microsoft.ContainerService/FleetResourcePreview",
+			namespace: "Microsoft.ContainerService",
+			name:      "FleetResourcePreview",
+			valid:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Testing %q..", testCase.input)
+
+		namespace, name, err := ParsePreviewFeature(testCase.input)
+		if (err == nil) != testCase.valid {
+			t.Fatalf("expected valid to be %t but got %t", testCase.valid, err == nil)
+		}
+		if namespace != testCase.namespace || name != testCase.name {
+			t.Fatalf("expected %q / %q but got %q / %q", testCase.namespace, testCase.name, namespace, name)
+		}
+	}
+}
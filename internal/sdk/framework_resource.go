@@ -0,0 +1,39 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// FrameworkResourceMetadata can be embedded within a Resource implemented natively using the Plugin Framework,
+// implementing `resource.ResourceWithConfigure` to provide access to the Clients for the configured Provider.
+type FrameworkResourceMetadata struct {
+	provider *schema.Provider
+}
+
+// Configure stores the Provider which is passed through from the Plugin Framework Provider
+func (m *FrameworkResourceMetadata) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// the ProviderData is nil until the Provider has been configured
+	if v, ok := req.ProviderData.(*schema.Provider); ok {
+		m.provider = v
+	}
+}
+
+// Client returns the Clients for the configured Provider - or nil if the Provider hasn't been configured yet.
+//
+// The Clients are obtained from the Plugin SDKv2 Provider when they're used, since the Muxed Providers can be
+// configured in any order.
+func (m *FrameworkResourceMetadata) Client() *clients.Client {
+	if m.provider == nil {
+		return nil
+	}
+
+	client, ok := m.provider.Meta().(*clients.Client)
+	if !ok {
+		return nil
+	}
+	return client
+}
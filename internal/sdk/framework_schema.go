@@ -0,0 +1,277 @@
+package sdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The Plugin Framework and Plugin SDKv2 must expose identical Provider Schemas when muxed - and
+// Resources moved from Plugin SDKv2 to the Plugin Framework must expose an identical Schema to avoid
+// changing the State. As such rather than duplicating these Schemas, they're converted from the
+// protocol representation which is exposed by Plugin SDKv2.
+
+// FrameworkProviderSchema converts the protocol representation of a Provider Schema into a Plugin Framework Schema
+func FrameworkProviderSchema(input *tfprotov5.Schema) (*providerschema.Schema, error) {
+	if input == nil || input.Block == nil {
+		return nil, fmt.Errorf("the provider schema was nil")
+	}
+
+	attributes, blocks, err := expandProviderSchemaBlock(input.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &providerschema.Schema{
+		Attributes:         attributes,
+		Blocks:             blocks,
+		Description:        input.Block.Description,
+		DeprecationMessage: deprecationMessage(input.Block.Deprecated),
+	}, nil
+}
+
+func expandProviderSchemaBlock(input *tfprotov5.SchemaBlock) (map[string]providerschema.Attribute, map[string]providerschema.Block, error) {
+	attributes := make(map[string]providerschema.Attribute)
+	for _, v := range input.Attributes {
+		attribute, err := expandProviderSchemaAttribute(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting attribute %q: %+v", v.Name, err)
+		}
+		attributes[v.Name] = attribute
+	}
+
+	blocks := make(map[string]providerschema.Block)
+	for _, v := range input.BlockTypes {
+		nestedAttributes, nestedBlocks, err := expandProviderSchemaBlock(v.Block)
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting block %q: %+v", v.TypeName, err)
+		}
+
+		switch v.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[v.TypeName] = providerschema.ListNestedBlock{
+				NestedObject: providerschema.NestedBlockObject{
+					Attributes: nestedAttributes,
+					Blocks:     nestedBlocks,
+				},
+				Description:        v.Block.Description,
+				DeprecationMessage: deprecationMessage(v.Block.Deprecated),
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[v.TypeName] = providerschema.SetNestedBlock{
+				NestedObject: providerschema.NestedBlockObject{
+					Attributes: nestedAttributes,
+					Blocks:     nestedBlocks,
+				},
+				Description:        v.Block.Description,
+				DeprecationMessage: deprecationMessage(v.Block.Deprecated),
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			blocks[v.TypeName] = providerschema.SingleNestedBlock{
+				Attributes:         nestedAttributes,
+				Blocks:             nestedBlocks,
+				Description:        v.Block.Description,
+				DeprecationMessage: deprecationMessage(v.Block.Deprecated),
+			}
+		default:
+			return nil, nil, fmt.Errorf("unsupported nesting mode %q for block %q", v.Nesting.String(), v.TypeName)
+		}
+	}
+
+	return attributes, blocks, nil
+}
+
+func expandProviderSchemaAttribute(input *tfprotov5.SchemaAttribute) (providerschema.Attribute, error) {
+	if input.Computed {
+		return nil, fmt.Errorf("provider attributes cannot be computed")
+	}
+
+	attrType, err := frameworkAttributeType(input.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	deprecation := deprecationMessage(input.Deprecated)
+	switch t := attrType.(type) {
+	case types.ListType:
+		return providerschema.ListAttribute{ElementType: t.ElemType, Required: input.Required, Optional: input.Optional, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.SetType:
+		return providerschema.SetAttribute{ElementType: t.ElemType, Required: input.Required, Optional: input.Optional, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.MapType:
+		return providerschema.MapAttribute{ElementType: t.ElemType, Required: input.Required, Optional: input.Optional, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.ObjectType:
+		return providerschema.ObjectAttribute{AttributeTypes: t.AttrTypes, Required: input.Required, Optional: input.Optional, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	}
+
+	switch attrType {
+	case types.BoolType:
+		return providerschema.BoolAttribute{Required: input.Required, Optional: input.Optional, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.NumberType:
+		return providerschema.NumberAttribute{Required: input.Required, Optional: input.Optional, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.StringType:
+		return providerschema.StringAttribute{Required: input.Required, Optional: input.Optional, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", input.Type.String())
+}
+
+// frameworkResourceSchema converts the protocol representation of a Resource Schema into a Plugin Framework Schema
+func frameworkResourceSchema(input *tfprotov5.Schema) (*resourceschema.Schema, error) {
+	if input == nil || input.Block == nil {
+		return nil, fmt.Errorf("the resource schema was nil")
+	}
+
+	attributes, blocks, err := expandResourceSchemaBlock(input.Block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resourceschema.Schema{
+		Attributes:         attributes,
+		Blocks:             blocks,
+		Description:        input.Block.Description,
+		DeprecationMessage: deprecationMessage(input.Block.Deprecated),
+		Version:            input.Version,
+	}, nil
+}
+
+func expandResourceSchemaBlock(input *tfprotov5.SchemaBlock) (map[string]resourceschema.Attribute, map[string]resourceschema.Block, error) {
+	attributes := make(map[string]resourceschema.Attribute)
+	for _, v := range input.Attributes {
+		attribute, err := expandResourceSchemaAttribute(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting attribute %q: %+v", v.Name, err)
+		}
+		attributes[v.Name] = attribute
+	}
+
+	blocks := make(map[string]resourceschema.Block)
+	for _, v := range input.BlockTypes {
+		nestedAttributes, nestedBlocks, err := expandResourceSchemaBlock(v.Block)
+		if err != nil {
+			return nil, nil, fmt.Errorf("converting block %q: %+v", v.TypeName, err)
+		}
+
+		switch v.Nesting {
+		case tfprotov5.SchemaNestedBlockNestingModeList:
+			blocks[v.TypeName] = resourceschema.ListNestedBlock{
+				NestedObject: resourceschema.NestedBlockObject{
+					Attributes: nestedAttributes,
+					Blocks:     nestedBlocks,
+				},
+				Description:        v.Block.Description,
+				DeprecationMessage: deprecationMessage(v.Block.Deprecated),
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSet:
+			blocks[v.TypeName] = resourceschema.SetNestedBlock{
+				NestedObject: resourceschema.NestedBlockObject{
+					Attributes: nestedAttributes,
+					Blocks:     nestedBlocks,
+				},
+				Description:        v.Block.Description,
+				DeprecationMessage: deprecationMessage(v.Block.Deprecated),
+			}
+		case tfprotov5.SchemaNestedBlockNestingModeSingle:
+			blocks[v.TypeName] = resourceschema.SingleNestedBlock{
+				Attributes:         nestedAttributes,
+				Blocks:             nestedBlocks,
+				Description:        v.Block.Description,
+				DeprecationMessage: deprecationMessage(v.Block.Deprecated),
+			}
+		default:
+			return nil, nil, fmt.Errorf("unsupported nesting mode %q for block %q", v.Nesting.String(), v.TypeName)
+		}
+	}
+
+	return attributes, blocks, nil
+}
+
+func expandResourceSchemaAttribute(input *tfprotov5.SchemaAttribute) (resourceschema.Attribute, error) {
+	attrType, err := frameworkAttributeType(input.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	deprecation := deprecationMessage(input.Deprecated)
+	switch t := attrType.(type) {
+	case types.ListType:
+		return resourceschema.ListAttribute{ElementType: t.ElemType, Required: input.Required, Optional: input.Optional, Computed: input.Computed, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.SetType:
+		return resourceschema.SetAttribute{ElementType: t.ElemType, Required: input.Required, Optional: input.Optional, Computed: input.Computed, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.MapType:
+		return resourceschema.MapAttribute{ElementType: t.ElemType, Required: input.Required, Optional: input.Optional, Computed: input.Computed, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.ObjectType:
+		return resourceschema.ObjectAttribute{AttributeTypes: t.AttrTypes, Required: input.Required, Optional: input.Optional, Computed: input.Computed, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	}
+
+	switch attrType {
+	case types.BoolType:
+		return resourceschema.BoolAttribute{Required: input.Required, Optional: input.Optional, Computed: input.Computed, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.NumberType:
+		return resourceschema.NumberAttribute{Required: input.Required, Optional: input.Optional, Computed: input.Computed, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	case types.StringType:
+		return resourceschema.StringAttribute{Required: input.Required, Optional: input.Optional, Computed: input.Computed, Sensitive: input.Sensitive, Description: input.Description, DeprecationMessage: deprecation}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", input.Type.String())
+}
+
+// frameworkAttributeType returns the Plugin Framework type for the specified protocol type
+func frameworkAttributeType(input tftypes.Type) (attr.Type, error) {
+	switch {
+	case input.Is(tftypes.Bool):
+		return types.BoolType, nil
+	case input.Is(tftypes.Number):
+		return types.NumberType, nil
+	case input.Is(tftypes.String):
+		return types.StringType, nil
+	}
+
+	switch t := input.(type) {
+	case tftypes.List:
+		elemType, err := frameworkAttributeType(t.ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return types.ListType{ElemType: elemType}, nil
+	case tftypes.Set:
+		elemType, err := frameworkAttributeType(t.ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return types.SetType{ElemType: elemType}, nil
+	case tftypes.Map:
+		elemType, err := frameworkAttributeType(t.ElementType)
+		if err != nil {
+			return nil, err
+		}
+		return types.MapType{ElemType: elemType}, nil
+	case tftypes.Object:
+		attrTypes := make(map[string]attr.Type)
+		for k, v := range t.AttributeTypes {
+			attrType, err := frameworkAttributeType(v)
+			if err != nil {
+				return nil, fmt.Errorf("converting object attribute %q: %+v", k, err)
+			}
+			attrTypes[k] = attrType
+		}
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", input.String())
+}
+
+// deprecationMessage returns a placeholder deprecation message, since only whether an attribute
+// is deprecated (rather than the message) is exposed in the protocol
+func deprecationMessage(deprecated bool) string {
+	if deprecated {
+		return "This property has been deprecated."
+	}
+
+	return ""
+}
@@ -11,6 +11,17 @@ var _ Logger = ConsoleLogger{}
 // to StdOut - in Terraform's perspective that's proxied via the Plugin SDK
 type ConsoleLogger struct{}
 
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (ConsoleLogger) Debug(message string) {
+	log.Printf("[DEBUG] %s", message)
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
+
 // Info prints out a message prefixed with `[INFO]` verbatim
 func (ConsoleLogger) Info(message string) {
 	log.Printf("[INFO] %s", message)
@@ -13,6 +13,14 @@ type DiagnosticsLogger struct {
 	diagnostics diag.Diagnostics
 }
 
+func (d *DiagnosticsLogger) Debug(message string) {
+	log.Printf("[DEBUG] %s", message)
+}
+
+func (d *DiagnosticsLogger) Debugf(format string, args ...interface{}) {
+	log.Printf("[DEBUG] "+format, args...)
+}
+
 func (d *DiagnosticsLogger) Info(message string) {
 	log.Printf("[INFO] %s", message)
 }
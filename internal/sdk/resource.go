@@ -83,8 +83,6 @@ type StateUpgradeData struct {
 	Upgraders     map[int]pluginsdk.StateUpgrade
 }
 
-// TODO: a generic state migration for updating ID's
-
 type ResourceWithCustomImporter interface {
 	Resource
 
@@ -140,6 +138,51 @@ type ResourceWithCustomizeDiff interface {
 	CustomizeDiff() ResourceFunc
 }
 
+// ResourceServedByPluginFramework is an optional interface
+//
+// Resources implementing this interface (and returning true) are served by the Plugin Framework
+// rather than Plugin SDKv2 - using the same Schema and implementation via the FrameworkResourceWrapper.
+type ResourceServedByPluginFramework interface {
+	Resource
+
+	// ServedByPluginFramework returns whether this Resource should be served by the Plugin Framework
+	ServedByPluginFramework() bool
+}
+
+// IsServedByPluginFramework returns whether the specified Resource should be served by the Plugin Framework
+func IsServedByPluginFramework(r Resource) bool {
+	if v, ok := r.(ResourceServedByPluginFramework); ok {
+		return v.ServedByPluginFramework()
+	}
+
+	return false
+}
+
+// ResourceWithSubscriptionTargeting is an optional interface
+//
+// Resources implementing this interface (and returning true) expose an optional `subscription_id` argument, allowing
+// the Resource to be managed within a Subscription other than the one the Provider is configured for - where the
+// Client for that Subscription is made available as `metadata.Client`.
+//
+// NOTE: the Resource ID for this Resource must contain the Subscription ID, which is used to determine the Client
+// to use for the Read, Update and Delete operations - as such the Resource must build the Resource ID using
+// `metadata.Client.Account.SubscriptionId` during Create.
+type ResourceWithSubscriptionTargeting interface {
+	Resource
+
+	// SupportsSubscriptionTargeting returns whether this Resource can be managed within another Subscription
+	SupportsSubscriptionTargeting() bool
+}
+
+// SupportsSubscriptionTargeting returns whether the specified Resource can be managed within another Subscription
+func SupportsSubscriptionTargeting(r Resource) bool {
+	if v, ok := r.(ResourceWithSubscriptionTargeting); ok {
+		return v.SupportsSubscriptionTargeting()
+	}
+
+	return false
+}
+
 // ResourceRunFunc is the function which can be run
 // ctx provides a Context instance with the user-provided timeout
 // metadata is a reference to an object containing the Client, ResourceData and a Logger
@@ -1,6 +1,8 @@
 package sdk
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -61,3 +63,35 @@ type UntypedServiceRegistrationWithAGitHubLabel interface {
 
 	AssociatedGitHubLabel() string
 }
+
+// ServiceRegistrationWithResourceProviders is an optional interface for both Typed and Untyped Service
+// Registrations, specifying the Resource Providers which must be registered within the Subscription to
+// be able to use the Data Sources and Resources within this Service.
+//
+// These are used to determine which Resource Providers need to be registered when the Provider is
+// configured to only register the Resource Providers which are used.
+type ServiceRegistrationWithResourceProviders interface {
+	ResourceProviders() []string
+}
+
+// ServiceRegistrationWithPreviewFeatures is an optional interface for both Typed and Untyped Service
+// Registrations, specifying the Preview Features (in the format `Namespace/FeatureName`) which must be
+// registered within the Subscription to be able to use a given Resource, keyed by the Resource Type.
+type ServiceRegistrationWithPreviewFeatures interface {
+	PreviewFeatures() map[string][]string
+}
+
+// ServiceRegistrationWithRedactionRules is an optional interface for both Typed and Untyped Service
+// Registrations, specifying the rules used to redact the sensitive values (for example Access Keys
+// or Secrets) returned from, or sent to, the APIs used by this Service prior to these being logged.
+type ServiceRegistrationWithRedactionRules interface {
+	RedactionRules() common.RedactionRules
+}
+
+// ServiceRegistrationWithFrameworkResources is an optional interface for both Typed and Untyped Service
+// Registrations, specifying the Resources which are implemented natively using the Plugin Framework - and
+// which are served by the Plugin Framework Provider. These Resources can embed FrameworkResourceMetadata
+// to obtain the Clients for the configured Provider.
+type ServiceRegistrationWithFrameworkResources interface {
+	FrameworkResources() []func() resource.Resource
+}
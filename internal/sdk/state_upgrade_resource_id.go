@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = ResourceIdStateUpgrade{}
+
+// ResourceIdStateUpgrade is a State Upgrade which only rewrites the Resource ID (and any other top-level fields
+// containing a Resource ID) into the format defined by the Resource ID Type - which is parsed insensitively to
+// account for API changes/bugs where the casing of a Resource ID has changed (e.g. `resourcegroups` vs `resourceGroups`).
+//
+// This can be registered directly within the StateUpgradeData for a Resource, for example:
+//
+//	0: sdk.ResourceIdStateUpgrade{PreviousSchema: schemaForV0(), ResourceId: &components.ComponentId{}},
+type ResourceIdStateUpgrade struct {
+	// PreviousSchema is a point-in-time reference to the Schema for the previous Schema Version
+	PreviousSchema map[string]*pluginsdk.Schema
+
+	// ResourceId is the Resource ID Type used to parse and format the `id` field
+	ResourceId resourceids.ResourceId
+
+	// Fields is an optional map of the field name to the Resource ID Type for any other fields
+	// containing a Resource ID (or a list/set of Resource IDs) which should also be rewritten
+	Fields map[string]resourceids.ResourceId
+}
+
+func (r ResourceIdStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return r.PreviousSchema
+}
+
+func (r ResourceIdStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return ResourceIdStateUpgradeFunc(r.ResourceId, r.Fields)
+}
+
+// ResourceIdStateUpgradeFunc returns a StateUpgraderFunc which rewrites the `id` field (and the values of any of the
+// specified fields) by parsing them insensitively using the specified Resource ID Type, for use in a State Upgrade.
+func ResourceIdStateUpgradeFunc(id resourceids.ResourceId, fields map[string]resourceids.ResourceId) pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		oldId, ok := rawState["id"].(string)
+		if !ok {
+			return rawState, fmt.Errorf("expected `id` to be a string but got %+v", rawState["id"])
+		}
+
+		newId, err := RewriteResourceIdInsensitively(id, oldId)
+		if err != nil {
+			return rawState, err
+		}
+
+		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, *newId)
+		rawState["id"] = *newId
+
+		for fieldName, fieldId := range fields {
+			switch v := rawState[fieldName].(type) {
+			case string:
+				if v == "" {
+					continue
+				}
+
+				newValue, err := RewriteResourceIdInsensitively(fieldId, v)
+				if err != nil {
+					return rawState, fmt.Errorf("rewriting the Resource ID within %q: %+v", fieldName, err)
+				}
+				rawState[fieldName] = *newValue
+
+			case []interface{}:
+				for i, item := range v {
+					raw, ok := item.(string)
+					if !ok || raw == "" {
+						continue
+					}
+
+					newValue, err := RewriteResourceIdInsensitively(fieldId, raw)
+					if err != nil {
+						return rawState, fmt.Errorf("rewriting the Resource ID within %q: %+v", fieldName, err)
+					}
+					v[i] = *newValue
+				}
+			}
+		}
+
+		return rawState, nil
+	}
+}
+
+// RewriteResourceIdInsensitively parses the specified value insensitively using the Resource ID Type, and
+// returns the Resource ID formatted using the casing defined by the Resource ID Type
+func RewriteResourceIdInsensitively(id resourceids.ResourceId, input string) (*string, error) {
+	segments := id.Segments()
+	parsed, err := resourceids.NewParserFromResourceIdType(id).Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	components := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		value, ok := parsed.Parsed[segment.Name]
+		if !ok {
+			return nil, fmt.Errorf("parsing %q: the segment %q was not found", input, segment.Name)
+		}
+
+		// scopes are prefixed with a `/`, which would otherwise be duplicated
+		if segment.Type == resourceids.ScopeSegmentType {
+			value = strings.TrimPrefix(value, "/")
+		}
+
+		components = append(components, value)
+	}
+
+	output := fmt.Sprintf("/%s", strings.Join(components, "/"))
+	return &output, nil
+}
@@ -0,0 +1,110 @@
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
+)
+
+func TestRewriteResourceIdInsensitively(t *testing.T) {
+	testData := []struct {
+		name     string
+		id       resourceids.ResourceId
+		input    string
+		expected *string
+	}{
+		{
+			name:     "already correct",
+			id:       &commonids.KeyVaultId{},
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			expected: pointer.To("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1"),
+		},
+		{
+			name:     "incorrect casing",
+			id:       &commonids.KeyVaultId{},
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.keyvault/Vaults/Vault1",
+			expected: pointer.To("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/Vault1"),
+		},
+		{
+			name:     "scope",
+			id:       &budgets.ScopedBudgetId{},
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.consumption/budgets/budget1",
+			expected: pointer.To("/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.Consumption/budgets/budget1"),
+		},
+		{
+			name:     "different resource type",
+			id:       &commonids.KeyVaultId{},
+			input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			expected: nil,
+		},
+	}
+
+	for _, test := range testData {
+		t.Logf("[DEBUG] Testing %q..", test.name)
+
+		actual, err := RewriteResourceIdInsensitively(test.id, test.input)
+		if err != nil {
+			if test.expected == nil {
+				continue
+			}
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if test.expected == nil {
+			t.Fatalf("expected an error but got %q", *actual)
+		}
+
+		if *actual != *test.expected {
+			t.Fatalf("expected %q but got %q", *test.expected, *actual)
+		}
+	}
+}
+
+func TestResourceIdStateUpgrade(t *testing.T) {
+	upgrade := ResourceIdStateUpgrade{
+		ResourceId: &commonids.KeyVaultId{},
+		Fields: map[string]resourceids.ResourceId{
+			"resource_group_id":  &commonids.ResourceGroupId{},
+			"resource_group_ids": &commonids.ResourceGroupId{},
+			"empty_id":           &commonids.ResourceGroupId{},
+		},
+	}
+
+	input := map[string]interface{}{
+		"id":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/microsoft.keyvault/vaults/vault1",
+		"name":              "vault1",
+		"resource_group_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+		"resource_group_ids": []interface{}{
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group2",
+		},
+		"empty_id": "",
+	}
+	expected := map[string]interface{}{
+		"id":                "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+		"name":              "vault1",
+		"resource_group_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+		"resource_group_ids": []interface{}{
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group2",
+		},
+		"empty_id": "",
+	}
+
+	actual, err := upgrade.UpgradeFunc()(context.TODO(), input, nil)
+	if err != nil {
+		t.Fatalf("upgrading the state: %+v", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if _, err := upgrade.UpgradeFunc()(context.TODO(), map[string]interface{}{"id": "/subscriptions/12345678-1234-9876-4563-123456789012"}, nil); err == nil {
+		t.Fatalf("expected an error when parsing an invalid Resource ID but didn't get one")
+	}
+}
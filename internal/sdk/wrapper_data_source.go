@@ -35,6 +35,9 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 		if err := ValidateModelObject(modelObj); err != nil {
 			return nil, fmt.Errorf("validating model for %q: %+v", dw.dataSource.ResourceType(), err)
 		}
+		if err := ValidateModelObjectAgainstSchema(modelObj, *resourceSchema); err != nil {
+			return nil, fmt.Errorf("validating model against the schema for %q: %+v", dw.dataSource.ResourceType(), err)
+		}
 	}
 
 	d := func(duration time.Duration) *time.Duration {
@@ -43,7 +46,7 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 
 	resource := schema.Resource{
 		Schema: *resourceSchema,
-		ReadContext: dw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		ReadContext: dw.diagnosticsWrapper("Read", *resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, dw.logger)
 			return dw.dataSource.Read().Func(ctx, metaData)
 		}),
@@ -55,6 +58,6 @@ func (dw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	return &resource, nil
 }
 
-func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
-	return diagnosticsWrapper(in, dw.logger)
+func (dw *DataSourceWrapper) diagnosticsWrapper(operationName string, resourceSchema map[string]*schema.Schema, in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
+	return diagnosticsWrapper(in, dw.logger, resourceSchema, dw.dataSource.ResourceType(), operationName)
 }
@@ -0,0 +1,163 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+var _ tfprotov5.ProviderServer = FrameworkPrivateStateServer{}
+
+// FrameworkPrivateStateServer wraps the Provider Server for the Plugin Framework Provider, ensuring that the
+// Private State for Resources served via the FrameworkResourceWrapper is stored in the Terraform State exactly
+// as Plugin SDKv2 returned it - meaning that Resources can be moved between Plugin SDKv2 and the Plugin Framework
+// (in either direction) without the Private State (containing the Timeouts and Schema Version) being lost.
+//
+// The Plugin Framework only exposes the Private State as a set of keys (and discards the Private State written
+// by Plugin SDKv2, since this isn't in the same format) - as such the Private State from Plugin SDKv2 is nested
+// within a single key whilst the request is processed by the Plugin Framework, which is removed again in the response.
+type FrameworkPrivateStateServer struct {
+	tfprotov5.ProviderServer
+
+	// resourceTypes are the Resource Types served via the FrameworkResourceWrapper
+	resourceTypes map[string]struct{}
+}
+
+// NewFrameworkPrivateStateServer returns a FrameworkPrivateStateServer wrapping the Plugin Framework Provider
+// Server `server`, which serves the Resource Types `resourceTypes` via the FrameworkResourceWrapper
+func NewFrameworkPrivateStateServer(server func() tfprotov5.ProviderServer, resourceTypes []string) func() tfprotov5.ProviderServer {
+	types := make(map[string]struct{})
+	for _, v := range resourceTypes {
+		types[v] = struct{}{}
+	}
+
+	return func() tfprotov5.ProviderServer {
+		return FrameworkPrivateStateServer{
+			ProviderServer: server(),
+			resourceTypes:  types,
+		}
+	}
+}
+
+func (s FrameworkPrivateStateServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	if !s.wrapsResourceType(req.TypeName) {
+		return s.ProviderServer.ReadResource(ctx, req)
+	}
+
+	private, err := frameworkPrivateState(req.Private)
+	if err != nil {
+		return nil, err
+	}
+	request := *req
+	request.Private = private
+
+	resp, err := s.ProviderServer.ReadResource(ctx, &request)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.Private, err = pluginSdkPrivateState(resp.Private); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s FrameworkPrivateStateServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	if !s.wrapsResourceType(req.TypeName) {
+		return s.ProviderServer.PlanResourceChange(ctx, req)
+	}
+
+	private, err := frameworkPrivateState(req.PriorPrivate)
+	if err != nil {
+		return nil, err
+	}
+	request := *req
+	request.PriorPrivate = private
+
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, &request)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.PlannedPrivate, err = pluginSdkPrivateState(resp.PlannedPrivate); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s FrameworkPrivateStateServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	if !s.wrapsResourceType(req.TypeName) {
+		return s.ProviderServer.ApplyResourceChange(ctx, req)
+	}
+
+	private, err := frameworkPrivateState(req.PlannedPrivate)
+	if err != nil {
+		return nil, err
+	}
+	request := *req
+	request.PlannedPrivate = private
+
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, &request)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	if resp.Private, err = pluginSdkPrivateState(resp.Private); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s FrameworkPrivateStateServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if err != nil || resp == nil || !s.wrapsResourceType(req.TypeName) {
+		return resp, err
+	}
+
+	for _, v := range resp.ImportedResources {
+		if v == nil {
+			continue
+		}
+		if v.Private, err = pluginSdkPrivateState(v.Private); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s FrameworkPrivateStateServer) wrapsResourceType(resourceType string) bool {
+	_, ok := s.resourceTypes[resourceType]
+	return ok
+}
+
+// frameworkPrivateState nests the Private State from Plugin SDKv2 within the Private State for the Plugin Framework
+func frameworkPrivateState(input []byte) ([]byte, error) {
+	if len(input) == 0 {
+		return input, nil
+	}
+
+	// the Plugin Framework requires that each value is valid JSON, which the Plugin SDKv2 Private State is
+	if !json.Valid(input) {
+		return nil, fmt.Errorf("the Plugin SDKv2 Private State was not valid JSON")
+	}
+
+	return json.Marshal(map[string][]byte{
+		pluginSdkPrivateStateKey: input,
+	})
+}
+
+// pluginSdkPrivateState returns the Private State from Plugin SDKv2 nested within the Private State for the Plugin Framework
+func pluginSdkPrivateState(input []byte) ([]byte, error) {
+	if len(input) == 0 {
+		return input, nil
+	}
+
+	var data map[string][]byte
+	if err := json.Unmarshal(input, &data); err != nil {
+		return nil, fmt.Errorf("decoding the Plugin Framework Private State: %+v", err)
+	}
+
+	return data[pluginSdkPrivateStateKey], nil
+}
@@ -0,0 +1,511 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pluginSdkPrivateStateKey is the key within the Plugin Framework's Private State used to store the
+// Private State from Plugin SDKv2 (which contains the Timeouts and Schema Version for this Resource).
+//
+// This key only exists whilst the request is being processed by the Plugin Framework - the Private State
+// is stored in the Terraform State exactly as Plugin SDKv2 returned it (see FrameworkPrivateStateServer).
+const pluginSdkPrivateStateKey = "plugin_sdk"
+
+var (
+	_ resource.Resource                   = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithConfigure      = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithImportState    = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithModifyPlan     = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithUpgradeState   = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithValidateConfig = &FrameworkResourceWrapper{}
+)
+
+// FrameworkResourceWrapper is a wrapper for serving a Resource implementation via the Plugin Framework
+// rather than Plugin SDKv2, which allows Resources to be moved across one at a time.
+//
+// Each RPC is proxied through the Plugin SDKv2 implementation of this Resource, meaning that the Schema,
+// Plan (including ForceNew, Defaults and CustomizeDiff), State and State Upgraders are identical to
+// when this Resource is served by Plugin SDKv2 - as such the State isn't changed when moving between them.
+type FrameworkResourceWrapper struct {
+	resourceType string
+
+	// sdkProvider is a Plugin SDKv2 Provider containing only this Resource
+	sdkProvider *schema.Provider
+	sdkServer   tfprotov5.ProviderServer
+	sdkSchema   *tfprotov5.Schema
+
+	// sdkProviderMeta ensures the Meta for sdkProvider is only set once, since it's shared between
+	// each instance of this Resource (which can be used concurrently)
+	sdkProviderMeta *frameworkSdkProviderMeta
+
+	// configuredProvider is the configured Plugin SDKv2 Provider, from which the Meta is sourced
+	configuredProvider *schema.Provider
+}
+
+type frameworkSdkProviderMeta struct {
+	lock       sync.Mutex
+	configured bool
+}
+
+// NewFrameworkResourceWrapper returns a FrameworkResourceWrapper for the Plugin SDKv2 Resource `sdkResource`,
+// which should be the Resource registered in the Plugin SDKv2 Provider for this Resource Type
+func NewFrameworkResourceWrapper(resourceType string, sdkResource *schema.Resource) (*FrameworkResourceWrapper, error) {
+	sdkProvider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			resourceType: sdkResource,
+		},
+	}
+	sdkServer := schema.NewGRPCProviderServer(sdkProvider)
+
+	schemaResp, err := sdkServer.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Plugin SDKv2 Schema: %+v", err)
+	}
+	sdkSchema, ok := schemaResp.ResourceSchemas[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("the Plugin SDKv2 Schema was not found")
+	}
+
+	return &FrameworkResourceWrapper{
+		resourceType:    resourceType,
+		sdkProvider:     sdkProvider,
+		sdkServer:       sdkServer,
+		sdkSchema:       sdkSchema,
+		sdkProviderMeta: &frameworkSdkProviderMeta{},
+	}, nil
+}
+
+func (w *FrameworkResourceWrapper) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = w.resourceType
+}
+
+func (w *FrameworkResourceWrapper) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resourceSchema, err := frameworkResourceSchema(w.sdkSchema)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("converting the Schema for %q", w.resourceType), err.Error())
+		return
+	}
+
+	resp.Schema = *resourceSchema
+}
+
+func (w *FrameworkResourceWrapper) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	// the ProviderData is nil until the Provider has been configured
+	if v, ok := req.ProviderData.(*schema.Provider); ok {
+		w.configuredProvider = v
+	}
+}
+
+func (w *FrameworkResourceWrapper) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	config, err := w.dynamicValue(req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("converting config", err.Error())
+		return
+	}
+
+	result, err := w.sdkServer.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+		TypeName: w.resourceType,
+		Config:   config,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("validating %s", w.resourceType), err.Error())
+		return
+	}
+	appendProtocolDiagnostics(&resp.Diagnostics, result.Diagnostics)
+}
+
+func (w *FrameworkResourceWrapper) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the Plugin SDKv2 doesn't plan destroys, so there's nothing to do
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// the Plugin Framework marks any Computed attributes without a value in the config as unknown when
+	// there's a change - which Plugin SDKv2 instead decides - so we need to revert to the proposed value
+	proposed, err := proposedNewState(req.Plan.Raw, req.State.Raw, req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("determining the proposed new state", err.Error())
+		return
+	}
+
+	values, err := w.dynamicValues(req.State.Raw, proposed, req.Config.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("converting plan", err.Error())
+		return
+	}
+	priorPrivate, diags := req.Private.GetKey(ctx, pluginSdkPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+
+	w.configureSdkProvider()
+	result, err := w.sdkServer.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         w.resourceType,
+		PriorState:       values[0],
+		ProposedNewState: values[1],
+		Config:           values[2],
+		PriorPrivate:     priorPrivate,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("planning %s", w.resourceType), err.Error())
+		return
+	}
+	appendProtocolDiagnostics(&resp.Diagnostics, result.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned, err := result.PlannedState.Unmarshal(w.sdkSchema.ValueType())
+	if err != nil {
+		resp.Diagnostics.AddError("converting planned state", err.Error())
+		return
+	}
+	resp.Plan.Raw = planned
+	for _, v := range result.RequiresReplace {
+		resp.RequiresReplace = append(resp.RequiresReplace, frameworkPath(v))
+	}
+	w.setPrivate(ctx, resp.Private, result.PlannedPrivate, &resp.Diagnostics)
+}
+
+func (w *FrameworkResourceWrapper) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	prior := tftypes.NewValue(w.sdkSchema.ValueType(), nil)
+	newState := w.apply(ctx, prior, req.Plan.Raw, req.Config.Raw, nil, resp.Private, &resp.Diagnostics)
+	if newState != nil {
+		resp.State.Raw = *newState
+	}
+}
+
+func (w *FrameworkResourceWrapper) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	current, err := w.dynamicValue(req.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError("converting state", err.Error())
+		return
+	}
+	private, diags := req.Private.GetKey(ctx, pluginSdkPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+
+	w.configureSdkProvider()
+	result, err := w.sdkServer.ReadResource(ctx, &tfprotov5.ReadResourceRequest{
+		TypeName:     w.resourceType,
+		CurrentState: current,
+		Private:      private,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("reading %s", w.resourceType), err.Error())
+		return
+	}
+	appendProtocolDiagnostics(&resp.Diagnostics, result.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newState, err := result.NewState.Unmarshal(w.sdkSchema.ValueType())
+	if err != nil {
+		resp.Diagnostics.AddError("converting state", err.Error())
+		return
+	}
+	if newState.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.State.Raw = newState
+	w.setPrivate(ctx, resp.Private, result.Private, &resp.Diagnostics)
+}
+
+func (w *FrameworkResourceWrapper) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	newState := w.apply(ctx, req.State.Raw, req.Plan.Raw, req.Config.Raw, req.Private, resp.Private, &resp.Diagnostics)
+	if newState != nil {
+		resp.State.Raw = *newState
+	}
+}
+
+func (w *FrameworkResourceWrapper) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	values, err := w.dynamicValues(req.State.Raw, tftypes.NewValue(w.sdkSchema.ValueType(), nil))
+	if err != nil {
+		resp.Diagnostics.AddError("converting state", err.Error())
+		return
+	}
+	private, diags := req.Private.GetKey(ctx, pluginSdkPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+
+	w.configureSdkProvider()
+	result, err := w.sdkServer.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:       w.resourceType,
+		PriorState:     values[0],
+		PlannedState:   values[1],
+		Config:         values[1],
+		PlannedPrivate: private,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("deleting %s", w.resourceType), err.Error())
+		return
+	}
+	appendProtocolDiagnostics(&resp.Diagnostics, result.Diagnostics)
+}
+
+func (w *FrameworkResourceWrapper) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	w.configureSdkProvider()
+	result, err := w.sdkServer.ImportResourceState(ctx, &tfprotov5.ImportResourceStateRequest{
+		TypeName: w.resourceType,
+		ID:       req.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s", w.resourceType), err.Error())
+		return
+	}
+	appendProtocolDiagnostics(&resp.Diagnostics, result.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(result.ImportedResources) != 1 {
+		resp.Diagnostics.AddError(fmt.Sprintf("importing %s", w.resourceType), fmt.Sprintf("expected a single resource to be imported but got %d", len(result.ImportedResources)))
+		return
+	}
+	imported := result.ImportedResources[0]
+
+	state, err := imported.State.Unmarshal(w.sdkSchema.ValueType())
+	if err != nil {
+		resp.Diagnostics.AddError("converting state", err.Error())
+		return
+	}
+	resp.State.Raw = state
+	w.setPrivate(ctx, resp.Private, imported.Private, &resp.Diagnostics)
+}
+
+func (w *FrameworkResourceWrapper) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader)
+
+	// Plugin SDKv2 upgrades the State from any prior version to the current version
+	for version := int64(0); version < w.sdkSchema.Version; version++ {
+		version := version
+		upgraders[version] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					resp.Diagnostics.AddError("upgrading state", "the raw state was nil")
+					return
+				}
+
+				w.configureSdkProvider()
+				result, err := w.sdkServer.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+					TypeName: w.resourceType,
+					Version:  version,
+					RawState: &tfprotov5.RawState{
+						JSON:    req.RawState.JSON,
+						Flatmap: req.RawState.Flatmap,
+					},
+				})
+				if err != nil {
+					resp.Diagnostics.AddError(fmt.Sprintf("upgrading the state for %s", w.resourceType), err.Error())
+					return
+				}
+				appendProtocolDiagnostics(&resp.Diagnostics, result.Diagnostics)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				state, err := result.UpgradedState.Unmarshal(w.sdkSchema.ValueType())
+				if err != nil {
+					resp.Diagnostics.AddError("converting state", err.Error())
+					return
+				}
+				resp.State.Raw = state
+			},
+		}
+	}
+
+	return upgraders
+}
+
+// apply creates or updates this Resource using the Plugin SDKv2 implementation, returning the new State
+func (w *FrameworkResourceWrapper) apply(ctx context.Context, prior, planned, config tftypes.Value, priorPrivate interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}, responsePrivate interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}, diags *diag.Diagnostics) *tftypes.Value {
+	values, err := w.dynamicValues(prior, planned, config)
+	if err != nil {
+		diags.AddError("converting plan", err.Error())
+		return nil
+	}
+
+	var private []byte
+	if priorPrivate != nil {
+		v, privateDiags := priorPrivate.GetKey(ctx, pluginSdkPrivateStateKey)
+		diags.Append(privateDiags...)
+		private = v
+	}
+
+	w.configureSdkProvider()
+
+	// the Plugin Framework doesn't pass through the Private State from the Plan during an Apply - however
+	// this contains the Timeouts from the Configuration, so we need to re-plan to obtain these
+	plan, err := w.sdkServer.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         w.resourceType,
+		PriorState:       values[0],
+		ProposedNewState: values[1],
+		Config:           values[2],
+		PriorPrivate:     private,
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("planning %s", w.resourceType), err.Error())
+		return nil
+	}
+	appendProtocolDiagnostics(diags, plan.Diagnostics)
+	if diags.HasError() {
+		return nil
+	}
+
+	result, err := w.sdkServer.ApplyResourceChange(ctx, &tfprotov5.ApplyResourceChangeRequest{
+		TypeName:       w.resourceType,
+		PriorState:     values[0],
+		PlannedState:   values[1],
+		Config:         values[2],
+		PlannedPrivate: plan.PlannedPrivate,
+	})
+	if err != nil {
+		diags.AddError(fmt.Sprintf("applying %s", w.resourceType), err.Error())
+		return nil
+	}
+	appendProtocolDiagnostics(diags, result.Diagnostics)
+
+	// the State is returned even if the Apply failed, since the Resource may have been partially provisioned
+	if result.NewState == nil {
+		return nil
+	}
+	newState, err := result.NewState.Unmarshal(w.sdkSchema.ValueType())
+	if err != nil {
+		diags.AddError("converting state", err.Error())
+		return nil
+	}
+	w.setPrivate(ctx, responsePrivate, result.Private, diags)
+
+	return &newState
+}
+
+// configureSdkProvider ensures the Plugin SDKv2 Provider for this Resource has the Meta (e.g. Clients) from the
+// configured Provider - this is done lazily since the Muxed Providers can be configured in any order, but only
+// once, since the Plugin SDKv2 Provider is shared between each (concurrently used) instance of this Resource
+func (w *FrameworkResourceWrapper) configureSdkProvider() {
+	w.sdkProviderMeta.lock.Lock()
+	defer w.sdkProviderMeta.lock.Unlock()
+
+	if w.sdkProviderMeta.configured || w.configuredProvider == nil {
+		return
+	}
+
+	// the Meta is nil until the Plugin SDKv2 Provider has been configured
+	if meta := w.configuredProvider.Meta(); meta != nil {
+		w.sdkProvider.SetMeta(meta)
+		w.sdkProviderMeta.configured = true
+	}
+}
+
+func (w *FrameworkResourceWrapper) setPrivate(ctx context.Context, private interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}, value []byte, diags *diag.Diagnostics) {
+	if private == nil || len(value) == 0 {
+		return
+	}
+
+	diags.Append(private.SetKey(ctx, pluginSdkPrivateStateKey, value)...)
+}
+
+func (w *FrameworkResourceWrapper) dynamicValue(input tftypes.Value) (*tfprotov5.DynamicValue, error) {
+	value, err := tfprotov5.NewDynamicValue(w.sdkSchema.ValueType(), input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+func (w *FrameworkResourceWrapper) dynamicValues(input ...tftypes.Value) ([]*tfprotov5.DynamicValue, error) {
+	output := make([]*tfprotov5.DynamicValue, 0)
+	for _, v := range input {
+		value, err := w.dynamicValue(v)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, value)
+	}
+
+	return output, nil
+}
+
+// proposedNewState reverts any unknown values which were set by the Plugin Framework for Computed attributes
+// without a value in the configuration - returning the prior value (or null) as proposed by Terraform Core
+func proposedNewState(plan, prior, config tftypes.Value) (tftypes.Value, error) {
+	return tftypes.Transform(plan, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if v.IsKnown() || len(p.Steps()) == 0 {
+			return v, nil
+		}
+
+		// unknown values in the configuration (e.g. from other resources) are retained
+		if configValue, _, err := tftypes.WalkAttributePath(config, p); err == nil {
+			if cv, ok := configValue.(tftypes.Value); ok && !cv.IsKnown() {
+				return v, nil
+			}
+		}
+
+		if priorValue, _, err := tftypes.WalkAttributePath(prior, p); err == nil {
+			if pv, ok := priorValue.(tftypes.Value); ok {
+				return pv, nil
+			}
+		}
+
+		return tftypes.NewValue(v.Type(), nil), nil
+	})
+}
+
+// frameworkPath converts the protocol representation of an Attribute Path into a Plugin Framework Path
+func frameworkPath(input *tftypes.AttributePath) path.Path {
+	output := path.Empty()
+	if input == nil {
+		return output
+	}
+
+	for _, step := range input.Steps() {
+		switch v := step.(type) {
+		case tftypes.AttributeName:
+			output = output.AtName(string(v))
+		case tftypes.ElementKeyInt:
+			output = output.AtListIndex(int(v))
+		case tftypes.ElementKeyString:
+			output = output.AtMapKey(string(v))
+		default:
+			// elements within a Set are identified by their value, so use the Set itself
+			return output
+		}
+	}
+
+	return output
+}
+
+func appendProtocolDiagnostics(diags *diag.Diagnostics, input []*tfprotov5.Diagnostic) {
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		attributePath := frameworkPath(v.Attribute)
+		hasPath := v.Attribute != nil && len(v.Attribute.Steps()) > 0
+		switch {
+		case v.Severity == tfprotov5.DiagnosticSeverityWarning && hasPath:
+			diags.AddAttributeWarning(attributePath, v.Summary, v.Detail)
+		case v.Severity == tfprotov5.DiagnosticSeverityWarning:
+			diags.AddWarning(v.Summary, v.Detail)
+		case hasPath:
+			diags.AddAttributeError(attributePath, v.Summary, v.Detail)
+		default:
+			diags.AddError(v.Summary, v.Detail)
+		}
+	}
+}
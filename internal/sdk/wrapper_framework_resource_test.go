@@ -0,0 +1,202 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestFrameworkResourceWrapperSchemaMatchesPluginSdk(t *testing.T) {
+	ctx := context.TODO()
+	resourceWrapper := NewResourceWrapper(frameworkExampleResource{})
+	sdkResource, err := resourceWrapper.Resource()
+	if err != nil {
+		t.Fatalf("building resource: %+v", err)
+	}
+	wrapper, err := NewFrameworkResourceWrapper("framework_example", sdkResource)
+	if err != nil {
+		t.Fatalf("building wrapper: %+v", err)
+	}
+
+	server := providerserver.NewProtocol5(frameworkExampleProvider{
+		resource: wrapper,
+	})()
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("retrieving schema: %+v", err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("retrieving schema: %+v", resp.Diagnostics[0])
+	}
+
+	actual, ok := resp.ResourceSchemas["framework_example"]
+	if !ok {
+		t.Fatalf("the schema for `framework_example` was not found")
+	}
+
+	// the Plugin Framework doesn't expose the MinItems/MaxItems for blocks, and Plugin SDKv2 exposes
+	// the attributes and blocks in a different order
+	opts := []cmp.Option{
+		cmpopts.IgnoreFields(tfprotov5.SchemaNestedBlock{}, "MinItems", "MaxItems"),
+		cmpopts.IgnoreFields(tfprotov5.SchemaBlock{}, "DescriptionKind"),
+		cmpopts.IgnoreFields(tfprotov5.SchemaAttribute{}, "DescriptionKind"),
+		cmpopts.SortSlices(func(a, b *tfprotov5.SchemaAttribute) bool { return a.Name < b.Name }),
+		cmpopts.SortSlices(func(a, b *tfprotov5.SchemaNestedBlock) bool { return a.TypeName < b.TypeName }),
+	}
+	if diff := cmp.Diff(wrapper.sdkSchema, actual, opts...); diff != "" {
+		t.Fatalf("the Plugin Framework schema differs from the Plugin SDKv2 schema: %s", diff)
+	}
+}
+
+type frameworkExampleProvider struct {
+	resource resource.Resource
+}
+
+func (p frameworkExampleProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "framework"
+}
+
+func (p frameworkExampleProvider) Schema(_ context.Context, _ provider.SchemaRequest, _ *provider.SchemaResponse) {
+}
+
+func (p frameworkExampleProvider) Configure(_ context.Context, _ provider.ConfigureRequest, _ *provider.ConfigureResponse) {
+}
+
+func (p frameworkExampleProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p frameworkExampleProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource {
+			return p.resource
+		},
+	}
+}
+
+type frameworkExampleResource struct{}
+
+func (frameworkExampleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"number": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		"networks": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"map": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"list": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"inner": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"value": {
+									Type:      pluginsdk.TypeString,
+									Optional:  true,
+									Sensitive: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (frameworkExampleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (frameworkExampleResource) ModelObject() interface{} {
+	return nil
+}
+
+func (frameworkExampleResource) ResourceType() string {
+	return "framework_example"
+}
+
+func (frameworkExampleResource) Create() ResourceFunc {
+	return ResourceFunc{}
+}
+
+func (frameworkExampleResource) Read() ResourceFunc {
+	return ResourceFunc{}
+}
+
+func (frameworkExampleResource) Delete() ResourceFunc {
+	return ResourceFunc{}
+}
+
+func (frameworkExampleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
+
+func TestFrameworkPrivateStateRoundTrip(t *testing.T) {
+	testData := []string{
+		"",
+		`{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":1800000000000},"schema_version":"1"}`,
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v)
+
+		private, err := frameworkPrivateState([]byte(v))
+		if err != nil {
+			t.Fatalf("building the Framework Private State: %+v", err)
+		}
+
+		actual, err := pluginSdkPrivateState(private)
+		if err != nil {
+			t.Fatalf("retrieving the Plugin SDKv2 Private State: %+v", err)
+		}
+		if string(actual) != v {
+			t.Fatalf("expected %q but got %q", v, string(actual))
+		}
+	}
+}
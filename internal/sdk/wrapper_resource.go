@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -38,6 +40,9 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		if err := ValidateModelObject(modelObj); err != nil {
 			return nil, fmt.Errorf("validating model for %q: %+v", rw.resource.ResourceType(), err)
 		}
+		if err := ValidateModelObjectAgainstSchema(modelObj, *resourceSchema); err != nil {
+			return nil, fmt.Errorf("validating model against the schema for %q: %+v", rw.resource.ResourceType(), err)
+		}
 	}
 
 	d := func(duration time.Duration) *time.Duration {
@@ -47,8 +52,9 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
-		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		CreateContext: rw.diagnosticsWrapper("Create", *resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
+			ctx = rw.lockingContext(ctx, fmt.Sprintf("creating %s", rw.resource.ResourceType()))
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
 				return err
@@ -60,12 +66,13 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}),
 
 		// looks like these could be reused, easiest if they're not
-		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		ReadContext: rw.diagnosticsWrapper("Read", *resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
 			return rw.resource.Read().Func(ctx, metaData)
 		}),
-		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		DeleteContext: rw.diagnosticsWrapper("Delete", *resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
+			ctx = rw.lockingContext(ctx, fmt.Sprintf("deleting %s %q", rw.resource.ResourceType(), d.Id()))
 			return rw.resource.Delete().Func(ctx, metaData)
 		}),
 
@@ -110,8 +117,9 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// Not all resources support update - so this is an separate interface
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
-		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		resource.UpdateContext = rw.diagnosticsWrapper("Update", *resourceSchema, func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
+			ctx = rw.lockingContext(ctx, fmt.Sprintf("updating %s %q", rw.resource.ResourceType(), d.Id()))
 
 			err := v.Update().Func(ctx, metaData)
 			if err != nil {
@@ -175,20 +183,41 @@ and we recommend using the %[2]q resource instead.
 	return &resource, nil
 }
 
-func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return diagnosticsWrapper(in, rw.logger)
+// lockingContext returns a copy of the Context which identifies the operation acquiring any locks, so that
+// this can be surfaced when a lock is contended - and reports the time spent waiting on locks to the Logger
+func (rw *ResourceWrapper) lockingContext(ctx context.Context, holder string) context.Context {
+	ctx = locks.WithHolder(ctx, holder)
+	return locks.WithLogger(ctx, rw.logger)
+}
+
+func (rw *ResourceWrapper) diagnosticsWrapper(operationName string, resourceSchema map[string]*schema.Schema, in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return diagnosticsWrapper(in, rw.logger, resourceSchema, rw.resource.ResourceType(), operationName)
 }
 
-func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger, resourceSchema map[string]*schema.Schema, resourceType, operationName string) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// each operation uses its own Correlation Request ID, which (alongside the Request ID and Error Code
+		// returned from Azure) is surfaced in the error to allow the requests for this operation to be isolated
+		ctx, operation := common.WithOperation(ctx)
+
+		// the values of any Sensitive fields are redacted from the requests and responses logged during this operation
+		operation.AddSensitiveValues(resourceSchema, d)
+
+		// when tracing is enabled, the requests made to Azure are recorded as children of the Span for this operation
+		ctx, endSpan := common.StartOperationSpan(ctx, resourceType, operationName, d)
+		err := in(ctx, d, meta)
+		endSpan(err)
+
 		out := make([]diag.Diagnostic, 0)
-		if err := in(ctx, d, meta); err != nil {
-			out = append(out, diag.Diagnostic{
+		if err != nil {
+			// where the error was returned from Azure, the Resource Manager error is decoded to surface the
+			// failing field (and where denied, the Azure Policy Assignment) within the diagnostic
+			out = append(out, operation.EnrichDiagnostic(diag.Diagnostic{
 				Severity:      diag.Error,
 				Summary:       err.Error(),
 				Detail:        err.Error(),
 				AttributePath: nil,
-			})
+			}, resourceSchema))
 		}
 
 		if diagsLogger, ok := logger.(*DiagnosticsLogger); ok {
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
@@ -18,8 +20,6 @@ func ValidateModelObject(input interface{}) error {
 		return fmt.Errorf("need a pointer to the model object")
 	}
 
-	// TODO: could we also validate that each `tfschema` tag exists in the schema?
-
 	objType := reflect.TypeOf(input).Elem()
 	objVal := reflect.ValueOf(input).Elem()
 
@@ -64,3 +64,115 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 
 	return nil
 }
+
+// ValidateModelObjectAgainstSchema validates that each `tfschema` tag within the object exists in the
+// specified schema, and that the Go type of each field is compatible with the type of that schema field
+func ValidateModelObjectAgainstSchema(input interface{}, resourceSchema map[string]*schema.Schema) error {
+	if input == nil {
+		// model not used for this resource
+		return nil
+	}
+
+	if reflect.TypeOf(input).Kind() != reflect.Ptr {
+		return fmt.Errorf("need a pointer to the model object")
+	}
+
+	objType := reflect.TypeOf(input).Elem()
+	if objType.Kind() != reflect.Struct {
+		return fmt.Errorf("the model object must be a struct but got %s", objType.Kind())
+	}
+
+	return validateModelObjectAgainstSchemaRecursively("", objType, resourceSchema)
+}
+
+func validateModelObjectAgainstSchemaRecursively(prefix string, objType reflect.Type, resourceSchema map[string]*schema.Schema) error {
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+
+		key, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			return fmt.Errorf("field %q is missing an `tfschema` label", fieldName)
+		}
+
+		fieldSchema, ok := resourceSchema[key]
+		if !ok {
+			return fmt.Errorf("field %q has the `tfschema` label %q which isn't defined in the schema", fieldName, key)
+		}
+
+		if err := validateModelFieldAgainstSchema(fieldName, field.Type, fieldSchema); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateModelFieldAgainstSchema(fieldName string, fieldType reflect.Type, fieldSchema *schema.Schema) error {
+	if fieldType.Kind() == reflect.Interface {
+		// the value is decoded as-is, so any schema type is compatible
+		return nil
+	}
+
+	mismatch := func() error {
+		return fmt.Errorf("field %q is of type %s which isn't compatible with the schema type %s", fieldName, fieldType.String(), fieldSchema.Type.String())
+	}
+
+	switch fieldSchema.Type {
+	case schema.TypeBool:
+		if fieldType.Kind() != reflect.Bool {
+			return mismatch()
+		}
+
+	case schema.TypeFloat:
+		if fieldType.Kind() != reflect.Float32 && fieldType.Kind() != reflect.Float64 {
+			return mismatch()
+		}
+
+	case schema.TypeInt:
+		switch fieldType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		default:
+			return mismatch()
+		}
+
+	case schema.TypeString:
+		if fieldType.Kind() != reflect.String {
+			return mismatch()
+		}
+
+	case schema.TypeMap:
+		if fieldType.Kind() != reflect.Map || fieldType.Key().Kind() != reflect.String {
+			return mismatch()
+		}
+
+		// when an Elem isn't specified Plugin SDKv2 defaults to a map of strings
+		elemSchema := &schema.Schema{Type: schema.TypeString}
+		if v, ok := fieldSchema.Elem.(*schema.Schema); ok {
+			elemSchema = v
+		}
+		return validateModelFieldAgainstSchema(fmt.Sprintf("%s[key]", fieldName), fieldType.Elem(), elemSchema)
+
+	case schema.TypeList, schema.TypeSet:
+		if fieldType.Kind() != reflect.Slice {
+			return mismatch()
+		}
+
+		elemType := fieldType.Elem()
+		switch elem := fieldSchema.Elem.(type) {
+		case *schema.Resource:
+			if elemType.Kind() != reflect.Struct {
+				return fmt.Errorf("field %q is of type %s but the schema contains a nested block, so it must be a slice of a struct", fieldName, fieldType.String())
+			}
+			return validateModelObjectAgainstSchemaRecursively(fieldName, elemType, elem.Schema)
+
+		case *schema.Schema:
+			return validateModelFieldAgainstSchema(fmt.Sprintf("%s[index]", fieldName), elemType, elem)
+		}
+
+	default:
+		return fmt.Errorf("field %q has an unsupported schema type %s", fieldName, fieldSchema.Type.String())
+	}
+
+	return nil
+}
@@ -1,6 +1,10 @@
 package sdk
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -66,3 +70,98 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateModelObjectAgainstSchemaValid(t *testing.T) {
+	type Pet struct {
+		Name string   `tfschema:"name"`
+		Tags []string `tfschema:"tags"`
+	}
+	type Person struct {
+		Name    string            `tfschema:"name"`
+		Age     int64             `tfschema:"age"`
+		Height  float64           `tfschema:"height"`
+		Enabled bool              `tfschema:"enabled"`
+		Labels  map[string]string `tfschema:"labels"`
+		Pets    []Pet             `tfschema:"pets"`
+	}
+	s := map[string]*schema.Schema{
+		"name":    {Type: schema.TypeString, Required: true},
+		"age":     {Type: schema.TypeInt, Optional: true},
+		"height":  {Type: schema.TypeFloat, Optional: true},
+		"enabled": {Type: schema.TypeBool, Optional: true},
+		"labels": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"pets": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Required: true},
+					"tags": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, s); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaMissingKey(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:"name"`
+		Age  int    `tfschema:"age"`
+	}
+	s := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Required: true},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, s); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaTypeMismatch(t *testing.T) {
+	type Person struct {
+		Name string `tfschema:"name"`
+		Age  string `tfschema:"age"`
+	}
+	s := map[string]*schema.Schema{
+		"name": {Type: schema.TypeString, Required: true},
+		"age":  {Type: schema.TypeInt, Optional: true},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, s); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaNestedMismatch(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+		Age  bool   `tfschema:"age"`
+	}
+	type Person struct {
+		Pets []Pet `tfschema:"pets"`
+	}
+	s := map[string]*schema.Schema{
+		"pets": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {Type: schema.TypeString, Required: true},
+					"age":  {Type: schema.TypeInt, Optional: true},
+				},
+			},
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, s); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
@@ -18,6 +18,13 @@ func (r Registration) Name() string {
 	return "Advisor"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Advisor",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -120,12 +120,12 @@ func resourceAnalysisServicesServer() *pluginsdk.Resource {
 				}, false),
 			},
 
-			"backup_blob_container_uri": {
+			"backup_blob_container_uri": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"server_full_name": {
 				Type:     pluginsdk.TypeString,
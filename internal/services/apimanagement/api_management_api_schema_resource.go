@@ -130,7 +130,7 @@ func resourceApiManagementApiSchemaCreateUpdate(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("waiting for creation/update of %q: %+v", id, err)
 	}
 
-	err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
+	err = pluginsdk.Retry(timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
 		resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.SchemaName)
 		if err != nil {
 			if utils.ResponseWasNotFound(resp.Response) {
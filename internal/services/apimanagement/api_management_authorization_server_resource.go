@@ -127,11 +127,11 @@ func resourceApiManagementAuthorizationServer() *pluginsdk.Resource {
 				Set: pluginsdk.HashString,
 			},
 
-			"client_secret": {
+			"client_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"default_scope": {
 				Type:     pluginsdk.TypeString,
@@ -148,11 +148,11 @@ func resourceApiManagementAuthorizationServer() *pluginsdk.Resource {
 				Optional: true,
 			},
 
-			"resource_owner_password": {
+			"resource_owner_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"support_state": {
 				Type:     pluginsdk.TypeBool,
@@ -125,9 +125,9 @@ func apiManagementCustomDomainCreateUpdate(d *pluginsdk.ResourceData, meta inter
 		ContinuousTargetOccurence: 6,
 	}
 	if d.IsNewResource() {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
@@ -237,7 +237,7 @@ func apiManagementCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}
 		Target:                    []string{"Succeeded", "Ready"},
 		Refresh:                   apiManagementRefreshFunc(ctx, client, id.ServiceName, id.ResourceGroup),
 		MinTimeout:                1 * time.Minute,
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		ContinuousTargetOccurence: 6,
 	}
 
@@ -44,12 +44,12 @@ func resourceArmApiManagementIdentityProviderAADB2C() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 
-			"client_secret": {
+			"client_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			// For AADB2C identity providers, `allowed_tenants` must specify exactly one tenant
 			"allowed_tenant": {
@@ -44,12 +44,12 @@ func resourceApiManagementIdentityProviderFacebook() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"app_secret": {
+			"app_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 		},
 	}
 }
@@ -45,12 +45,12 @@ func resourceApiManagementIdentityProviderGoogle() *pluginsdk.Resource {
 				ValidateFunc: validate.GoogleClientID,
 			},
 
-			"client_secret": {
+			"client_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 		},
 	}
 }
@@ -44,12 +44,12 @@ func resourceApiManagementIdentityProviderMicrosoft() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 
-			"client_secret": {
+			"client_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 		},
 	}
 }
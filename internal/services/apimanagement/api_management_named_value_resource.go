@@ -70,13 +70,13 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 				},
 			},
 
-			"value": {
+			"value": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 				ExactlyOneOf: []string{"value", "value_from_key_vault"},
-			},
+			}),
 
 			"secret": {
 				Type:     pluginsdk.TypeBool,
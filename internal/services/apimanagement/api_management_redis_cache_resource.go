@@ -53,12 +53,12 @@ func resourceApiManagementRedisCache() *pluginsdk.Resource {
 				ValidateFunc: validate.ApiManagementID,
 			},
 
-			"connection_string": {
+			"connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"redis_cache_id": {
 				Type:         pluginsdk.TypeString,
@@ -23,19 +23,19 @@ func apiManagementResourceHostnameSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
 		},
 
-		"certificate": {
+		"certificate": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 
-		"certificate_password": {
+		"certificate_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 
 		"negotiate_client_certificate": {
 			Type:     pluginsdk.TypeBool,
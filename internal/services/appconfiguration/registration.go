@@ -35,6 +35,13 @@ func (r Registration) Name() string {
 	return "App Configuration"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.AppConfiguration",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -21,6 +21,14 @@ func (r Registration) Name() string {
 	return "Application Insights"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"microsoft.insights",
+		"Microsoft.AlertsManagement",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
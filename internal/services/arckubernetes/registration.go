@@ -17,6 +17,14 @@ func (r Registration) Name() string {
 	return "ArcKubernetes"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Kubernetes",
+		"Microsoft.KubernetesConfiguration",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -231,7 +231,7 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 		properties.RoleAssignmentProperties.PrincipalType = authorization.ServicePrincipal
 	}
 
-	if err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, scope, name, properties, meta, tenantId)); err != nil {
+	if err := pluginsdk.Retry(timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate), retryRoleAssignmentsClient(d, scope, name, properties, meta, tenantId)); err != nil {
 		return err
 	}
 
@@ -348,7 +348,7 @@ func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name st
 			Refresh:                   roleAssignmentCreateStateRefreshFunc(ctx, roleAssignmentsClient, *resp.ID, tenantId),
 			MinTimeout:                5 * time.Second,
 			ContinuousTargetOccurence: 5,
-			Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+			Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 		}
 
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
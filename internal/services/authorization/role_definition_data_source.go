@@ -122,7 +122,7 @@ func dataSourceArmRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 	var role authorization.RoleDefinition
 	if name != "" {
 		// Accounting for eventual consistency
-		err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutRead), func() *pluginsdk.RetryError {
+		err := pluginsdk.Retry(timeouts.DurationFor(ctx, d, pluginsdk.TimeoutRead), func() *pluginsdk.RetryError {
 			roleDefinitions, err := client.List(ctx, scope, fmt.Sprintf("roleName eq '%s'", name))
 			if err != nil {
 				return pluginsdk.NonRetryableError(fmt.Errorf("loading Role Definition List: %+v", err))
@@ -191,7 +191,7 @@ func resourceArmRoleDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}
 			Refresh:                   roleDefinitionUpdateStateRefreshFunc(ctx, client, id.ResourceID),
 			MinTimeout:                10 * time.Second,
 			ContinuousTargetOccurence: 12,
-			Timeout:                   d.Timeout(pluginsdk.TimeoutUpdate),
+			Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 		}
 
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -264,7 +264,7 @@ func resourceArmRoleDefinitionUpdate(d *pluginsdk.ResourceData, meta interface{}
 		Pending:                   []string{"Pending"},
 		Target:                    []string{"Updated"},
 		Refresh:                   roleDefinitionEventualConsistencyUpdate(ctx, client, *roleDefinitionId, *updatedOn),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutUpdate),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 	}
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for Role Definition %q (Scope %q) to settle down: %+v", roleDefinitionId.RoleID, roleDefinitionId.Scope, err)
@@ -341,7 +341,7 @@ func resourceArmRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}
 		Refresh:                   roleDefinitionDeleteStateRefreshFunc(ctx, client, id.ResourceID),
 		MinTimeout:                10 * time.Second,
 		ContinuousTargetOccurence: 20,
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
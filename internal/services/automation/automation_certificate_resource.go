@@ -56,13 +56,13 @@ func resourceAutomationCertificate() *pluginsdk.Resource {
 				Optional: true,
 			},
 
-			"base64": {
+			"base64": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsBase64,
-			},
+			}),
 
 			"exportable": {
 				Type:     pluginsdk.TypeBool,
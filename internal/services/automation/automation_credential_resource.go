@@ -55,11 +55,11 @@ func resourceAutomationCredential() *pluginsdk.Resource {
 				Required: true,
 			},
 
-			"password": {
+			"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Required:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"description": {
 				Type:     pluginsdk.TypeString,
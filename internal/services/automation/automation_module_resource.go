@@ -165,9 +165,9 @@ func resourceAutomationModuleCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		},
 	}
 	if d.IsNewResource() {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
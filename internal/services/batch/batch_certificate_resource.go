@@ -54,12 +54,12 @@ func resourceBatchCertificate() *pluginsdk.Resource {
 			// https://github.com/Azure/azure-rest-api-specs/issues/5574
 			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
 
-			"certificate": {
+			"certificate": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringLenBetween(1, 10000),
-			},
+			}),
 
 			"format": {
 				Type:     pluginsdk.TypeString,
@@ -70,11 +70,11 @@ func resourceBatchCertificate() *pluginsdk.Resource {
 				}, false),
 			},
 
-			"password": {
+			"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true, // Cannot be used when `format` is "Cer"
 				Sensitive: true,
-			},
+			}),
 
 			"thumbprint": {
 				Type:             pluginsdk.TypeString,
@@ -323,12 +323,12 @@ func resourceBatchPool() *pluginsdk.Resource {
 										Required:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
-									"account_key": {
+									"account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
 										Optional:     true,
 										Sensitive:    true,
 										ValidateFunc: validation.StringIsNotEmpty,
-									},
+									}),
 									"sas_key": {
 										Type:         pluginsdk.TypeString,
 										Optional:     true,
@@ -363,12 +363,12 @@ func resourceBatchPool() *pluginsdk.Resource {
 										Required:     true,
 										ValidateFunc: validation.IsURLWithHTTPS,
 									},
-									"account_key": {
+									"account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
 										Required:     true,
 										Sensitive:    true,
 										ValidateFunc: validation.StringIsNotEmpty,
-									},
+									}),
 									"relative_mount_path": {
 										Type:         pluginsdk.TypeString,
 										Required:     true,
@@ -407,12 +407,12 @@ func resourceBatchPool() *pluginsdk.Resource {
 										Optional:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
-									"password": {
+									"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
 										Required:     true,
 										Sensitive:    true,
 										ValidateFunc: validation.StringIsNotEmpty,
-									},
+									}),
 								},
 							},
 						},
@@ -737,12 +737,12 @@ func resourceBatchPool() *pluginsdk.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
-						"password": {
+						"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 						"elevation_level": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
@@ -764,11 +764,11 @@ func resourceBatchPool() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeInt,
 										Optional: true,
 									},
-									"ssh_private_key": {
+									"ssh_private_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 										Type:      pluginsdk.TypeString,
 										Optional:  true,
 										Sensitive: true,
-									},
+									}),
 								},
 							},
 						},
@@ -1559,12 +1559,12 @@ func containerRegistry() map[string]*schema.Schema {
 			ForceNew:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
-		"password": {
+		"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			ForceNew:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 	}
 }
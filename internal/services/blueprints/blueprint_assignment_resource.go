@@ -214,7 +214,7 @@ func resourceBlueprintAssignmentCreateUpdate(d *pluginsdk.ResourceData, meta int
 		},
 		Target:  []string{string(blueprint.Succeeded)},
 		Refresh: blueprintAssignmentCreateStateRefreshFunc(ctx, client, targetScope, name),
-		Timeout: d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout: timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -345,7 +345,7 @@ func resourceBlueprintAssignmentDelete(d *pluginsdk.ResourceData, meta interface
 		},
 		Target:  []string{"NotFound"},
 		Refresh: blueprintAssignmentDeleteStateRefreshFunc(ctx, client, id.Scope, id.Name),
-		Timeout: d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout: timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -107,7 +107,7 @@ func resourceBotChannelAlexaCreate(d *pluginsdk.ResourceData, meta interface{})
 		Target:     []string{"200", "202"},
 		Refresh:    botChannelAlexaStateRefreshFunc(ctx, client, id),
 		MinTimeout: 15 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -191,7 +191,7 @@ func resourceBotChannelAlexaUpdate(d *pluginsdk.ResourceData, meta interface{})
 		Target:     []string{"200", "202"},
 		Refresh:    botChannelAlexaStateRefreshFunc(ctx, client, *id),
 		MinTimeout: 15 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
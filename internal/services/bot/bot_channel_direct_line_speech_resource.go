@@ -52,12 +52,12 @@ func resourceBotChannelDirectLineSpeech() *pluginsdk.Resource {
 				ValidateFunc: validate.BotName,
 			},
 
-			"cognitive_service_access_key": {
+			"cognitive_service_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"cognitive_service_location": commonschema.LocationWithoutForceNew(),
 
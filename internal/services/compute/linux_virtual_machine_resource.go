@@ -93,14 +93,14 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 			// Optional
 			"additional_capabilities": virtualMachineAdditionalCapabilitiesSchema(),
 
-			"admin_password": {
+			"admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				ForceNew:         true,
 				Sensitive:        true,
 				DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 				ValidateFunc:     computeValidate.LinuxAdminPassword,
-			},
+			}),
 
 			"admin_ssh_key": SSHKeysSchema(true),
 
@@ -151,7 +151,7 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.LinuxComputerNameFull,
 			},
 
-			"custom_data": base64.OptionalSchema(true),
+			"custom_data": pluginsdk.WriteOnly(base64.OptionalSchema(true)),
 
 			"dedicated_host_id": {
 				Type:         pluginsdk.TypeString,
@@ -1576,7 +1576,7 @@ func resourceLinuxVirtualMachineDelete(d *pluginsdk.ResourceData, meta interface
 			Pending:    []string{"200"},
 			Target:     []string{"404"},
 			MinTimeout: 30 * time.Second,
-			Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
+			Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 			Refresh: func() (interface{}, string, error) {
 				log.Printf("[INFO] checking on state of Linux Virtual Machine %q", id.Name)
 				resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
@@ -1175,13 +1175,13 @@ func resourceLinuxVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema {
 		// Optional
 		"additional_capabilities": VirtualMachineScaleSetAdditionalCapabilitiesSchema(),
 
-		"admin_password": {
+		"admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:             pluginsdk.TypeString,
 			Optional:         true,
 			ForceNew:         true,
 			Sensitive:        true,
 			DiffSuppressFunc: adminPasswordDiffSuppressFunc,
-		},
+		}),
 
 		"admin_ssh_key": SSHKeysSchema(false),
 
@@ -1212,7 +1212,7 @@ func resourceLinuxVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: validate.LinuxComputerNamePrefix,
 		},
 
-		"custom_data": base64.OptionalSchema(false),
+		"custom_data": pluginsdk.WriteOnly(base64.OptionalSchema(false)),
 
 		"data_disk": VirtualMachineScaleSetDataDiskSchema(),
 
@@ -22,12 +22,12 @@ func OrchestratedVirtualMachineScaleSetOSProfileSchema() *pluginsdk.Schema {
 		MaxItems: 1,
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
-				"custom_data": {
+				"custom_data": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringIsBase64,
-				},
+				}),
 				"windows_configuration": OrchestratedVirtualMachineScaleSetWindowsConfigurationSchema(),
 				"linux_configuration":   OrchestratedVirtualMachineScaleSetLinuxConfigurationSchema(),
 			},
@@ -49,14 +49,14 @@ func OrchestratedVirtualMachineScaleSetWindowsConfigurationSchema() *pluginsdk.S
 					ValidateFunc: validateAdminUsernameWindows,
 				},
 
-				"admin_password": {
+				"admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:             pluginsdk.TypeString,
 					Required:         true,
 					ForceNew:         true,
 					Sensitive:        true,
 					DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 					ValidateFunc:     validatePasswordComplexityWindows,
-				},
+				}),
 
 				"computer_name_prefix": computerPrefixWindowsSchema(),
 
@@ -132,14 +132,14 @@ func OrchestratedVirtualMachineScaleSetLinuxConfigurationSchema() *pluginsdk.Sch
 					ValidateFunc: validateAdminUsernameLinux,
 				},
 
-				"admin_password": {
+				"admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:             pluginsdk.TypeString,
 					Optional:         true,
 					ForceNew:         true,
 					Sensitive:        true,
 					DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 					ValidateFunc:     validatePasswordComplexityLinux,
-				},
+				}),
 
 				"admin_ssh_key":        SSHKeysSchema(false),
 				"computer_name_prefix": computerPrefixLinuxSchema(),
@@ -231,12 +231,12 @@ func OrchestratedVirtualMachineScaleSetExtensionsSchema() *pluginsdk.Schema {
 					Optional: true,
 				},
 
-				"protected_settings": {
+				"protected_settings": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringIsJSON,
-				},
+				}),
 
 				// Need to check `protected_settings_from_key_vault` conflicting with `protected_settings` in iteration
 				"protected_settings_from_key_vault": protectedSettingsFromKeyVaultSchema(false),
@@ -12,6 +12,15 @@ func (r Registration) Name() string {
 	return "Compute"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Compute",
+		// required for `azurerm_marketplace_agreement`
+		"Microsoft.MarketplaceOrdering",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -482,7 +482,7 @@ func resourceSharedImageDelete(d *pluginsdk.ResourceData, meta interface{}) erro
 		Refresh:                   sharedImageDeleteStateRefreshFunc(ctx, client, id.ResourceGroup, id.GalleryName, id.ImageName),
 		MinTimeout:                10 * time.Second,
 		ContinuousTargetOccurence: 10,
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -22,12 +22,12 @@ func additionalUnattendContentSchema() *pluginsdk.Schema {
 		ForceNew: true,
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
-				"content": {
+				"content": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:      pluginsdk.TypeString,
 					Required:  true,
 					ForceNew:  true,
 					Sensitive: true,
-				},
+				}),
 				"setting": {
 					Type:     pluginsdk.TypeString,
 					Required: true,
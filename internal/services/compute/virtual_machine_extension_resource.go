@@ -93,14 +93,14 @@ func resourceVirtualMachineExtension() *pluginsdk.Resource {
 			},
 
 			// due to the sensitive nature, these are not returned by the API
-			"protected_settings": {
+			"protected_settings": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				Sensitive:        true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 				ConflictsWith:    []string{"protected_settings_from_key_vault"},
-			},
+			}),
 
 			"protected_settings_from_key_vault": protectedSettingsFromKeyVaultSchema(true),
 
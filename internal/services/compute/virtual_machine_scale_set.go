@@ -2085,12 +2085,12 @@ func VirtualMachineScaleSetExtensionsSchema() *pluginsdk.Schema {
 					Optional: true,
 				},
 
-				"protected_settings": {
+				"protected_settings": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringIsJSON,
-				},
+				}),
 
 				// Need to check `protected_settings_from_key_vault` conflicting with `protected_settings` in iteration
 				"protected_settings_from_key_vault": protectedSettingsFromKeyVaultSchema(false),
@@ -97,13 +97,13 @@ func resourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
 				Optional: true,
 			},
 
-			"protected_settings": {
+			"protected_settings": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				Sensitive:        true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
-			},
+			}),
 
 			"protected_settings_from_key_vault": protectedSettingsFromKeyVaultSchema(true),
 
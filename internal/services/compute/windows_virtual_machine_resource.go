@@ -66,14 +66,14 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 			"location": commonschema.Location(),
 
 			// Required
-			"admin_password": {
+			"admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:             pluginsdk.TypeString,
 				Required:         true,
 				ForceNew:         true,
 				Sensitive:        true,
 				DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 				ValidateFunc:     computeValidate.WindowsAdminPassword,
-			},
+			}),
 
 			"admin_username": {
 				Type:         pluginsdk.TypeString,
@@ -152,7 +152,7 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.WindowsComputerNameFull,
 			},
 
-			"custom_data": base64.OptionalSchema(true),
+			"custom_data": pluginsdk.WriteOnly(base64.OptionalSchema(true)),
 
 			"dedicated_host_id": {
 				Type:         pluginsdk.TypeString,
@@ -1646,7 +1646,7 @@ func resourceWindowsVirtualMachineDelete(d *pluginsdk.ResourceData, meta interfa
 			Pending:    []string{"200"},
 			Target:     []string{"404"},
 			MinTimeout: 30 * time.Second,
-			Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
+			Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 			Refresh: func() (interface{}, string, error) {
 				log.Printf("[INFO] checking on state of Windows Virtual Machine %q", id.Name)
 				resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
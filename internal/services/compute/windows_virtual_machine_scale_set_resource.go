@@ -1187,14 +1187,14 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 
-		"admin_password": {
+		"admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:             pluginsdk.TypeString,
 			Required:         true,
 			ForceNew:         true,
 			Sensitive:        true,
 			DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 			ValidateFunc:     validation.StringIsNotEmpty,
-		},
+		}),
 
 		"network_interface": VirtualMachineScaleSetNetworkInterfaceSchema(),
 
@@ -1244,7 +1244,7 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 			ValidateFunc: computeValidate.WindowsComputerNamePrefix,
 		},
 
-		"custom_data": base64.OptionalSchema(false),
+		"custom_data": pluginsdk.WriteOnly(base64.OptionalSchema(false)),
 
 		"data_disk": VirtualMachineScaleSetDataDiskSchema(),
 
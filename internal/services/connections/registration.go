@@ -13,6 +13,13 @@ func (r Registration) Name() string {
 	return "Connections"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Web",
+	}
+}
+
 func (r Registration) WebsiteCategories() []string {
 	return []string{
 		"Connections",
@@ -76,13 +76,13 @@ func (r ContainerAppEnvironmentCertificateResource) Arguments() map[string]*plug
 			Description:  "The Certificate Private Key as a base64 encoded PFX or PEM.",
 		},
 
-		"certificate_password": {
+		"certificate_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:        pluginsdk.TypeString,
 			Required:    true,
 			ForceNew:    true,
 			Sensitive:   true,
 			Description: "The password for the Certificate.",
-		},
+		}),
 
 		"tags": commonschema.Tags(),
 	}
@@ -66,13 +66,13 @@ func (r ContainerAppEnvironmentStorageResource) Arguments() map[string]*pluginsd
 			Description:  "The Azure Storage Account in which the Share to be used is located.",
 		},
 
-		"access_key": {
+		"access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
 			Description:  "The Storage Account Access Key.",
-		},
+		}),
 
 		"share_name": {
 			Type:         pluginsdk.TypeString,
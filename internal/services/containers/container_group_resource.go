@@ -19,9 +19,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -110,13 +110,13 @@ func resourceContainerGroup() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"password": {
+						"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ForceNew:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 					},
 				},
 			},
@@ -454,13 +454,13 @@ func resourceContainerGroup() *pluginsdk.Resource {
 										ValidateFunc: validation.IsUUID,
 									},
 
-									"workspace_key": {
+									"workspace_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
 										Required:     true,
 										Sensitive:    true,
 										ForceNew:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
-									},
+									}),
 
 									"log_type": {
 										Type:     pluginsdk.TypeString,
@@ -586,13 +586,13 @@ func containerVolumeSchema() *pluginsdk.Schema {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 
-				"storage_account_key": {
+				"storage_account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
 					Sensitive:    true,
 					ForceNew:     true,
 					ValidateFunc: validation.StringIsNotEmpty,
-				},
+				}),
 
 				"empty_dir": {
 					Type:     pluginsdk.TypeBool,
@@ -629,7 +629,7 @@ func containerVolumeSchema() *pluginsdk.Schema {
 					},
 				},
 
-				"secret": {
+				"secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:      pluginsdk.TypeMap,
 					ForceNew:  true,
 					Optional:  true,
@@ -637,7 +637,7 @@ func containerVolumeSchema() *pluginsdk.Schema {
 					Elem: &pluginsdk.Schema{
 						Type: pluginsdk.TypeString,
 					},
-				},
+				}),
 			},
 		},
 	}
@@ -750,15 +750,20 @@ func resourceContainerGroupCreate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	// Avoid parallel provisioning if "subnet_ids" are given.
 	if subnets != nil && len(*subnets) != 0 {
+		subnetsToLock := make([]networkParse.SubnetId, 0)
 		for _, item := range *subnets {
 			subnet, err := networkParse.SubnetID(item.Id)
 			if err != nil {
 				return fmt.Errorf(`parsing subnet id %q: %v`, item.Id, err)
 			}
+			subnetsToLock = append(subnetsToLock, *subnet)
+		}
 
-			locks.ByID(subnet.ID())
-			defer locks.UnlockByID(subnet.ID())
+		networkLocks := locking.New(meta)
+		if err := networkLocks.LockSubnets(ctx, subnetsToLock...); err != nil {
+			return err
 		}
+		defer networkLocks.UnlockSubnets(subnetsToLock...)
 	}
 
 	if err := client.ContainerGroupsCreateOrUpdateThenPoll(ctx, id, containerGroup); err != nil {
@@ -962,15 +967,20 @@ func resourceContainerGroupDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		props := model.Properties
 		if subnetIDs := props.SubnetIds; subnetIDs != nil && len(*subnetIDs) != 0 {
 			// Avoid parallel deletion if "subnet_ids" are given.
+			subnetsToLock := make([]networkParse.SubnetId, 0)
 			for _, item := range *subnetIDs {
 				subnet, err := networkParse.SubnetID(item.Id)
 				if err != nil {
 					return fmt.Errorf(`parsing subnet id %q: %v`, item.Id, err)
 				}
+				subnetsToLock = append(subnetsToLock, *subnet)
+			}
 
-				locks.ByID(subnet.ID())
-				defer locks.UnlockByID(subnet.ID())
+			networkLocks := locking.New(meta)
+			if err := networkLocks.LockSubnets(ctx, subnetsToLock...); err != nil {
+				return err
 			}
+			defer networkLocks.UnlockSubnets(subnetsToLock...)
 		}
 	}
 
@@ -214,12 +214,12 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 						Required:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
-					"context_access_token": {
+					"context_access_token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-					},
+					}),
 					"image_names": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -249,14 +249,14 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 							Type: pluginsdk.TypeString,
 						},
 					},
-					"secret_arguments": {
+					"secret_arguments": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:      pluginsdk.TypeMap,
 						Optional:  true,
 						Sensitive: true,
 						Elem: &pluginsdk.Schema{
 							Type: pluginsdk.TypeString,
 						},
-					},
+					}),
 				},
 			},
 			ConflictsWith: []string{"file_step", "encoded_step"},
@@ -282,12 +282,12 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 						Optional:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
-					"context_access_token": {
+					"context_access_token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-					},
+					}),
 					"values": {
 						Type:     pluginsdk.TypeMap,
 						Optional: true,
@@ -295,14 +295,14 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 							Type: pluginsdk.TypeString,
 						},
 					},
-					"secret_values": {
+					"secret_values": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:      pluginsdk.TypeMap,
 						Optional:  true,
 						Sensitive: true,
 						Elem: &pluginsdk.Schema{
 							Type: pluginsdk.TypeString,
 						},
-					},
+					}),
 				},
 			},
 			ConflictsWith: []string{"docker_step", "encoded_step"},
@@ -330,12 +330,12 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 						Optional:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
-					"context_access_token": {
+					"context_access_token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-					},
+					}),
 					"values": {
 						Type:     pluginsdk.TypeMap,
 						Optional: true,
@@ -343,14 +343,14 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 							Type: pluginsdk.TypeString,
 						},
 					},
-					"secret_values": {
+					"secret_values": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:      pluginsdk.TypeMap,
 						Optional:  true,
 						Sensitive: true,
 						Elem: &pluginsdk.Schema{
 							Type: pluginsdk.TypeString,
 						},
-					},
+					}),
 				},
 			},
 			ConflictsWith: []string{"docker_step", "file_step"},
@@ -379,12 +379,12 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 						Optional: true,
 						Default:  true,
 					},
-					"update_trigger_endpoint": {
+					"update_trigger_endpoint": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-					},
+					}),
 					"update_trigger_payload_type": {
 						Type:     pluginsdk.TypeString,
 						Optional: true,
@@ -448,18 +448,18 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 										string(tasks.TokenTypeOAuth),
 									}, false),
 								},
-								"token": {
+								"token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 									Type:         pluginsdk.TypeString,
 									Required:     true,
 									Sensitive:    true,
 									ValidateFunc: validation.StringIsNotEmpty,
-								},
-								"refresh_token": {
+								}),
+								"refresh_token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 									Type:         pluginsdk.TypeString,
 									Optional:     true,
 									Sensitive:    true,
 									ValidateFunc: validation.StringIsNotEmpty,
-								},
+								}),
 								"scope": {
 									Type:         pluginsdk.TypeString,
 									Optional:     true,
@@ -545,12 +545,12 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 									Optional:     true,
 									ValidateFunc: validation.StringIsNotEmpty,
 								},
-								"password": {
+								"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 									Type:         pluginsdk.TypeString,
 									Optional:     true,
 									Sensitive:    true,
 									ValidateFunc: validation.StringIsNotEmpty,
-								},
+								}),
 								"identity": {
 									// TODO - 4.0: this should be `user_assigned_identity_id`?
 									Type:         pluginsdk.TypeString,
@@ -300,7 +300,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 							},
 						},
 
-						"server_app_secret": {
+						"server_app_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
@@ -310,7 +310,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 								"azure_active_directory_role_based_access_control.0.server_app_secret", "azure_active_directory_role_based_access_control.0.tenant_id",
 								"azure_active_directory_role_based_access_control.0.managed", "azure_active_directory_role_based_access_control.0.admin_group_object_ids",
 							},
-						},
+						}),
 
 						"tenant_id": {
 							Type:     pluginsdk.TypeString,
@@ -1123,12 +1123,12 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 							ValidateFunc: containerValidate.ClientID,
 						},
 
-						"client_secret": {
+						"client_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 					},
 				},
 			},
@@ -1197,12 +1197,12 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 							Required: true,
 							ForceNew: true,
 						},
-						"admin_password": {
+						"admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringLenBetween(8, 123),
-						},
+						}),
 						"license": {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
@@ -0,0 +1,37 @@
+package containers
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestRedactionRules(t *testing.T) {
+	testCases := []common.RedactionTestCase{
+		{
+			Name:       "registry credentials",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerRegistry/registries/example/listCredentials",
+			Body:       `{"username":"example","passwords":[{"name":"password","value":"cmVnaXN0cnk="}]}`,
+			Redacted:   []string{`cmVnaXN0cnk=`},
+			Unredacted: []string{`"username":"example"`},
+		},
+		{
+			Name:       "kube config",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerService/managedClusters/example/listClusterAdminCredential",
+			Body:       `{"kubeconfigs":[{"name":"clusterAdmin","value":"YXBpVmVyc2lvbjogdjE="}]}`,
+			Redacted:   []string{`YXBpVmVyc2lvbjogdjE=`},
+			Unredacted: []string{`"name":"clusterAdmin"`},
+		},
+		{
+			Name:       "service principal",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ContainerService/managedClusters/example",
+			Body:       `{"properties":{"servicePrincipalProfile":{"clientId":"11111111-1111-1111-1111-111111111111","secret":"c3BuLXNlY3JldA=="}}}`,
+			Redacted:   []string{`c3BuLXNlY3JldA==`},
+			Unredacted: []string{`11111111-1111-1111-1111-111111111111`},
+		},
+	}
+
+	if err := common.VerifyRedactionRules(Registration{}.RedactionRules(), testCases); err != nil {
+		t.Fatal(err)
+	}
+}
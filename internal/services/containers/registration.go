@@ -1,6 +1,9 @@
 package containers
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -19,6 +22,46 @@ func (r Registration) Name() string {
 	return "Container Services"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ContainerInstance",
+		"Microsoft.ContainerRegistry",
+		"Microsoft.ContainerService",
+	}
+}
+
+// RedactionRules returns the rules used to redact sensitive values from the requests and responses for this Service
+func (r Registration) RedactionRules() common.RedactionRules {
+	return common.RedactionRules{
+		{
+			// the Admin Credentials for a Container Registry, or the Credentials for a Container Registry Token
+			URLPattern: regexp.MustCompile(`(?i)/providers/Microsoft.ContainerRegistry/registries/[^/]+/(listCredentials|regenerateCredential|generateCredentials)$`),
+			JSONPaths:  []string{"passwords.value"},
+		},
+		{
+			// the Kube Configs for a Kubernetes Cluster
+			URLPattern: regexp.MustCompile(`(?i)/providers/Microsoft.ContainerService/managedClusters/[^/]+/listCluster(Admin|User|MonitoringUser)Credential$`),
+			JSONPaths:  []string{"kubeconfigs.value"},
+		},
+		{
+			// the Service Principal used by a Kubernetes Cluster
+			URLPattern: regexp.MustCompile(`(?i)/providers/Microsoft.ContainerService/managedClusters/[^/]+(/resetServicePrincipalProfile)?$`),
+			JSONPaths:  []string{"properties.servicePrincipalProfile.secret", "secret"},
+		},
+	}
+}
+
+// PreviewFeatures returns the Preview Features (in the format `Namespace/FeatureName`) which must be
+// registered to use the specified Resources within this Service
+func (r Registration) PreviewFeatures() map[string][]string {
+	return map[string][]string{
+		"azurerm_kubernetes_fleet_manager": {
+			"Microsoft.ContainerService/FleetResourcePreview",
+		},
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	categories := []string{
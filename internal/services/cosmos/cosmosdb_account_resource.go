@@ -1283,7 +1283,7 @@ func resourceCosmosDbAccountDelete(d *pluginsdk.ResourceData, meta interface{})
 		Pending:    []string{"Deleting"},
 		Target:     []string{"NotFound"},
 		MinTimeout: 30 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		Refresh: func() (interface{}, string, error) {
 			resp, err2 := client.Get(ctx, id.ResourceGroup, id.Name)
 			if err2 != nil {
@@ -1360,9 +1360,9 @@ func resourceCosmosDbAccountApiUpsert(client *documentdb.DatabaseAccountsClient,
 	}
 
 	if d.IsNewResource() {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	_, err = stateConf.WaitForStateContext(ctx)
@@ -63,13 +63,13 @@ func resourceCassandraCluster() *pluginsdk.Resource {
 				ValidateFunc: networkValidate.SubnetID,
 			},
 
-			"default_admin_password": {
+			"default_admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"authentication_method": {
 				Type:     pluginsdk.TypeString,
@@ -324,7 +324,7 @@ func resourceCassandraClusterUpdate(d *pluginsdk.ResourceData, meta interface{})
 		Target:     []string{string(managedcassandras.ManagedCassandraProvisioningStateSucceeded)},
 		Refresh:    cosmosdbCassandraClusterStateRefreshFunc(ctx, client, id),
 		MinTimeout: 15 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -262,7 +262,7 @@ func resourceCassandraDatacenterUpdate(d *pluginsdk.ResourceData, meta interface
 		Target:     []string{string(documentdb.ManagedCassandraProvisioningStateSucceeded)},
 		Refresh:    cassandraDatacenterStateRefreshFunc(ctx, client, *id),
 		MinTimeout: 15 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -0,0 +1,23 @@
+package cosmos
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestRedactionRules(t *testing.T) {
+	testCases := []common.RedactionTestCase{
+		{
+			Name:       "list keys",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.DocumentDB/databaseAccounts/example/listKeys",
+			Body:       `{"primaryMasterKey":"cHJpbWFyeQ==","secondaryMasterKey":"c2Vjb25kYXJ5","primaryReadonlyMasterKey":"cmVhZG9ubHk=","secondaryReadonlyMasterKey":"cmVhZG9ubHky"}`,
+			Redacted:   []string{`cHJpbWFyeQ==`, `c2Vjb25kYXJ5`, `cmVhZG9ubHk=`, `cmVhZG9ubHky`},
+			Unredacted: nil,
+		},
+	}
+
+	if err := common.VerifyRedactionRules(Registration{}.RedactionRules(), testCases); err != nil {
+		t.Fatal(err)
+	}
+}
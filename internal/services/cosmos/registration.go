@@ -1,6 +1,9 @@
 package cosmos
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -31,6 +34,24 @@ func (r Registration) Name() string {
 	return "CosmosDB"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.DocumentDB",
+	}
+}
+
+// RedactionRules returns the rules used to redact sensitive values from the requests and responses for this Service
+func (r Registration) RedactionRules() common.RedactionRules {
+	return common.RedactionRules{
+		{
+			// the Keys for a CosmosDB Account
+			URLPattern: regexp.MustCompile(`(?i)/providers/Microsoft.DocumentDB/databaseAccounts/[^/]+/(listKeys|readonlykeys)$`),
+			JSONPaths:  []string{"primaryMasterKey", "primaryReadonlyMasterKey", "secondaryMasterKey", "secondaryReadonlyMasterKey"},
+		},
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -61,19 +61,19 @@ func resourceDatadogMonitor() *pluginsdk.Resource {
 							Computed: true,
 						},
 
-						"api_key": {
+						"api_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Required:  true,
 							ForceNew:  true,
 							Sensitive: true,
-						},
+						}),
 
-						"application_key": {
+						"application_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Required:  true,
 							ForceNew:  true,
 							Sensitive: true,
-						},
+						}),
 
 						"enterprise_app_id": {
 							Type:     pluginsdk.TypeString,
@@ -81,19 +81,19 @@ func resourceDatadogMonitor() *pluginsdk.Resource {
 							ForceNew: true,
 						},
 
-						"linking_auth_code": {
+						"linking_auth_code": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Optional:  true,
 							ForceNew:  true,
 							Sensitive: true,
-						},
+						}),
 
-						"linking_client_id": {
+						"linking_client_id": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Optional:  true,
 							ForceNew:  true,
 							Sensitive: true,
-						},
+						}),
 
 						"redirect_uri": {
 							Type:     pluginsdk.TypeString,
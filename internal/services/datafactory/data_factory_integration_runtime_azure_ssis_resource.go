@@ -187,12 +187,12 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
-						"sas_token": {
+						"sas_token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 					},
 				},
 			},
@@ -213,12 +213,12 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
 							Optional:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
-						"administrator_password": {
+						"administrator_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 						"pricing_tier": {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
@@ -288,12 +288,12 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
 
-									"password": {
+									"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
 										Optional:     true,
 										Sensitive:    true,
 										ValidateFunc: validation.StringIsNotEmpty,
-									},
+									}),
 
 									"key_vault_password": {
 										Type:     pluginsdk.TypeList,
@@ -345,12 +345,12 @@ func resourceDataFactoryIntegrationRuntimeAzureSsis() *pluginsdk.Resource {
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
 
-									"license": {
+									"license": pluginsdk.WriteOnly(&pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
 										Optional:     true,
 										Sensitive:    true,
 										ValidateFunc: validation.StringIsNotEmpty,
-									},
+									}),
 
 									"key_vault_license": {
 										Type:     pluginsdk.TypeList,
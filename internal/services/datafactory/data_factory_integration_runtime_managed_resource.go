@@ -156,12 +156,12 @@ func resourceDataFactoryIntegrationRuntimeManaged() *pluginsdk.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
-						"sas_token": {
+						"sas_token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 					},
 				},
 			},
@@ -182,12 +182,12 @@ func resourceDataFactoryIntegrationRuntimeManaged() *pluginsdk.Resource {
 							Optional:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
-						"administrator_password": {
+						"administrator_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 						"pricing_tier": {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
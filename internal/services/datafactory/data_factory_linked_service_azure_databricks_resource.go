@@ -61,13 +61,13 @@ func resourceDataFactoryLinkedServiceAzureDatabricks() *pluginsdk.Resource {
 				ExactlyOneOf: []string{"access_token", "msi_work_space_resource_id", "key_vault_password"},
 			},
 
-			"access_token": {
+			"access_token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 				ExactlyOneOf: []string{"access_token", "msi_work_space_resource_id", "key_vault_password"},
-			},
+			}),
 
 			"key_vault_password": {
 				Type:     pluginsdk.TypeList,
@@ -49,12 +49,12 @@ func resourceDataFactoryLinkedServiceAzureFileStorage() *pluginsdk.Resource {
 				ValidateFunc: validate.DataFactoryID,
 			},
 
-			"connection_string": {
+			"connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"file_share": {
 				Type:         pluginsdk.TypeString,
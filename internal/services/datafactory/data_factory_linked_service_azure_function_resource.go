@@ -56,13 +56,13 @@ func resourceDataFactoryLinkedServiceAzureFunction() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"key": {
+			"key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ExactlyOneOf: []string{"key", "key_vault_key"},
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"description": {
 				Type:         pluginsdk.TypeString,
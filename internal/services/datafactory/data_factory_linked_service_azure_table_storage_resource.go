@@ -49,12 +49,12 @@ func resourceDataFactoryLinkedServiceAzureTableStorage() *pluginsdk.Resource {
 				ValidateFunc: validate.DataFactoryID,
 			},
 
-			"connection_string": {
+			"connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"description": {
 				Type:         pluginsdk.TypeString,
@@ -49,13 +49,13 @@ func resourceDataFactoryLinkedServiceCosmosDbMongoAPI() *pluginsdk.Resource {
 				ValidateFunc: validate.DataFactoryID,
 			},
 
-			"connection_string": {
+			"connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				Sensitive:        true,
 				DiffSuppressFunc: azureRmDataFactoryLinkedServiceConnectionStringDiff,
 				ValidateFunc:     validation.StringIsNotEmpty,
-			},
+			}),
 
 			"database": {
 				Type:         pluginsdk.TypeString,
@@ -49,14 +49,14 @@ func resourceDataFactoryLinkedServiceCosmosDb() *pluginsdk.Resource {
 				ValidateFunc: validate.DataFactoryID,
 			},
 
-			"connection_string": {
+			"connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
 				Sensitive:        true,
 				ConflictsWith:    []string{"account_endpoint", "account_key"},
 				DiffSuppressFunc: azureRmDataFactoryLinkedServiceConnectionStringDiff,
 				ValidateFunc:     validation.StringIsNotEmpty,
-			},
+			}),
 
 			"account_endpoint": {
 				Type:          pluginsdk.TypeString,
@@ -65,13 +65,13 @@ func resourceDataFactoryLinkedServiceCosmosDb() *pluginsdk.Resource {
 				ValidateFunc:  validation.StringIsNotEmpty,
 			},
 
-			"account_key": {
+			"account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
 				Sensitive:     true,
 				ConflictsWith: []string{"connection_string"},
 				ValidateFunc:  validation.StringIsNotEmpty,
-			},
+			}),
 
 			"database": {
 				Type:         pluginsdk.TypeString,
@@ -76,13 +76,13 @@ func resourceDataFactoryLinkedServiceKusto() *pluginsdk.Resource {
 				ExactlyOneOf: []string{"service_principal_id", "use_managed_identity"},
 			},
 
-			"service_principal_key": {
+			"service_principal_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 				RequiredWith: []string{"service_principal_id"},
-			},
+			}),
 
 			"tenant": {
 				Type:         pluginsdk.TypeString,
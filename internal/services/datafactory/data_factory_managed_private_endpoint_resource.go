@@ -154,7 +154,7 @@ func resourceDataFactoryManagedPrivateEndpointCreate(d *pluginsdk.ResourceData,
 		Target:     []string{"Succeeded"},
 		Refresh:    getManagedPrivateEndpointProvisionStatus(ctx, client, id),
 		MinTimeout: 1 * time.Minute,
-		Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for %s to be created: %+v", id.ID(), err)
@@ -112,13 +112,13 @@ func resourceArmDevTestLinuxVirtualMachine() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
-			"password": {
+			"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				// since this isn't returned from the API
 				ForceNew:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"ssh_key": {
 				Type:     pluginsdk.TypeString,
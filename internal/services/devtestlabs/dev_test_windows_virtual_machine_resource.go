@@ -77,13 +77,13 @@ func resourceArmDevTestWindowsVirtualMachine() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
-			"password": {
+			"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:     pluginsdk.TypeString,
 				Required: true,
 				// since this isn't returned from the API
 				ForceNew:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"storage_type": {
 				Type:     pluginsdk.TypeString,
@@ -57,26 +57,26 @@ func resourceDigitalTwinsEndpointEventHub() *pluginsdk.Resource {
 				ValidateFunc: digitaltwinsinstance.ValidateDigitalTwinsInstanceID,
 			},
 
-			"eventhub_primary_connection_string": {
+			"eventhub_primary_connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
-			"eventhub_secondary_connection_string": {
+			"eventhub_secondary_connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
-			"dead_letter_storage_secret": {
+			"dead_letter_storage_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 		},
 	}
 }
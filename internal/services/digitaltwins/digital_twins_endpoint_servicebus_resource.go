@@ -57,26 +57,26 @@ func resourceDigitalTwinsEndpointServiceBus() *pluginsdk.Resource {
 				ValidateFunc: digitaltwinsinstance.ValidateDigitalTwinsInstanceID,
 			},
 
-			"servicebus_primary_connection_string": {
+			"servicebus_primary_connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
-			"servicebus_secondary_connection_string": {
+			"servicebus_secondary_connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
-			"dead_letter_storage_secret": {
+			"dead_letter_storage_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 		},
 	}
 }
@@ -16,6 +16,14 @@ func (r Registration) Name() string {
 	return "Disks"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Compute",
+		"Microsoft.StoragePool",
+	}
+}
+
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{}
 }
@@ -2,12 +2,11 @@ package migration
 
 import (
 	"bytes"
-	"context"
 	"fmt"
-	"log"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -80,17 +79,7 @@ func (CAARecordV0ToV1) Schema() map[string]*pluginsdk.Schema {
 }
 
 func (CAARecordV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
-	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
-		oldId := rawState["id"].(string)
-		parsedId, err := recordsets.ParseRecordTypeIDInsensitively(oldId)
-		if err != nil {
-			return nil, err
-		}
-		newId := parsedId.ID()
-		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId)
-		rawState["id"] = newId
-		return rawState, nil
-	}
+	return sdk.ResourceIdStateUpgradeFunc(&recordsets.RecordTypeId{}, nil)
 }
 
 func resourceDnsCaaRecordHash(v interface{}) int {
@@ -173,18 +173,18 @@ func resourceActiveDirectoryDomainService() *pluginsdk.Resource {
 							Default:  false,
 						},
 
-						"pfx_certificate": {
+						"pfx_certificate": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							Sensitive:    true,
 							ValidateFunc: azValidate.Base64EncodedString,
-						},
+						}),
 
-						"pfx_certificate_password": {
+						"pfx_certificate_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Required:  true,
 							Sensitive: true,
-						},
+						}),
 
 						"certificate_expiry": {
 							Type:     pluginsdk.TypeString,
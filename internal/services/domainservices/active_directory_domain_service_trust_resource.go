@@ -57,12 +57,12 @@ func (r DomainServiceTrustResource) Arguments() map[string]*pluginsdk.Schema {
 				ValidateFunc: validation.IsIPAddress,
 			},
 		},
-		"password": {
+		"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 	}
 }
 
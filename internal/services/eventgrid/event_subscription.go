@@ -112,11 +112,11 @@ func eventSubscriptionSchemaDeliveryProperty() *pluginsdk.Schema {
 					}, false),
 				},
 
-				"value": {
+				"value": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:      pluginsdk.TypeString,
 					Optional:  true,
 					Sensitive: true,
-				},
+				}),
 
 				"source_field": {
 					Type:     pluginsdk.TypeString,
@@ -622,7 +622,7 @@ func resourceEventHubNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	// need to wait for namespace status to be ready before deleting.
-	if err := waitForEventHubNamespaceStatusToBeReady(ctx, meta, *id, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+	if err := waitForEventHubNamespaceStatusToBeReady(ctx, meta, *id, timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("waiting for eventHub namespace %s state to be ready error: %+v", *id, err)
 	}
 
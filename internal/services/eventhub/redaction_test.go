@@ -0,0 +1,30 @@
+package eventhub
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestRedactionRules(t *testing.T) {
+	testCases := []common.RedactionTestCase{
+		{
+			Name:       "list keys",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.EventHub/namespaces/example/eventhubs/example/authorizationRules/example/listKeys",
+			Body:       `{"aliasPrimaryConnectionString":"Endpoint=sb://alias/;SharedAccessKey=YWxpYXM=","aliasSecondaryConnectionString":"Endpoint=sb://alias/;SharedAccessKey=c2Vjb25kYXJ5","keyName":"example"}`,
+			Redacted:   []string{`YWxpYXM=`, `c2Vjb25kYXJ5`},
+			Unredacted: []string{`"keyName":"example"`},
+		},
+		{
+			Name:       "regenerate keys",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.EventHub/namespaces/example/authorizationRules/example/regenerateKeys",
+			Body:       `{"keyType":"SecondaryKey","key":"bmV3LWtleQ=="}`,
+			Redacted:   []string{`bmV3LWtleQ==`},
+			Unredacted: []string{`"keyType":"SecondaryKey"`},
+		},
+	}
+
+	if err := common.VerifyRedactionRules(Registration{}.RedactionRules(), testCases); err != nil {
+		t.Fatal(err)
+	}
+}
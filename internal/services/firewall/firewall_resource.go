@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/azuresdkhacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -248,7 +249,7 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 	i := d.Get("ip_configuration").([]interface{})
-	ipConfigs, subnetToLock, vnetToLock, err := expandFirewallIPConfigurations(i)
+	ipConfigs, subnetsToLock, err := expandFirewallIPConfigurations(i)
 	if err != nil {
 		return fmt.Errorf("building list of Azure Firewall IP Configurations: %+v", err)
 	}
@@ -270,18 +271,12 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 
 	m := d.Get("management_ip_configuration").([]interface{})
 	if len(m) == 1 {
-		mgmtIPConfig, mgmtSubnetName, mgmtVirtualNetworkName, err := expandFirewallIPConfigurations(m)
+		mgmtIPConfig, mgmtSubnetsToLock, err := expandFirewallIPConfigurations(m)
 		if err != nil {
 			return fmt.Errorf("parsing Azure Firewall Management IP Configurations: %+v", err)
 		}
 
-		if !utils.SliceContainsValue(*subnetToLock, (*mgmtSubnetName)[0]) {
-			*subnetToLock = append(*subnetToLock, (*mgmtSubnetName)[0])
-		}
-
-		if !utils.SliceContainsValue(*vnetToLock, (*mgmtVirtualNetworkName)[0]) {
-			*vnetToLock = append(*vnetToLock, (*mgmtVirtualNetworkName)[0])
-		}
+		subnetsToLock = append(subnetsToLock, mgmtSubnetsToLock...)
 		if *mgmtIPConfig != nil {
 			if parameters.IPConfigurations != nil {
 				for k, v := range *parameters.IPConfigurations {
@@ -344,11 +339,11 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	locks.ByName(id.AzureFirewallName, AzureFirewallResourceName)
 	defer locks.UnlockByName(id.AzureFirewallName, AzureFirewallResourceName)
 
-	locks.MultipleByName(vnetToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetToLock, SubnetResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockSubnetsAndVirtualNetworks(ctx, subnetsToLock...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnetsAndVirtualNetworks(subnetsToLock...)
 
 	if !d.IsNewResource() {
 		exists, err2 := client.Get(ctx, id.ResourceGroup, id.AzureFirewallName)
@@ -469,8 +464,7 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving Firewall %s : %+v", *id, err)
 	}
 
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	subnetsToLock := make([]networkParse.SubnetId, 0)
 	if props := read.AzureFirewallPropertiesFormat; props != nil {
 		if configs := props.IPConfigurations; configs != nil {
 			for _, config := range *configs {
@@ -483,13 +477,7 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 					return err2
 				}
 
-				if !utils.SliceContainsValue(subnetNamesToLock, parsedSubnetID.Name) {
-					subnetNamesToLock = append(subnetNamesToLock, parsedSubnetID.Name)
-				}
-
-				if !utils.SliceContainsValue(virtualNetworkNamesToLock, parsedSubnetID.VirtualNetworkName) {
-					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, parsedSubnetID.VirtualNetworkName)
-				}
+				subnetsToLock = append(subnetsToLock, *parsedSubnetID)
 			}
 		}
 
@@ -500,13 +488,7 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 					return err2
 				}
 
-				if !utils.SliceContainsValue(subnetNamesToLock, parsedSubnetID.Name) {
-					subnetNamesToLock = append(subnetNamesToLock, parsedSubnetID.Name)
-				}
-
-				if !utils.SliceContainsValue(virtualNetworkNamesToLock, parsedSubnetID.VirtualNetworkName) {
-					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, parsedSubnetID.VirtualNetworkName)
-				}
+				subnetsToLock = append(subnetsToLock, *parsedSubnetID)
 			}
 		}
 	}
@@ -520,11 +502,11 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	locks.ByName(id.AzureFirewallName, AzureFirewallResourceName)
 	defer locks.UnlockByName(id.AzureFirewallName, AzureFirewallResourceName)
 
-	locks.MultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(&subnetNamesToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(&subnetNamesToLock, SubnetResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockSubnetsAndVirtualNetworks(ctx, subnetsToLock...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnetsAndVirtualNetworks(subnetsToLock...)
 
 	// Change this back to using the SDK method once https://github.com/Azure/azure-sdk-for-go/issues/17013 is addressed.
 	future, err := azuresdkhacks.DeleteFirewall(ctx, client, id.ResourceGroup, id.AzureFirewallName)
@@ -539,10 +521,9 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return err
 }
 
-func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFirewallIPConfiguration, *[]string, *[]string, error) {
+func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFirewallIPConfiguration, []networkParse.SubnetId, error) {
 	ipConfigs := make([]network.AzureFirewallIPConfiguration, 0)
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
+	subnetsToLock := make([]networkParse.SubnetId, 0)
 
 	for _, configRaw := range configs {
 		data := configRaw.(map[string]interface{})
@@ -562,16 +543,10 @@ func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFire
 		if subnetId != "" {
 			subnetID, err := networkParse.SubnetID(subnetId)
 			if err != nil {
-				return nil, nil, nil, err
-			}
-
-			if !utils.SliceContainsValue(subnetNamesToLock, subnetID.Name) {
-				subnetNamesToLock = append(subnetNamesToLock, subnetID.Name)
+				return nil, nil, err
 			}
 
-			if !utils.SliceContainsValue(virtualNetworkNamesToLock, subnetID.VirtualNetworkName) {
-				virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, subnetID.VirtualNetworkName)
-			}
+			subnetsToLock = append(subnetsToLock, *subnetID)
 
 			ipConfig.AzureFirewallIPConfigurationPropertiesFormat.Subnet = &network.SubResource{
 				ID: utils.String(subnetId),
@@ -579,7 +554,7 @@ func expandFirewallIPConfigurations(configs []interface{}) (*[]network.AzureFire
 		}
 		ipConfigs = append(ipConfigs, ipConfig)
 	}
-	return &ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
+	return &ipConfigs, subnetsToLock, nil
 }
 
 func flattenFirewallIPConfigurations(input *[]network.AzureFirewallIPConfiguration) []interface{} {
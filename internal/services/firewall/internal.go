@@ -1,8 +0,0 @@
-package firewall
-
-// TODO: determine what to do with these locking handlers, could we replace it with the ID?
-
-var (
-	SubnetResourceName         = "azurerm_subnet"
-	VirtualNetworkResourceName = "azurerm_virtual_network"
-)
@@ -115,7 +115,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 					Target:     []string{"Running"},
 					Refresh:    hdInsightWaitForReadyRefreshFunc(ctx, client, resourceGroup, name),
 					MinTimeout: 15 * time.Second,
-					Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
+					Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 				}
 
 				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
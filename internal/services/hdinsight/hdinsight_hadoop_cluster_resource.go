@@ -342,7 +342,7 @@ func resourceHDInsightHadoopClusterCreate(d *pluginsdk.ResourceData, meta interf
 			Target:     []string{"Running"},
 			Refresh:    hdInsightWaitForReadyRefreshFunc(ctx, client, resourceGroup, name),
 			MinTimeout: 15 * time.Second,
-			Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+			Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 		}
 
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -103,7 +103,7 @@ func SchemaHDInsightsGateway() *pluginsdk.Schema {
 					Required: true,
 					ForceNew: true,
 				},
-				"password": {
+				"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:      pluginsdk.TypeString,
 					Required:  true,
 					Sensitive: true,
@@ -111,7 +111,7 @@ func SchemaHDInsightsGateway() *pluginsdk.Schema {
 					DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 						return (new == d.Get(k).(string)) && (old == "*****")
 					},
-				},
+				}),
 			},
 		},
 	}
@@ -162,7 +162,7 @@ func SchemaHDInsightsExternalMetastore() *pluginsdk.Schema {
 					Required: true,
 					ForceNew: true,
 				},
-				"password": {
+				"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:      pluginsdk.TypeString,
 					Required:  true,
 					ForceNew:  true,
@@ -171,7 +171,7 @@ func SchemaHDInsightsExternalMetastore() *pluginsdk.Schema {
 					DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
 						return (new == d.Get(k).(string)) && (old == "*****")
 					},
-				},
+				}),
 			},
 		},
 	}
@@ -305,13 +305,13 @@ func SchemaHDInsightsSecurityProfile() *pluginsdk.Schema {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 
-				"domain_user_password": {
+				"domain_user_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					Required:     true,
 					ForceNew:     true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringIsNotEmpty,
-				},
+				}),
 
 				"ldaps_urls": {
 					Type:     pluginsdk.TypeSet,
@@ -1036,12 +1036,12 @@ func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNo
 			Required: true,
 			ForceNew: true,
 		},
-		"password": {
+		"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:      pluginsdk.TypeString,
 			Optional:  true,
 			ForceNew:  true,
 			Sensitive: true,
-		},
+		}),
 		"ssh_keys": {
 			Type:     pluginsdk.TypeSet,
 			Optional: true,
@@ -283,7 +283,7 @@ func resourceHealthcareApisDicomServiceDelete(d *pluginsdk.ResourceData, meta in
 		Pending:                   []string{"Pending"},
 		Target:                    []string{"Deleted"},
 		Refresh:                   dicomServiceStateStatusCodeRefreshFunc(ctx, client, *id),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		ContinuousTargetOccurence: 3,
 		PollInterval:              10 * time.Second,
 	}
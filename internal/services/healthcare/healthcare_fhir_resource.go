@@ -304,7 +304,7 @@ func resourceHealthcareApisFhirServiceCreate(d *pluginsdk.ResourceData, meta int
 		Pending:                   []string{"Creating", "Updating", "Verifying"},
 		Target:                    []string{"Succeeded"},
 		Refresh:                   fhirServiceCreateStateRefreshFunc(ctx, client, id),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutUpdate),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 	}
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for Fhir Service %s to settle down: %+v", id, err)
@@ -475,7 +475,7 @@ func resourceHealthcareApisFhirServiceDelete(d *pluginsdk.ResourceData, meta int
 		Pending:                   []string{"Pending"},
 		Target:                    []string{"Deleted"},
 		Refresh:                   fhirServiceStateStatusCodeRefreshFunc(ctx, client, *id),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		ContinuousTargetOccurence: 3,
 		PollInterval:              10 * time.Second,
 	}
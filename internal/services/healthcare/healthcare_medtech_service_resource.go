@@ -162,7 +162,7 @@ func resourceHealthcareApisMedTechServiceCreate(d *pluginsdk.ResourceData, meta
 		Pending:                   []string{"Creating", "Updating"},
 		Target:                    []string{"Succeeded"},
 		Refresh:                   medTechServiceCreateStateRefreshFunc(ctx, client, id),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutUpdate),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 	}
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for MedTech Service %s to settle down: %+v", id, err)
@@ -301,7 +301,7 @@ func resourceHealthcareApisMedTechServiceUpdate(d *pluginsdk.ResourceData, meta
 		Pending:                   []string{"Creating", "Updating"},
 		Target:                    []string{"Succeeded"},
 		Refresh:                   medTechServiceCreateStateRefreshFunc(ctx, client, id),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutUpdate),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 	}
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for MedTech Service %s to settle down: %+v", id, err)
@@ -332,7 +332,7 @@ func resourceHealthcareApisMedTechServiceDelete(d *pluginsdk.ResourceData, meta
 		Pending:                   []string{"Pending"},
 		Target:                    []string{"Deleted"},
 		Refresh:                   medTechServiceStateStatusCodeRefreshFunc(ctx, client, *id),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		ContinuousTargetOccurence: 3,
 		PollInterval:              10 * time.Second,
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-01-01/caches"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-01-01/storagetargets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 func CacheGetAccessPolicyByName(policies []caches.NfsAccessPolicy, name string) *caches.NfsAccessPolicy {
@@ -57,7 +58,7 @@ func resourceHPCCacheWaitForCreating(ctx context.Context, client *caches.CachesC
 		Pending:    []string{string(storagetargets.ProvisioningStateTypeCreating)},
 		Target:     []string{string(storagetargets.ProvisioningStateTypeSucceeded)},
 		Refresh:    resourceHPCCacheRefresh(ctx, client, id),
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 	}
 
 	resp, err := state.WaitForStateContext(ctx)
@@ -823,12 +823,12 @@ func resourceHPCCacheSchema() map[string]*pluginsdk.Schema {
 						Required:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
-					"password": {
+					"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-					},
+					}),
 					"dns_secondary_ip": {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
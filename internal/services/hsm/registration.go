@@ -18,6 +18,24 @@ func (r Registration) Name() string {
 	return "Hardware Security Module"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.HardwareSecurityModules",
+	}
+}
+
+// PreviewFeatures returns the Preview Features (in the format `Namespace/FeatureName`) which must be
+// registered to use the specified Resources within this Service
+func (r Registration) PreviewFeatures() map[string][]string {
+	return map[string][]string{
+		"azurerm_dedicated_hardware_security_module": {
+			"Microsoft.HardwareSecurityModules/AzureDedicatedHSM",
+			"Microsoft.Network/AllowBaremetalServers",
+		},
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
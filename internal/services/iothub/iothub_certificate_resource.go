@@ -58,12 +58,12 @@ func resourceIotHubCertificate() *pluginsdk.Resource {
 				ValidateFunc: validate.IoTHubName,
 			},
 
-			"certificate_content": {
+			"certificate_content": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 				Sensitive:    true,
-			},
+			}),
 
 			"is_verified": {
 				Type:     pluginsdk.TypeBool,
@@ -65,12 +65,12 @@ func (r IotHubDeviceUpdateInstanceResource) Arguments() map[string]*pluginsdk.Sc
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
-					"connection_string": {
+					"connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-					},
+					}),
 
 					"id": {
 						Type:         pluginsdk.TypeString,
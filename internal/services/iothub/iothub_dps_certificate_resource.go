@@ -52,12 +52,12 @@ func resourceIotHubDPSCertificate() *pluginsdk.Resource {
 				ValidateFunc: validate.IoTHubName,
 			},
 
-			"certificate_content": {
+			"certificate_content": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 				Sensitive:    true,
-			},
+			}),
 
 			"is_verified": {
 				Type:     pluginsdk.TypeBool,
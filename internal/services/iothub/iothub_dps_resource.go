@@ -396,7 +396,7 @@ func waitForIotHubDPSToBeDeleted(ctx context.Context, client *iotdpsresource.Iot
 		Pending: []string{"200"},
 		Target:  []string{"404"},
 		Refresh: iothubdpsStateStatusCodeRefreshFunc(ctx, client, id),
-		Timeout: d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout: timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -69,12 +69,12 @@ func resourceIoTTimeSeriesInsightsEventSourceEventhub() *pluginsdk.Resource {
 				Required: true,
 			},
 
-			"shared_access_key": {
+			"shared_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"consumer_group_name": {
 				Type:         pluginsdk.TypeString,
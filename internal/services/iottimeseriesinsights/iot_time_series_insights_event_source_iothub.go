@@ -64,12 +64,12 @@ func resourceIoTTimeSeriesInsightsEventSourceIoTHub() *pluginsdk.Resource {
 				ValidateFunc: iothubValidate.IoTHubName,
 			},
 
-			"shared_access_key": {
+			"shared_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"consumer_group_name": {
 				Type:         pluginsdk.TypeString,
@@ -87,12 +87,12 @@ func resourceIoTTimeSeriesInsightsGen2Environment() *pluginsdk.Resource {
 							ForceNew:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
-						"key": {
+						"key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 					},
 				},
 			},
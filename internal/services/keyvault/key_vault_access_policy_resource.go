@@ -222,17 +222,17 @@ func resourceKeyVaultAccessPolicyCreateOrDelete(d *pluginsdk.ResourceData, meta
 		Refresh:                   accessPolicyRefreshFunc(ctx, client, vaultId.ResourceGroupName, vaultId.VaultName, objectId, applicationIdRaw),
 		Delay:                     5 * time.Second,
 		ContinuousTargetOccurence: 3,
-		Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 	}
 
 	if action == keyvault.AccessPolicyUpdateKindRemove {
 		stateConf.Target = []string{"notfound"}
 		stateConf.Pending = []string{"found", "vaultnotfound"}
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutDelete)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete)
 	}
 
 	if action == keyvault.AccessPolicyUpdateKindReplace {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -94,11 +94,11 @@ func resourceKeyVaultCertificateIssuer() *pluginsdk.Resource {
 				},
 			},
 
-			"password": {
+			"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 		},
 	}
 }
@@ -497,7 +497,7 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 			Target:     []string{"Ready"},
 			Refresh:    keyVaultCertificateCreationRefreshFunc(ctx, client, *keyVaultBaseUrl, name),
 			MinTimeout: 15 * time.Second,
-			Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+			Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 		}
 		// It has been observed that at least one certificate issuer responds to a request with manual processing by issuer staff. SLA's may differ among issuers.
 		// The total create timeout duration is divided by a modified poll interval of 30s to calculate the number of times to allow not found instead of the default 20.
@@ -544,7 +544,7 @@ func recoverDeletedCertificate(ctx context.Context, d *pluginsdk.ResourceData, m
 			Delay:                     30 * time.Second,
 			PollInterval:              10 * time.Second,
 			ContinuousTargetOccurence: 10,
-			Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+			Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 		}
 
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -143,7 +143,7 @@ func resourceKeyVaultManagedStorageAccountCreateUpdate(d *pluginsdk.ResourceData
 					Delay:                     30 * time.Second,
 					PollInterval:              10 * time.Second,
 					ContinuousTargetOccurence: 10,
-					Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+					Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 				}
 
 				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
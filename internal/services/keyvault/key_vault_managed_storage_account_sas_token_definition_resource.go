@@ -151,7 +151,7 @@ func resourceKeyVaultManagedStorageAccountSasTokenDefinitionCreateUpdate(d *plug
 					Delay:                     30 * time.Second,
 					PollInterval:              10 * time.Second,
 					ContinuousTargetOccurence: 10,
-					Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+					Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 				}
 
 				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -21,7 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -343,19 +343,20 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIds := make([]networkParse.VirtualNetworkId, 0)
 	for _, v := range subnetIds {
 		id, err := networkParse.SubnetIDInsensitively(v)
 		if err != nil {
 			return err
 		}
-		if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-			virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
-		}
+		virtualNetworkIds = append(virtualNetworkIds, locking.VirtualNetworkIdForSubnet(*id))
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, virtualNetworkIds...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(virtualNetworkIds...)
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroupName, id.VaultName, parameters)
 	if err != nil {
@@ -385,7 +386,7 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 				Delay:                     30 * time.Second,
 				PollInterval:              10 * time.Second,
 				ContinuousTargetOccurence: 10,
-				Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+				Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 			}
 
 			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -489,20 +490,21 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
 
 		// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
-		virtualNetworkNames := make([]string, 0)
+		virtualNetworkIds := make([]networkParse.VirtualNetworkId, 0)
 		for _, v := range subnetIds {
 			id, err := networkParse.SubnetIDInsensitively(v)
 			if err != nil {
 				return err
 			}
 
-			if !utils.SliceContainsValue(virtualNetworkNames, id.VirtualNetworkName) {
-				virtualNetworkNames = append(virtualNetworkNames, id.VirtualNetworkName)
-			}
+			virtualNetworkIds = append(virtualNetworkIds, locking.VirtualNetworkIdForSubnet(*id))
 		}
 
-		locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-		defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+		networkLocks := locking.New(meta)
+		if err := networkLocks.LockVirtualNetworks(ctx, virtualNetworkIds...); err != nil {
+			return err
+		}
+		defer networkLocks.UnlockVirtualNetworks(virtualNetworkIds...)
 
 		update.Properties.NetworkAcls = networkAcls
 	}
@@ -751,7 +753,7 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	// ensure we lock on the latest network names, to ensure we handle Azure's networking layer being limited to one change at a time
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIds := make([]networkParse.VirtualNetworkId, 0)
 	if props := read.Properties; props != nil {
 		if acls := props.NetworkAcls; acls != nil {
 			if rules := acls.VirtualNetworkRules; rules != nil {
@@ -765,16 +767,17 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 						return err
 					}
 
-					if !utils.SliceContainsValue(virtualNetworkNames, subnetId.VirtualNetworkName) {
-						virtualNetworkNames = append(virtualNetworkNames, subnetId.VirtualNetworkName)
-					}
+					virtualNetworkIds = append(virtualNetworkIds, locking.VirtualNetworkIdForSubnet(*subnetId))
 				}
 			}
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, virtualNetworkIds...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(virtualNetworkIds...)
 
 	resp, err := client.Delete(ctx, id.ResourceGroupName, id.VaultName)
 	if err != nil {
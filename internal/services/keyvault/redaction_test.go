@@ -0,0 +1,37 @@
+package keyvault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestRedactionRules(t *testing.T) {
+	testCases := []common.RedactionTestCase{
+		{
+			Name:       "secret value",
+			URL:        "https://example.vault.azure.net/secrets/example/00000000000000000000000000000000",
+			Body:       `{"value":"s3cr3t-value","id":"https://example.vault.azure.net/secrets/example/00000000000000000000000000000000"}`,
+			Redacted:   []string{`s3cr3t-value`},
+			Unredacted: []string{`https://example.vault.azure.net/secrets/example`},
+		},
+		{
+			Name:       "certificate import",
+			URL:        "https://example.vault.azure.net/certificates/example/import",
+			Body:       `{"value":"TUlJS2VBSUJB","pwd":"p4ssw0rd","policy":{"key_props":{"exportable":true}}}`,
+			Redacted:   []string{`TUlJS2VBSUJB`, `p4ssw0rd`},
+			Unredacted: []string{`"exportable":true`},
+		},
+		{
+			Name:       "key import",
+			URL:        "https://example.vault.azure.net/keys/example",
+			Body:       `{"key":{"kty":"RSA","n":"public-modulus","d":"private-exponent"}}`,
+			Redacted:   []string{`private-exponent`},
+			Unredacted: []string{`public-modulus`},
+		},
+	}
+
+	if err := common.VerifyRedactionRules(Registration{}.RedactionRules(), testCases); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,6 +1,9 @@
 package keyvault
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -19,6 +22,34 @@ func (r Registration) Name() string {
 	return "KeyVault"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.KeyVault",
+	}
+}
+
+// RedactionRules returns the rules used to redact sensitive values from the requests and responses for this Service
+func (r Registration) RedactionRules() common.RedactionRules {
+	return common.RedactionRules{
+		{
+			// the value of a Secret
+			URLPattern: regexp.MustCompile(`(?i)/secrets/[^/]+(/[^/]+)?$`),
+			JSONPaths:  []string{"value"},
+		},
+		{
+			// the PFX/PEM (and password) for a Certificate being imported
+			URLPattern: regexp.MustCompile(`(?i)/certificates/[^/]+/import$`),
+			JSONPaths:  []string{"value", "pwd"},
+		},
+		{
+			// the private components of a Key being imported or restored
+			URLPattern: regexp.MustCompile(`(?i)/keys/[^/]+(/[^/]+)?$`),
+			JSONPaths:  []string{"key.d", "key.dp", "key.dq", "key.k", "key.p", "key.q", "key.qi", "value"},
+		},
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
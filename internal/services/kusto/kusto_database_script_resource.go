@@ -79,23 +79,23 @@ func resourceKustoDatabaseScript() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"sas_token": {
+			"sas_token": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				RequiredWith: []string{"url"},
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
-			"script_content": {
+			"script_content": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				ExactlyOneOf: []string{"url", "script_content"},
 				Optional:     true,
 				ForceNew:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 		},
 	}
 }
@@ -168,13 +168,13 @@ func (r LabServiceLabResource) Arguments() map[string]*pluginsdk.Schema {
 									ValidateFunc: validate.LabUsername,
 								},
 
-								"password": {
+								"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 									Type:         pluginsdk.TypeString,
 									Required:     true,
 									ForceNew:     true,
 									Sensitive:    true,
 									ValidateFunc: validate.LabPassword,
-								},
+								}),
 							},
 						},
 					},
@@ -294,12 +294,12 @@ func (r LabServiceLabResource) Arguments() map[string]*pluginsdk.Schema {
 									ValidateFunc: validate.LabUsername,
 								},
 
-								"password": {
+								"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 									Type:         pluginsdk.TypeString,
 									Required:     true,
 									Sensitive:    true,
 									ValidateFunc: validate.LabPassword,
-								},
+								}),
 							},
 						},
 					},
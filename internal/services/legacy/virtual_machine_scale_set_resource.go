@@ -262,12 +262,12 @@ func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"admin_password": {
+						"admin_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 
 						"custom_data": {
 							Type:             pluginsdk.TypeString,
@@ -22,6 +22,13 @@ func (r Registration) Name() string {
 	return r.autoRegistration.Name()
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.LoadTestService",
+	}
+}
+
 func (r Registration) DataSources() []sdk.DataSource {
 	return r.autoRegistration.DataSources()
 }
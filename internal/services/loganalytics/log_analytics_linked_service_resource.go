@@ -189,7 +189,7 @@ func resourceLogAnalyticsLinkedServiceDelete(d *pluginsdk.ResourceData, meta int
 
 	// (@WodansSon) - This is a bug in the service API, it returns instantly from the delete call with a 200
 	// so we must wait for the state to change before we return from the delete function
-	deleteWait := logAnalyticsLinkedServiceDeleteWaitForState(ctx, client, d.Timeout(pluginsdk.TimeoutDelete), *id)
+	deleteWait := logAnalyticsLinkedServiceDeleteWaitForState(ctx, client, timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete), *id)
 
 	if _, err := deleteWait.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for %s: %+v", *id, err)
@@ -192,12 +192,12 @@ func resourceLogAnalyticsStorageInsightsSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: storageaccounts.ValidateStorageAccountID,
 		},
 
-		"storage_account_key": {
+		"storage_account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			Sensitive:    true,
 			ValidateFunc: azValidate.Base64EncodedString,
-		},
+		}),
 
 		"blob_container_names": {
 			Type:     pluginsdk.TypeSet,
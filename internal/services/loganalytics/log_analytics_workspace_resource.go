@@ -313,7 +313,7 @@ func resourceLogAnalyticsWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta i
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending:    []string{strconv.FormatBool(!allowResourceOnlyPermission)},
 		Target:     []string{strconv.FormatBool(allowResourceOnlyPermission)},
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 		MinTimeout: 30 * time.Second,
 		Refresh: func() (interface{}, string, error) {
 			resp, err := client.Get(ctx, id)
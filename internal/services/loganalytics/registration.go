@@ -32,6 +32,14 @@ func (r Registration) Name() string {
 	return "Log Analytics"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.OperationalInsights",
+		"Microsoft.OperationsManagement",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
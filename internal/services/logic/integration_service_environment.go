@@ -300,7 +300,7 @@ func resourceIntegrationServiceEnvironmentDelete(d *pluginsdk.ResourceData, meta
 		Target:                    []string{string(integrationserviceenvironments.WorkflowProvisioningStateDeleted)},
 		MinTimeout:                5 * time.Minute,
 		Refresh:                   integrationServiceEnvironmentDeleteStateRefreshFunc(ctx, meta.(*clients.Client), d.Id(), subnetIDs),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		ContinuousTargetOccurence: 1,
 		NotFoundChecks:            1,
 	}
@@ -98,21 +98,21 @@ func (r MachineLearningDataStoreBlobStorage) Arguments() map[string]*pluginsdk.S
 			Default: string(datastore.ServiceDataAccessAuthIdentityNone),
 		},
 
-		"account_key": {
+		"account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
 			ExactlyOneOf: []string{"account_key", "shared_access_signature"},
-		},
+		}),
 
-		"shared_access_signature": {
+		"shared_access_signature": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
 			AtLeastOneOf: []string{"account_key", "shared_access_signature"},
-		},
+		}),
 
 		"tags": commonschema.TagsForceNew(),
 	}
@@ -96,13 +96,13 @@ func (r MachineLearningDataStoreDataLakeGen2) Arguments() map[string]*pluginsdk.
 			RequiredWith: []string{"tenant_id", "client_secret"},
 		},
 
-		"client_secret": {
+		"client_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
 			RequiredWith: []string{"tenant_id", "client_id"},
-		},
+		}),
 
 		"description": {
 			Type:     pluginsdk.TypeString,
@@ -19,6 +19,13 @@ func (r Registration) Name() string {
 	return "Machine Learning"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.MachineLearningServices",
+	}
+}
+
 func (r Registration) WebsiteCategories() []string {
 	return []string{
 		"Machine Learning",
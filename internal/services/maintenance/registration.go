@@ -17,6 +17,13 @@ func (r Registration) Name() string {
 	return "Maintenance"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Maintenance",
+	}
+}
+
 func (r Registration) WebsiteCategories() []string {
 	return []string{
 		"Maintenance",
@@ -21,6 +21,13 @@ func (r Registration) Name() string {
 	return r.autoRegistration.Name()
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ManagedIdentity",
+	}
+}
+
 func (r Registration) DataSources() []sdk.DataSource {
 	dataSources := []sdk.DataSource{}
 	dataSources = append(dataSources, r.autoRegistration.DataSources()...)
@@ -8,6 +8,7 @@ import (
 
 var _ sdk.Resource = UserAssignedIdentityResource{}
 var _ sdk.ResourceWithStateMigration = UserAssignedIdentityResource{}
+var _ sdk.ResourceWithSubscriptionTargeting = UserAssignedIdentityResource{}
 
 func (r UserAssignedIdentityResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
@@ -17,3 +18,9 @@ func (r UserAssignedIdentityResource) StateUpgraders() sdk.StateUpgradeData {
 		},
 	}
 }
+
+// SupportsSubscriptionTargeting allows the User Assigned Identity to be managed within another Subscription, since the
+// Resource ID is built from the Subscription of the Client made available during each operation
+func (r UserAssignedIdentityResource) SupportsSubscriptionTargeting() bool {
+	return true
+}
@@ -0,0 +1,56 @@
+package managedidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+func TestAccUserAssignedIdentity_otherSubscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_user_assigned_identity", "test")
+	if data.Subscriptions.Secondary == "" {
+		t.Skipf("The secondary subscription is not specified")
+	}
+	r := UserAssignedIdentityTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.otherSubscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("id").HasValue(fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestrg-%d/providers/Microsoft.ManagedIdentity/userAssignedIdentities/acctest-%d", data.Subscriptions.Secondary, data.RandomInteger, data.RandomInteger)),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(data.Subscriptions.Secondary),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r UserAssignedIdentityTestResource) otherSubscription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azurerm-alt" {
+  subscription_id = %[1]q
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  provider = azurerm-alt
+
+  name     = "acctestrg-%[2]d"
+  location = %[3]q
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  location            = azurerm_resource_group.test.location
+  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  subscription_id     = %[1]q
+}
+`, data.Subscriptions.Secondary, data.RandomInteger, data.Locations.Primary)
+}
@@ -151,7 +151,7 @@ func resourceManagementGroupCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 			"succeeded",
 		},
 		Refresh:                   managementgroupCreateStateRefreshFunc(ctx, client, groupName),
-		Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 		ContinuousTargetOccurence: 5,
 	}
 
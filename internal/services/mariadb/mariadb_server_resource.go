@@ -64,11 +64,11 @@ func resourceMariaDbServer() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
-			"administrator_login_password": {
+			"administrator_login_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"auto_grow_enabled": {
 				Type:     pluginsdk.TypeBool,
@@ -106,9 +106,9 @@ func resourceMariaDbVirtualNetworkRuleCreateUpdate(d *pluginsdk.ResourceData, me
 		ContinuousTargetOccurence: 5,
 	}
 	if d.IsNewResource() {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -39,6 +39,14 @@ func (r Registration) Name() string {
 	return "Monitor"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"microsoft.insights",
+		"Microsoft.AlertsManagement",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
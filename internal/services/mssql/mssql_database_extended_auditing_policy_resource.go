@@ -55,12 +55,12 @@ func resourceMsSqlDatabaseExtendedAuditingPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.IsURLWithHTTPS,
 			},
 
-			"storage_account_access_key": {
+			"storage_account_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"storage_account_access_key_is_secondary": {
 				Type:     pluginsdk.TypeBool,
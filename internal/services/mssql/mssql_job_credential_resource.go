@@ -53,11 +53,11 @@ func resourceMsSqlJobCredential() *pluginsdk.Resource {
 				Required: true,
 			},
 
-			"password": {
+			"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Required:  true,
 				Sensitive: true,
-			},
+			}),
 		},
 	}
 }
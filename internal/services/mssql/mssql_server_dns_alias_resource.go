@@ -20,7 +20,7 @@ type ServerDNSAliasModel struct {
 
 type ServerDNSAliasResource struct{}
 
-var _ sdk.Resource = (*ServerDNSAliasResource)(nil)
+var _ sdk.ResourceServedByPluginFramework = (*ServerDNSAliasResource)(nil)
 
 func (m ServerDNSAliasResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -53,6 +53,10 @@ func (m ServerDNSAliasResource) ModelObject() interface{} {
 	return &ServerDNSAliasModel{}
 }
 
+func (m ServerDNSAliasResource) ServedByPluginFramework() bool {
+	return true
+}
+
 func (m ServerDNSAliasResource) ResourceType() string {
 	return "azurerm_mssql_server_dns_alias"
 }
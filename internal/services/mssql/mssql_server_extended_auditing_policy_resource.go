@@ -57,12 +57,12 @@ func resourceMsSqlServerExtendedAuditingPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.IsURLWithHTTPS,
 			},
 
-			"storage_account_access_key": {
+			"storage_account_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"storage_account_access_key_is_secondary": {
 				Type:     pluginsdk.TypeBool,
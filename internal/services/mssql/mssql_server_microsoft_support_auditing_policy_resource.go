@@ -56,12 +56,12 @@ func resourceMsSqlServerMicrosoftSupportAuditingPolicy() *pluginsdk.Resource {
 				ValidateFunc: validation.IsURLWithHTTPS,
 			},
 
-			"storage_account_access_key": {
+			"storage_account_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"log_monitoring_enabled": {
 				Type:     pluginsdk.TypeBool,
@@ -75,13 +75,13 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				RequiredWith: []string{"administrator_login", "administrator_login_password"},
 			},
 
-			"administrator_login_password": {
+			"administrator_login_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				AtLeastOneOf: []string{"administrator_login_password", "azuread_administrator.0.azuread_authentication_only"},
 				RequiredWith: []string{"administrator_login", "administrator_login_password"},
-			},
+			}),
 
 			"azuread_administrator": {
 				Type:     pluginsdk.TypeList,
@@ -78,12 +78,12 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 							Default:  false,
 						},
 
-						"encryption_password": {
+						"encryption_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 
 						"manual_schedule": {
 							Type:     pluginsdk.TypeList,
@@ -270,29 +270,29 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 							DiffSuppressFunc: mssqlVMCredentialNameDiffSuppressFunc,
 						},
 
-						"key_vault_url": {
+						"key_vault_url": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							ForceNew:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.IsURLWithHTTPS,
-						},
+						}),
 
-						"service_principal_name": {
+						"service_principal_name": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							ForceNew:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 
-						"service_principal_secret": {
+						"service_principal_secret": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							ForceNew:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 					},
 				},
 			},
@@ -320,19 +320,19 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 				}, false),
 			},
 
-			"sql_connectivity_update_password": {
+			"sql_connectivity_update_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
-			"sql_connectivity_update_username": {
+			"sql_connectivity_update_username": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validate.SqlVirtualMachineLoginUserName,
-			},
+			}),
 
 			"sql_instance": {
 				Type:     pluginsdk.TypeList,
@@ -548,9 +548,9 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta int
 		}
 
 		if d.IsNewResource() {
-			stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+			stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 		} else {
-			stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+			stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 		}
 
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -571,9 +571,9 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta int
 		}
 
 		if d.IsNewResource() {
-			stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+			stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 		} else {
-			stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+			stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 		}
 
 		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -51,7 +51,7 @@ func (d MsSqlManagedInstanceDataSource) ResourceType() string {
 }
 
 func (d MsSqlManagedInstanceDataSource) ModelObject() interface{} {
-	return &MsSqlManagedInstanceModel{}
+	return &MsSqlManagedInstanceDataSourceModel{}
 }
 
 func (d MsSqlManagedInstanceDataSource) Arguments() map[string]*pluginsdk.Schema {
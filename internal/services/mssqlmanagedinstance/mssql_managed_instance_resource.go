@@ -102,12 +102,12 @@ func (r MsSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 
-		"administrator_login_password": {
+		"administrator_login_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         schema.TypeString,
 			Required:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 
 		"license_type": {
 			Type:     schema.TypeString,
@@ -72,12 +72,12 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 				ValidateFunc: validate.FlexibleServerAdministratorLogin,
 			},
 
-			"administrator_password": {
+			"administrator_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validate.FlexibleServerAdministratorPassword,
-			},
+			}),
 
 			"backup_retention_days": {
 				Type:         pluginsdk.TypeInt,
@@ -419,7 +419,7 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		},
 		Refresh:    mySqlFlexibleServerCreationRefreshFunc(ctx, client, id),
 		MinTimeout: 10 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
@@ -70,11 +70,11 @@ func resourceMySqlServer() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
-			"administrator_login_password": {
+			"administrator_login_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"auto_grow_enabled": {
 				Type:     pluginsdk.TypeBool,
@@ -269,7 +269,7 @@ func resourceMySqlServer() *pluginsdk.Resource {
 							},
 						},
 
-						"storage_account_access_key": {
+						"storage_account_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
@@ -279,7 +279,7 @@ func resourceMySqlServer() *pluginsdk.Resource {
 								"threat_detection_policy.0.email_addresses", "threat_detection_policy.0.retention_days", "threat_detection_policy.0.storage_account_access_key",
 								"threat_detection_policy.0.storage_endpoint",
 							},
-						},
+						}),
 
 						"storage_endpoint": {
 							Type:         pluginsdk.TypeString,
@@ -234,7 +234,7 @@ func resourceNetAppSnapshotPolicyCreate(d *pluginsdk.ResourceData, meta interfac
 
 	// Waiting for snapshot policy be completely provisioned
 	log.Printf("[DEBUG] Waiting for %s to complete", id)
-	if err := waitForSnapshotPolicyCreation(ctx, client, id, d.Timeout(pluginsdk.TimeoutDelete)); err != nil {
+	if err := waitForSnapshotPolicyCreation(ctx, client, id, timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete)); err != nil {
 		return err
 	}
 
@@ -337,7 +337,7 @@ func resourceNetAppSnapshotPolicyDelete(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	log.Printf("[DEBUG] Waiting for %s to be deleted", id)
-	if err := waitForSnapshotPolicyDeletion(ctx, client, *id, d.Timeout(pluginsdk.TimeoutDelete)); err != nil {
+	if err := waitForSnapshotPolicyDeletion(ctx, client, *id, timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete)); err != nil {
 		return err
 	}
 
@@ -855,12 +855,12 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"data": {
+						"data": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 							Sensitive:    true,
-						},
+						}),
 
 						"id": {
 							Type:     pluginsdk.TypeString,
@@ -881,12 +881,12 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
-						"data": {
+						"data": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							ValidateFunc: validation.StringIsNotEmpty,
 							Sensitive:    true,
-						},
+						}),
 
 						"key_vault_secret_id": {
 							Type:         pluginsdk.TypeString,
@@ -1156,19 +1156,19 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							Required: true,
 						},
 
-						"data": {
+						"data": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							StateFunc:    base64EncodedStateFunc,
 							ValidateFunc: validation.StringIsBase64,
-						},
+						}),
 
-						"password": {
+						"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Optional:  true,
 							Sensitive: true,
-						},
+						}),
 
 						"key_vault_secret_id": {
 							Type:         pluginsdk.TypeString,
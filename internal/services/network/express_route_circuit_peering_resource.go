@@ -86,12 +86,12 @@ func resourceExpressRouteCircuitPeering() *pluginsdk.Resource {
 				Required: true,
 			},
 
-			"shared_key": {
+			"shared_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringLenBetween(1, 25),
-			},
+			}),
 
 			"peer_asn": {
 				Type:     pluginsdk.TypeInt,
@@ -142,11 +142,11 @@ func resourceExpressRouteCircuit() *pluginsdk.Resource {
 				Computed: true,
 			},
 
-			"authorization_key": {
+			"authorization_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"service_key": {
 				Type:      pluginsdk.TypeString,
@@ -261,7 +261,7 @@ func resourceExpressRouteCircuitCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Refresh:                   expressRouteCircuitCreationRefreshFunc(ctx, client, id.ResourceGroup, id.Name),
 		PollInterval:              3 * time.Second,
 		ContinuousTargetOccurence: 3,
-		Timeout:                   d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:                   timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
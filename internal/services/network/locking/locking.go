@@ -0,0 +1,143 @@
+// Package locking provides the locks used by every resource which modifies a Virtual Network or Subnet.
+//
+// Azure only allows a single operation at a time against a Virtual Network (including its Subnets), so these must be
+// serialized - and since Virtual Networks and Subnets are modified by resources across many services (for example
+// Key Vaults, Storage Accounts and Redis Caches), all of these must use the same locks - which is why these are
+// acquired through this package rather than by calling the `locks` package directly.
+package locking
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+const (
+	subnetResourceName         = "azurerm_subnet"
+	virtualNetworkResourceName = "azurerm_virtual_network"
+)
+
+// Locks locks Virtual Networks and Subnets.
+//
+// By default these are locked by name - which means that (for example) all Subnets named `default`, or identically
+// named Virtual Networks in different Resource Groups/Subscriptions, are serialized. When the `relaxed_locking`
+// feature is enabled these are instead locked by their Resource ID, allowing these to be modified in parallel.
+//
+// Since the feature is configured per provider (and a configuration can contain multiple aliased providers) the
+// Resource ID is also locked by default, such that a Virtual Network is locked regardless of the feature.
+type Locks struct {
+	relaxed bool
+}
+
+// New returns the Locks for the provider, using the `relaxed_locking` feature from the provider's configuration
+func New(meta interface{}) Locks {
+	return Locks{
+		relaxed: meta.(*clients.Client).Features.Network.RelaxedLocking,
+	}
+}
+
+// LockVirtualNetworks locks each of the Virtual Networks, waiting until the locks are acquired or the Context is cancelled
+func (l Locks) LockVirtualNetworks(ctx context.Context, ids ...parse.VirtualNetworkId) error {
+	keys := l.virtualNetworkKeys(ids)
+	return locks.MultipleByIDWithContext(ctx, &keys)
+}
+
+// UnlockVirtualNetworks unlocks each of the Virtual Networks locked via LockVirtualNetworks
+func (l Locks) UnlockVirtualNetworks(ids ...parse.VirtualNetworkId) {
+	keys := l.virtualNetworkKeys(ids)
+	locks.UnlockMultipleByID(&keys)
+}
+
+// LockSubnets locks each of the Subnets, waiting until the locks are acquired or the Context is cancelled
+func (l Locks) LockSubnets(ctx context.Context, ids ...parse.SubnetId) error {
+	keys := l.subnetKeys(ids)
+	return locks.MultipleByIDWithContext(ctx, &keys)
+}
+
+// UnlockSubnets unlocks each of the Subnets locked via LockSubnets
+func (l Locks) UnlockSubnets(ids ...parse.SubnetId) {
+	keys := l.subnetKeys(ids)
+	locks.UnlockMultipleByID(&keys)
+}
+
+// LockSubnetsAndVirtualNetworks locks the Virtual Network containing each of the Subnets, and then the Subnets themselves
+func (l Locks) LockSubnetsAndVirtualNetworks(ctx context.Context, ids ...parse.SubnetId) error {
+	virtualNetworkIds := virtualNetworkIdsForSubnets(ids)
+	if err := l.LockVirtualNetworks(ctx, virtualNetworkIds...); err != nil {
+		return err
+	}
+
+	if err := l.LockSubnets(ctx, ids...); err != nil {
+		l.UnlockVirtualNetworks(virtualNetworkIds...)
+		return err
+	}
+
+	return nil
+}
+
+// UnlockSubnetsAndVirtualNetworks unlocks each of the Subnets and Virtual Networks locked via LockSubnetsAndVirtualNetworks
+func (l Locks) UnlockSubnetsAndVirtualNetworks(ids ...parse.SubnetId) {
+	l.UnlockSubnets(ids...)
+	l.UnlockVirtualNetworks(virtualNetworkIdsForSubnets(ids)...)
+}
+
+// VirtualNetworkIdForSubnet returns the ID of the Virtual Network containing the specified Subnet
+func VirtualNetworkIdForSubnet(id parse.SubnetId) parse.VirtualNetworkId {
+	return parse.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName)
+}
+
+func virtualNetworkIdsForSubnets(ids []parse.SubnetId) []parse.VirtualNetworkId {
+	out := make([]parse.VirtualNetworkId, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, VirtualNetworkIdForSubnet(id))
+	}
+	return out
+}
+
+func (l Locks) virtualNetworkKeys(ids []parse.VirtualNetworkId) []string {
+	names := make([]string, 0, len(ids))
+	resourceIds := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, id.Name)
+		resourceIds = append(resourceIds, id.ID())
+	}
+	return l.keys(virtualNetworkResourceName, names, resourceIds)
+}
+
+func (l Locks) subnetKeys(ids []parse.SubnetId) []string {
+	names := make([]string, 0, len(ids))
+	resourceIds := make([]string, 0, len(ids))
+	for _, id := range ids {
+		names = append(names, id.Name)
+		resourceIds = append(resourceIds, id.ID())
+	}
+	return l.keys(subnetResourceName, names, resourceIds)
+}
+
+// keys returns the (sorted) keys to lock for the resources - which are always acquired in the same order (names
+// before Resource IDs, Virtual Networks before Subnets) to avoid deadlocks between concurrent operations
+func (l Locks) keys(resourceType string, names, resourceIds []string) []string {
+	out := make([]string, 0)
+
+	if !l.relaxed {
+		nameKeys := make([]string, 0, len(names))
+		for _, name := range names {
+			// this matches the key used by `locks.ByName`
+			nameKeys = append(nameKeys, resourceType+"."+name)
+		}
+		sort.Strings(nameKeys)
+		out = append(out, nameKeys...)
+	}
+
+	idKeys := make([]string, 0, len(resourceIds))
+	for _, id := range resourceIds {
+		// Resource IDs are case-insensitive
+		idKeys = append(idKeys, strings.ToLower(id))
+	}
+	sort.Strings(idKeys)
+	return append(out, idKeys...)
+}
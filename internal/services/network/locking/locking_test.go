@@ -0,0 +1,64 @@
+package locking
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+)
+
+func TestLocksSubnetKeys(t *testing.T) {
+	ids := []parse.SubnetId{
+		parse.NewSubnetID("00000000-0000-0000-0000-000000000000", "group2", "network1", "default"),
+		parse.NewSubnetID("00000000-0000-0000-0000-000000000000", "group1", "network1", "default"),
+	}
+
+	testData := []struct {
+		relaxed  bool
+		expected []string
+	}{
+		{
+			relaxed: false,
+			expected: []string{
+				"azurerm_subnet.default",
+				"azurerm_subnet.default",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1/subnets/default",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group2/providers/microsoft.network/virtualnetworks/network1/subnets/default",
+			},
+		},
+		{
+			relaxed: true,
+			expected: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1/subnets/default",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group2/providers/microsoft.network/virtualnetworks/network1/subnets/default",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing relaxed %t", v.relaxed)
+
+		actual := Locks{relaxed: v.relaxed}.subnetKeys(ids)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestLocksVirtualNetworkKeysMatchByName(t *testing.T) {
+	id := parse.NewVirtualNetworkID("00000000-0000-0000-0000-000000000000", "group1", "network1")
+
+	// resources outside of this package historically locked Virtual Networks using `locks.ByName`, which this must match
+	actual := Locks{}.virtualNetworkKeys([]parse.VirtualNetworkId{id})
+	if actual[0] != "azurerm_virtual_network.network1" {
+		t.Fatalf("expected the first key to be the name but got %q", actual[0])
+	}
+}
+
+func TestVirtualNetworkIdForSubnet(t *testing.T) {
+	actual := VirtualNetworkIdForSubnet(parse.NewSubnetID("00000000-0000-0000-0000-000000000000", "group1", "network1", "default"))
+	expected := parse.NewVirtualNetworkID("00000000-0000-0000-0000-000000000000", "group1", "network1")
+	if actual != expected {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -89,16 +90,19 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *pluginsdk.ResourceData, me
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	vnetsToLock, err := expandNetworkDDoSProtectionPlanVnetNames(d)
+	vnetsToLock, err := expandNetworkDDoSProtectionPlanVnetIDs(d)
 	if err != nil {
-		return fmt.Errorf("extracting names of Virtual Network: %+v", err)
+		return fmt.Errorf("extracting IDs of Virtual Network: %+v", err)
 	}
 
 	locks.ByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
 	defer locks.UnlockByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, vnetsToLock...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(vnetsToLock...)
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
@@ -177,16 +181,19 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	vnetsToLock, err := extractVnetNames(d)
+	vnetsToLock, err := expandNetworkDDoSProtectionPlanVnetIDs(d)
 	if err != nil {
-		return fmt.Errorf("extracting names of Virtual Network: %+v", err)
+		return fmt.Errorf("extracting IDs of Virtual Network: %+v", err)
 	}
 
 	locks.ByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
 	defer locks.UnlockByName(id.Name, azureNetworkDDoSProtectionPlanResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, vnetsToLock...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(vnetsToLock...)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -200,9 +207,9 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 	return err
 }
 
-func expandNetworkDDoSProtectionPlanVnetNames(d *pluginsdk.ResourceData) (*[]string, error) {
+func expandNetworkDDoSProtectionPlanVnetIDs(d *pluginsdk.ResourceData) ([]parse.VirtualNetworkId, error) {
 	vnetIDs := d.Get("virtual_network_ids").([]interface{})
-	vnetNames := make([]string, 0)
+	vnetResourceIDs := make([]parse.VirtualNetworkId, 0)
 
 	for _, vnetID := range vnetIDs {
 		vnetResourceID, err := parse.VirtualNetworkID(vnetID.(string))
@@ -210,12 +217,10 @@ func expandNetworkDDoSProtectionPlanVnetNames(d *pluginsdk.ResourceData) (*[]str
 			return nil, err
 		}
 
-		if !utils.SliceContainsValue(vnetNames, vnetResourceID.Name) {
-			vnetNames = append(vnetNames, vnetResourceID.Name)
-		}
+		vnetResourceIDs = append(vnetResourceIDs, *vnetResourceID)
 	}
 
-	return &vnetNames, nil
+	return vnetResourceIDs, nil
 }
 
 func flattenNetworkDDoSProtectionPlanVirtualNetworkIDs(input *[]network.SubResource) []string {
@@ -233,21 +238,3 @@ func flattenNetworkDDoSProtectionPlanVirtualNetworkIDs(input *[]network.SubResou
 
 	return vnetIDs
 }
-
-func extractVnetNames(d *pluginsdk.ResourceData) (*[]string, error) {
-	vnetIDs := d.Get("virtual_network_ids").([]interface{})
-	vnetNames := make([]string, 0)
-
-	for _, vnetID := range vnetIDs {
-		vnetResourceID, err := parse.VirtualNetworkID(vnetID.(string))
-		if err != nil {
-			return nil, err
-		}
-
-		if !utils.SliceContainsValue(vnetNames, vnetResourceID.Name) {
-			vnetNames = append(vnetNames, vnetResourceID.Name)
-		}
-	}
-
-	return &vnetNames, nil
-}
@@ -1,38 +1,36 @@
 package network
 
 import (
-	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"context"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
 )
 
 type networkInterfaceIPConfigurationLockingDetails struct {
-	subnetNamesToLock         []string
-	virtualNetworkNamesToLock []string
+	networkLocks locking.Locks
+
+	subnetIdsToLock []parse.SubnetId
 }
 
-func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
+func (details networkInterfaceIPConfigurationLockingDetails) lock(ctx context.Context) error {
+	return details.networkLocks.LockSubnetsAndVirtualNetworks(ctx, details.subnetIdsToLock...)
 }
 
 func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
-	locks.UnlockMultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	details.networkLocks.UnlockSubnetsAndVirtualNetworks(details.subnetIdsToLock...)
 }
 
-func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
+func determineResourcesToLockFromIPConfiguration(networkLocks locking.Locks, input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
 	if input == nil {
 		return &networkInterfaceIPConfigurationLockingDetails{
-			subnetNamesToLock:         []string{},
-			virtualNetworkNamesToLock: []string{},
+			networkLocks:    networkLocks,
+			subnetIdsToLock: []parse.SubnetId{},
 		}, nil
 	}
 
-	subnetNamesToLock := make([]string, 0)
-	virtualNetworkNamesToLock := make([]string, 0)
-
+	subnetIdsToLock := make([]parse.SubnetId, 0)
 	for _, config := range *input {
 		if config.Subnet == nil || config.Subnet.ID == nil {
 			continue
@@ -43,20 +41,11 @@ func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPCon
 			return nil, err
 		}
 
-		virtualNetworkName := id.VirtualNetworkName
-		subnetName := id.Name
-
-		if !utils.SliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-			virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
-		}
-
-		if !utils.SliceContainsValue(subnetNamesToLock, subnetName) {
-			subnetNamesToLock = append(subnetNamesToLock, subnetName)
-		}
+		subnetIdsToLock = append(subnetIdsToLock, *id)
 	}
 
 	return &networkInterfaceIPConfigurationLockingDetails{
-		subnetNamesToLock:         subnetNamesToLock,
-		virtualNetworkNamesToLock: virtualNetworkNamesToLock,
+		networkLocks:    networkLocks,
+		subnetIdsToLock: subnetIdsToLock,
 	}, nil
 }
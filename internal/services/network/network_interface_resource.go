@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	lbvalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -252,12 +253,15 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 	if err != nil {
 		return fmt.Errorf("expanding `ip_configuration`: %+v", err)
 	}
-	lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(locking.New(meta), ipConfigs)
 	if err != nil {
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("creating %s", id))
+	if err := lockingDetails.lock(ctx); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	if len(*ipConfigs) > 0 {
@@ -349,12 +353,15 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		if err != nil {
 			return fmt.Errorf("expanding `ip_configuration`: %+v", err)
 		}
-		lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
+		lockingDetails, err := determineResourcesToLockFromIPConfiguration(locking.New(meta), ipConfigs)
 		if err != nil {
 			return fmt.Errorf("determining locking details: %+v", err)
 		}
 
-		lockingDetails.lock()
+		ctx = locks.WithHolder(ctx, fmt.Sprintf("updating %s", *id))
+		if err := lockingDetails.lock(ctx); err != nil {
+			return err
+		}
 		defer lockingDetails.unlock()
 
 		// then map the fields managed in other resources back
@@ -509,12 +516,15 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 	}
 	props := *existing.InterfacePropertiesFormat
 
-	lockingDetails, err := determineResourcesToLockFromIPConfiguration(props.IPConfigurations)
+	lockingDetails, err := determineResourcesToLockFromIPConfiguration(locking.New(meta), props.IPConfigurations)
 	if err != nil {
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("deleting %s", *id))
+	if err := lockingDetails.lock(ctx); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
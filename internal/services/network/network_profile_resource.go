@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -124,19 +125,19 @@ func resourceNetworkProfileCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d)
+	subnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetIDs(d)
 	if err != nil {
-		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
+		return fmt.Errorf("extracting IDs of Subnet: %+v", err)
 	}
 
 	locks.ByName(id.Name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(id.Name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockSubnetsAndVirtualNetworks(ctx, subnetsToLock...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnetsAndVirtualNetworks(subnetsToLock...)
 
 	parameters := network.Profile{
 		Location: &location,
@@ -218,19 +219,19 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	subnetsToLock, vnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetNames(d)
+	subnetsToLock, err := expandNetworkProfileVirtualNetworkSubnetIDs(d)
 	if err != nil {
-		return fmt.Errorf("extracting names of Subnet and Virtual Network: %+v", err)
+		return fmt.Errorf("extracting IDs of Subnet: %+v", err)
 	}
 
 	locks.ByName(id.Name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(id.Name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockSubnetsAndVirtualNetworks(ctx, subnetsToLock...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnetsAndVirtualNetworks(subnetsToLock...)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -284,10 +285,9 @@ func expandNetworkProfileContainerNetworkInterface(d *pluginsdk.ResourceData) *[
 	return &retCNIConfigs
 }
 
-func expandNetworkProfileVirtualNetworkSubnetNames(d *pluginsdk.ResourceData) (*[]string, *[]string, error) {
+func expandNetworkProfileVirtualNetworkSubnetIDs(d *pluginsdk.ResourceData) ([]parse.SubnetId, error) {
 	cniConfigs := d.Get("container_network_interface").([]interface{})
-	subnetNames := make([]string, 0)
-	vnetNames := make([]string, 0)
+	subnetIDs := make([]parse.SubnetId, 0)
 
 	for _, cniConfig := range cniConfigs {
 		nciData := cniConfig.(map[string]interface{})
@@ -299,20 +299,14 @@ func expandNetworkProfileVirtualNetworkSubnetNames(d *pluginsdk.ResourceData) (*
 
 			subnetResourceID, err := parse.SubnetID(subnetID)
 			if err != nil {
-				return nil, nil, err
-			}
-
-			if !utils.SliceContainsValue(subnetNames, subnetResourceID.Name) {
-				subnetNames = append(subnetNames, subnetResourceID.Name)
+				return nil, err
 			}
 
-			if !utils.SliceContainsValue(vnetNames, subnetResourceID.VirtualNetworkName) {
-				vnetNames = append(vnetNames, subnetResourceID.VirtualNetworkName)
-			}
+			subnetIDs = append(subnetIDs, *subnetResourceID)
 		}
 	}
 
-	return &subnetNames, &vnetNames, nil
+	return subnetIDs, nil
 }
 
 func flattenNetworkProfileContainerNetworkInterface(input *[]network.ContainerNetworkInterfaceConfiguration) []interface{} {
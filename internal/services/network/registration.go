@@ -17,6 +17,13 @@ func (r Registration) Name() string {
 	return "Network"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Network",
+	}
+}
+
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/network"
 }
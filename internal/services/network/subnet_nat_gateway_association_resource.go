@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -70,12 +71,22 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("parsing NAT gateway id '%s': %+v", natGatewayId, err)
 	}
 
-	locks.ByName(parsedGatewayId.Name, natGatewayResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("creating the NAT Gateway Association for %s", *parsedSubnetId))
+	networkLocks := locking.New(meta)
+	if err := locks.ByNameWithContext(ctx, parsedGatewayId.Name, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedGatewayId.Name, natGatewayResourceName)
-	locks.ByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(parsedSubnetId.Name, SubnetResourceName)
-	defer locks.UnlockByName(parsedSubnetId.Name, SubnetResourceName)
+
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(*parsedSubnetId)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(*parsedSubnetId))
+
+	if err := networkLocks.LockSubnets(ctx, *parsedSubnetId); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnets(*parsedSubnetId)
 
 	subnet, err := client.Get(ctx, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName, parsedSubnetId.Name, "")
 	if err != nil {
@@ -205,10 +216,17 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedGatewayId.Name, natGatewayResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("deleting the NAT Gateway Association for %s", *id))
+	networkLocks := locking.New(meta)
+	if err := locks.ByNameWithContext(ctx, parsedGatewayId.Name, natGatewayResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedGatewayId.Name, natGatewayResourceName)
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(*id)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(*id))
 
 	// ensure we get the latest state
 	subnet, err = client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
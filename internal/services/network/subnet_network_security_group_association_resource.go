@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -73,14 +74,22 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("creating the Network Security Group Association for %s", *parsedSubnetId))
+	networkLocks := locking.New(meta)
+	if err := locks.ByNameWithContext(ctx, parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 
-	locks.ByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(parsedSubnetId.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(*parsedSubnetId)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(*parsedSubnetId))
 
-	locks.ByName(parsedSubnetId.Name, SubnetResourceName)
-	defer locks.UnlockByName(parsedSubnetId.Name, SubnetResourceName)
+	if err := networkLocks.LockSubnets(ctx, *parsedSubnetId); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnets(*parsedSubnetId)
 
 	subnet, err := client.Get(ctx, parsedSubnetId.ResourceGroup, parsedSubnetId.VirtualNetworkName, parsedSubnetId.Name, "")
 	if err != nil {
@@ -218,14 +227,22 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("deleting the Network Security Group Association for %s", *id))
+	networkLocks := locking.New(meta)
+	if err := locks.ByNameWithContext(ctx, parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(*id)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(*id))
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	if err := networkLocks.LockSubnets(ctx, *id); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnets(*id)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
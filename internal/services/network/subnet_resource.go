@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -252,8 +253,12 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurerm_subnet", id.ID())
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("creating %s", id))
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(id)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(id))
 
 	properties := network.SubnetPropertiesFormat{}
 	if value, ok := d.GetOk("address_prefixes"); ok {
@@ -400,11 +405,17 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("updating %s", *id))
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(*id)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(*id))
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	if err := networkLocks.LockSubnets(ctx, *id); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnets(*id)
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
 	if err != nil {
@@ -611,11 +622,17 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("deleting %s", *id))
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(*id)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(*id))
 
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	if err := networkLocks.LockSubnets(ctx, *id); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockSubnets(*id)
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -73,15 +74,21 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("creating the Route Table Association for %s", *parsedSubnetId))
+	networkLocks := locking.New(meta)
+	if err := locks.ByNameWithContext(ctx, parsedRouteTableId.Name, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
 
 	subnetName := parsedSubnetId.Name
 	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(*parsedSubnetId)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(*parsedSubnetId))
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -225,11 +232,17 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
+	ctx = locks.WithHolder(ctx, fmt.Sprintf("deleting the Route Table Association for %s", *id))
+	networkLocks := locking.New(meta)
+	if err := locks.ByNameWithContext(ctx, parsedRouteTableId.Name, routeTableResourceName); err != nil {
+		return err
+	}
 	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	if err := networkLocks.LockVirtualNetworks(ctx, locking.VirtualNetworkIdForSubnet(*id)); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(locking.VirtualNetworkIdForSubnet(*id))
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
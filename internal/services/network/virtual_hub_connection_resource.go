@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -174,8 +175,11 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(remoteVirtualNetworkId.Name, VirtualNetworkResourceName)
-	defer locks.UnlockByName(remoteVirtualNetworkId.Name, VirtualNetworkResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, *remoteVirtualNetworkId); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(*remoteVirtualNetworkId)
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
@@ -6,7 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -76,8 +76,11 @@ func resourceVirtualNetworkDnsServersCreateUpdate(d *pluginsdk.ResourceData, met
 		return fmt.Errorf("reading %s: %s", vnetId, err)
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, *vnetId); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(*vnetId)
 
 	if vnet.VirtualNetworkPropertiesFormat == nil {
 		return fmt.Errorf("%s was returned without any properties", vnetId)
@@ -166,8 +169,11 @@ func resourceVirtualNetworkDnsServersDelete(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("reading %s: %s", vnetId, err)
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, vnetId); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(vnetId)
 
 	if vnet.VirtualNetworkPropertiesFormat == nil {
 		return fmt.Errorf("%s was returned without any properties", vnetId)
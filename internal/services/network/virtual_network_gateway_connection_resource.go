@@ -145,11 +145,11 @@ func resourceVirtualNetworkGatewayConnection() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(0, 32000),
 			},
 
-			"shared_key": {
+			"shared_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"express_route_gateway_bypass": {
 				Type:     pluginsdk.TypeBool,
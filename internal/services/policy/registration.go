@@ -36,6 +36,15 @@ func (r Registration) Name() string {
 	return "Policy"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Authorization",
+		"Microsoft.GuestConfiguration",
+		"Microsoft.PolicyInsights",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
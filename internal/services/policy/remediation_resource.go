@@ -204,7 +204,7 @@ func resourceArmResourcePolicyRemediationDelete(d *pluginsdk.ResourceData, meta
 	if err := waitForRemediationToDelete(ctx,
 		existing.Model.Properties,
 		id.ID(),
-		d.Timeout(pluginsdk.TimeoutDelete),
+		timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		func() error {
 			_, err := client.RemediationsCancelAtResource(ctx, *id)
 			return err
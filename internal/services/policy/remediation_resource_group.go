@@ -207,7 +207,7 @@ func resourceArmResourceGroupPolicyRemediationDelete(d *pluginsdk.ResourceData,
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	if err := waitForRemediationToDelete(ctx, existing.Model.Properties, id.ID(), d.Timeout(pluginsdk.TimeoutDelete),
+	if err := waitForRemediationToDelete(ctx, existing.Model.Properties, id.ID(), timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		func() error {
 			_, err := client.RemediationsCancelAtResourceGroup(ctx, *id)
 			return err
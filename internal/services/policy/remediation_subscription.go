@@ -206,7 +206,7 @@ func resourceArmSubscriptionPolicyRemediationDelete(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	if err := waitForRemediationToDelete(ctx, existing.Model.Properties, id.ID(), d.Timeout(pluginsdk.TimeoutDelete),
+	if err := waitForRemediationToDelete(ctx, existing.Model.Properties, id.ID(), timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 		func() error {
 			_, err := client.RemediationsCancelAtSubscription(ctx, *id)
 			return err
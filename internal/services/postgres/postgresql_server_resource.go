@@ -141,11 +141,11 @@ func resourcePostgreSQLServer() *pluginsdk.Resource {
 				ValidateFunc: validation.All(validation.StringIsNotWhiteSpace, validate.AdminUsernames),
 			},
 
-			"administrator_login_password": {
+			"administrator_login_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"auto_grow_enabled": {
 				Type:     pluginsdk.TypeBool,
@@ -295,7 +295,7 @@ func resourcePostgreSQLServer() *pluginsdk.Resource {
 							},
 						},
 
-						"storage_account_access_key": {
+						"storage_account_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
@@ -305,7 +305,7 @@ func resourcePostgreSQLServer() *pluginsdk.Resource {
 								"threat_detection_policy.0.email_addresses", "threat_detection_policy.0.retention_days", "threat_detection_policy.0.storage_account_access_key",
 								"threat_detection_policy.0.storage_endpoint",
 							},
-						},
+						}),
 
 						"storage_endpoint": {
 							Type:         pluginsdk.TypeString,
@@ -492,7 +492,7 @@ func resourcePostgreSQLServerCreate(d *pluginsdk.ResourceData, meta interface{})
 		Target:     []string{string(servers.ServerStateReady)},
 		Refresh:    postgreSqlStateRefreshFunc(ctx, client, id),
 		MinTimeout: 15 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
@@ -569,7 +569,7 @@ func resourcePostgreSQLServerUpdate(d *pluginsdk.ResourceData, meta interface{})
 			Target:     []string{string(servers.ServerStateReady)},
 			Refresh:    postgreSqlStateRefreshFunc(ctx, client, *id),
 			MinTimeout: 15 * time.Second,
-			Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+			Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 		}
 
 		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
@@ -200,9 +200,9 @@ func resourceBackupProtectionContainerStorageAccountWaitForOperation(ctx context
 	}
 
 	if d.IsNewResource() {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	log.Printf("[DEBUG] Waiting for backup container operation %q (Vault %q) to complete", operationID, vaultName)
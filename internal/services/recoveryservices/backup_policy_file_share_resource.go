@@ -476,9 +476,9 @@ func resourceBackupProtectionPolicyFileShareWaitForUpdate(ctx context.Context, c
 	}
 
 	if d.IsNewResource() {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	_, err := state.WaitForStateContext(ctx)
@@ -496,7 +496,7 @@ func resourceBackupProtectionPolicyFileShareWaitForDeletion(ctx context.Context,
 		Pending:    []string{"Found"},
 		Target:     []string{"NotFound"},
 		Refresh:    resourceBackupProtectionPolicyFileShareRefreshFunc(ctx, client, id),
-		Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 
 	_, err := state.WaitForStateContext(ctx)
@@ -707,9 +707,9 @@ func resourceBackupProtectionPolicyVMWaitForUpdate(ctx context.Context, client *
 	}
 
 	if d.IsNewResource() {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	_, err := state.WaitForStateContext(ctx)
@@ -727,7 +727,7 @@ func resourceBackupProtectionPolicyVMWaitForDeletion(ctx context.Context, client
 		Pending:    []string{"Found"},
 		Target:     []string{"NotFound"},
 		Refresh:    resourceBackupProtectionPolicyVMRefreshFunc(ctx, client, id),
-		Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 
 	_, err := state.WaitForStateContext(ctx)
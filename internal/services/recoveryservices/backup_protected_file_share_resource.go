@@ -152,9 +152,9 @@ func resourceBackupProtectedFileShareCreateUpdate(d *pluginsdk.ResourceData, met
 	}
 
 	if d.IsNewResource() {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	if _, err := state.WaitForStateContext(ctx); err != nil {
@@ -357,9 +357,9 @@ func resourceBackupProtectedFileShareWaitForOperation(ctx context.Context, clien
 	}
 
 	if d.IsNewResource() {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	log.Printf("[DEBUG] Waiting for backup operation %s (Vault %s) to complete", operationID, vaultName)
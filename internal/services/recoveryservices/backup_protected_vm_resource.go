@@ -221,9 +221,9 @@ func resourceRecoveryServicesBackupProtectedVMWaitForStateCreateUpdate(ctx conte
 	}
 
 	if d.IsNewResource() {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 	} else {
-		state.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		state.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	_, err := state.WaitForStateContext(ctx)
@@ -262,7 +262,7 @@ func resourceRecoveryServicesBackupProtectedVMWaitForDeletion(ctx context.Contex
 			return resp, "Pending", nil
 		},
 
-		Timeout: d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout: timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 
 	_, err := state.WaitForStateContext(ctx)
@@ -284,7 +284,7 @@ func resourceRecoveryServicesBackupProtectedVMWaitForDeletion(ctx context.Contex
 			return resp, strconv.Itoa(resp.StatusCode), err
 		},
 
-		Timeout: d.Timeout(pluginsdk.TimeoutDelete),
+		Timeout: timeouts.DurationFor(ctx, d, pluginsdk.TimeoutDelete),
 	}
 
 	_, err = opState.WaitForStateContext(ctx)
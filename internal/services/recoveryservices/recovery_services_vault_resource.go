@@ -241,7 +241,7 @@ func resourceRecoveryServicesVaultCreate(d *pluginsdk.ResourceData, meta interfa
 		},
 	}
 
-	err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
+	err = pluginsdk.Retry(timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
 		if resp, err := storageCfgsClient.Update(ctx, storageId, storageCfg); err != nil {
 			if response.WasNotFound(resp.HttpResponse) {
 				return pluginsdk.RetryableError(fmt.Errorf("updating Recovery Service Storage Cfg %s: %+v", id.String(), err))
@@ -256,7 +256,7 @@ func resourceRecoveryServicesVaultCreate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	// storage type is not updated instantaneously, so we wait until storage type is correct
-	err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
+	err = pluginsdk.Retry(timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
 		if resp, err := storageCfgsClient.Get(ctx, storageId); err == nil {
 			if resp.Model == nil {
 				return pluginsdk.NonRetryableError(fmt.Errorf("updating %s Storage Config: `model` was nil", id))
@@ -328,7 +328,7 @@ func resourceRecoveryServicesVaultCreate(d *pluginsdk.ResourceData, meta interfa
 		Refresh:                   resourceRecoveryServicesVaultSoftDeleteRefreshFunc(ctx, cfgsClient, cfgId),
 	}
 
-	stateConf.Timeout = d.Timeout(pluginsdk.TimeoutCreate)
+	stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate)
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for on update for Recovery Service %s: %+v", id.String(), err)
@@ -430,7 +430,7 @@ func resourceRecoveryServicesVaultUpdate(d *pluginsdk.ResourceData, meta interfa
 			},
 		}
 
-		err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutUpdate), func() *pluginsdk.RetryError {
+		err = pluginsdk.Retry(timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate), func() *pluginsdk.RetryError {
 			if resp, err := storageCfgsClient.Update(ctx, storageId, storageCfg); err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
 					return pluginsdk.RetryableError(fmt.Errorf("updating Recovery Service Storage Cfg %s: %+v", id.String(), err))
@@ -448,7 +448,7 @@ func resourceRecoveryServicesVaultUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 
 		// storage type is not updated instantaneously, so we wait until storage type is correct
-		err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutUpdate), func() *pluginsdk.RetryError {
+		err = pluginsdk.Retry(timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate), func() *pluginsdk.RetryError {
 			if resp, err := storageCfgsClient.Get(ctx, storageId); err == nil {
 				if resp.Model == nil {
 					return pluginsdk.NonRetryableError(fmt.Errorf("updating %s Storage Config: `model` was nil", id))
@@ -556,7 +556,7 @@ func resourceRecoveryServicesVaultUpdate(d *pluginsdk.ResourceData, meta interfa
 		Refresh:                   resourceRecoveryServicesVaultSoftDeleteRefreshFunc(ctx, cfgsClient, cfgId),
 	}
 
-	stateConf.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+	stateConf.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("waiting for on update for Recovery Service %s: %+v", id.String(), err)
@@ -21,8 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/migration"
@@ -189,11 +188,11 @@ func resourceRedisCache() *pluginsdk.Resource {
 							Optional: true,
 						},
 
-						"rdb_storage_connection_string": {
+						"rdb_storage_connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Optional:  true,
 							Sensitive: true,
-						},
+						}),
 
 						"notify_keyspace_events": {
 							Type:     pluginsdk.TypeString,
@@ -205,17 +204,17 @@ func resourceRedisCache() *pluginsdk.Resource {
 							Optional: true,
 						},
 
-						"aof_storage_connection_string_0": {
+						"aof_storage_connection_string_0": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Optional:  true,
 							Sensitive: true,
-						},
+						}),
 
-						"aof_storage_connection_string_1": {
+						"aof_storage_connection_string_1": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:      pluginsdk.TypeString,
 							Optional:  true,
 							Sensitive: true,
-						},
+						}),
 						// TODO 4.0: change this from enable_* to *_enabled
 						"enable_authentication": {
 							Type:     pluginsdk.TypeBool,
@@ -438,11 +437,11 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		networkLocks := locking.New(meta)
+		if err := networkLocks.LockSubnetsAndVirtualNetworks(ctx, *parsed); err != nil {
+			return err
+		}
+		defer networkLocks.UnlockSubnetsAndVirtualNetworks(*parsed)
 
 		parameters.Properties.SubnetId = utils.String(v.(string))
 	}
@@ -572,7 +571,7 @@ func resourceRedisCacheUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		Target:     []string{"Succeeded"},
 		Refresh:    redisStateRefreshFunc(ctx, client, *id),
 		MinTimeout: 15 * time.Second,
-		Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate),
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
@@ -753,11 +752,11 @@ func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		networkLocks := locking.New(meta)
+		if err := networkLocks.LockSubnetsAndVirtualNetworks(ctx, *parsed); err != nil {
+			return err
+		}
+		defer networkLocks.UnlockSubnetsAndVirtualNetworks(*parsed)
 	}
 
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
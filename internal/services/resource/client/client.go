@@ -3,6 +3,7 @@ package client
 import (
 	providers "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2019-06-01-preview/templatespecs" // nolint: staticcheck
+	"github.com/Azure/azure-sdk-for-go/services/resourcegraph/mgmt/2021-03-01/resourcegraph"             // nolint: staticcheck
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2015-12-01/features"                      // nolint: staticcheck
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"                     // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
@@ -17,6 +18,7 @@ type Client struct {
 	GroupsClient                *resources.GroupsClient
 	LocksClient                 *managementlocks.ManagementLocksClient
 	ProvidersClient             *providers.ProvidersClient
+	ResourceGraphClient         *resourcegraph.BaseClient
 	ResourceProvidersClient     *resources.ProvidersClient
 	ResourcesClient             *resources.Client
 	TagsClient                  *resources.TagsClient
@@ -45,6 +47,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	providersClient := providers.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&providersClient.Client, o.ResourceManagerAuthorizer)
 
+	resourceGraphClient := resourcegraph.NewWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&resourceGraphClient.Client, o.ResourceManagerAuthorizer)
+
 	// add a secondary ProvidersClient to use latest resources sdk
 	resourceProvidersClient := resources.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourceProvidersClient.Client, o.ResourceManagerAuthorizer)
@@ -65,6 +70,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		FeaturesClient:              &featuresClient,
 		LocksClient:                 &locksClient,
 		ProvidersClient:             &providersClient,
+		ResourceGraphClient:         &resourceGraphClient,
 		ResourceProvidersClient:     &resourceProvidersClient,
 		ResourcesClient:             &resourcesClient,
 		TagsClient:                  &tagsClient,
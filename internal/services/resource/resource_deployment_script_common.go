@@ -133,12 +133,12 @@ func getDeploymentScriptArguments(kind DeploymentScriptKind) map[string]*plugins
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
 
-					"secure_value": {
+					"secure_value": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 						Sensitive:    true,
-					},
+					}),
 
 					"value": {
 						Type:         pluginsdk.TypeString,
@@ -182,12 +182,12 @@ func getDeploymentScriptArguments(kind DeploymentScriptKind) map[string]*plugins
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
-					"key": {
+					"key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Required:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 						Sensitive:    true,
-					},
+					}),
 
 					"name": {
 						Type:         pluginsdk.TypeString,
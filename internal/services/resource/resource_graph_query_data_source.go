@@ -0,0 +1,183 @@
+package resource
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resourcegraph/mgmt/2021-03-01/resourcegraph" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	managementGroupParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
+	managementGroupValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceGraphQueryPageSize is the maximum number of rows which Resource Graph returns in a single page
+const resourceGraphQueryPageSize = 1000
+
+type ResourceGraphQueryDataSource struct{}
+
+var _ sdk.DataSource = ResourceGraphQueryDataSource{}
+
+type ResourceGraphQueryDataSourceModel struct {
+	Query              string   `tfschema:"query"`
+	SubscriptionIds    []string `tfschema:"subscription_ids"`
+	ManagementGroupIds []string `tfschema:"management_group_ids"`
+	Ids                []string `tfschema:"ids"`
+	Results            []string `tfschema:"results"`
+}
+
+func (r ResourceGraphQueryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subscription_ids": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"management_group_ids"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"management_group_ids": {
+			Type:          pluginsdk.TypeList,
+			Optional:      true,
+			ConflictsWith: []string{"subscription_ids"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: managementGroupValidate.ManagementGroupID,
+			},
+		},
+	}
+}
+
+func (r ResourceGraphQueryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		// NOTE: the columns returned for each row depend on the query, as such each row is returned as a JSON
+		// encoded object, which can be parsed using `jsondecode`
+		"results": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ResourceGraphQueryDataSource) ModelObject() interface{} {
+	return &ResourceGraphQueryDataSourceModel{}
+}
+
+func (r ResourceGraphQueryDataSource) ResourceType() string {
+	return "azurerm_resource_graph_query"
+}
+
+func (r ResourceGraphQueryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.ResourceGraphClient
+
+			var model ResourceGraphQueryDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			request := resourcegraph.QueryRequest{
+				Query: utils.String(model.Query),
+				Options: &resourcegraph.QueryRequestOptions{
+					ResultFormat: resourcegraph.ResultFormatObjectArray,
+					Top:          utils.Int32(resourceGraphQueryPageSize),
+				},
+			}
+
+			scopes := make([]string, 0)
+			if len(model.ManagementGroupIds) > 0 {
+				managementGroupNames := make([]string, 0)
+				for _, v := range model.ManagementGroupIds {
+					id, err := managementGroupParse.ManagementGroupID(v)
+					if err != nil {
+						return err
+					}
+					managementGroupNames = append(managementGroupNames, id.Name)
+				}
+				request.ManagementGroups = &managementGroupNames
+				scopes = append(scopes, model.ManagementGroupIds...)
+			} else {
+				// the query is scoped to the Subscription used by the Provider unless otherwise specified, rather than
+				// each Subscription which the credentials have access to
+				subscriptionIds := model.SubscriptionIds
+				if len(subscriptionIds) == 0 {
+					subscriptionIds = []string{metadata.Client.Account.SubscriptionId}
+				}
+				request.Subscriptions = &subscriptionIds
+				scopes = append(scopes, subscriptionIds...)
+			}
+
+			model.Ids = make([]string, 0)
+			model.Results = make([]string, 0)
+			for {
+				resp, err := client.Resources(ctx, request)
+				if err != nil {
+					return fmt.Errorf("running the Resource Graph Query: %+v", err)
+				}
+
+				// where the results can't be paged (since the `id` column isn't included) an error is returned rather than
+				// only returning the first page, since the truncated results would otherwise be used silently
+				if resp.ResultTruncated == resourcegraph.True && resp.SkipToken == nil {
+					return fmt.Errorf("the results of the Resource Graph Query were truncated - the `id` column must be included in the results for these to be paged")
+				}
+
+				rows, ok := resp.Data.([]interface{})
+				if resp.Data != nil && !ok {
+					return fmt.Errorf("expected the results of the Resource Graph Query to be an array of objects but got %T", resp.Data)
+				}
+
+				for _, row := range rows {
+					result, err := json.Marshal(row)
+					if err != nil {
+						return fmt.Errorf("encoding the result %+v: %+v", row, err)
+					}
+					model.Results = append(model.Results, string(result))
+
+					if v, ok := row.(map[string]interface{}); ok {
+						if id, ok := v["id"].(string); ok && id != "" {
+							model.Ids = append(model.Ids, id)
+						}
+					}
+				}
+
+				if resp.SkipToken == nil || *resp.SkipToken == "" {
+					break
+				}
+				request.Options.SkipToken = resp.SkipToken
+			}
+
+			sort.Strings(scopes)
+			metadata.ResourceData.SetId(fmt.Sprintf("resourceGraphQuery-%x", sha1.Sum([]byte(strings.Join(append(scopes, model.Query), "|")))))
+
+			return metadata.Encode(&model)
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ResourceGraphQueryDataSource struct{}
+
+func TestAccDataSourceResourceGraphQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("results.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceResourceGraphQuery_subscriptionIds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_graph_query", "test")
+	r := ResourceGraphQueryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			Config: r.subscriptionIds(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ids.#").HasValue("0"),
+				check.That(data.ResourceName).Key("results.#").HasValue("1"),
+				check.That(data.ResourceName).Key("results.0").HasValue(`{"Count":2}`),
+			),
+		},
+	})
+}
+
+func (r ResourceGraphQueryDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_graph_query" "test" {
+  query = "resources | where resourceGroup =~ '${azurerm_resource_group.test.name}' | project id, name, type"
+}
+`, r.template(data))
+}
+
+func (r ResourceGraphQueryDataSource) subscriptionIds(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_resource_graph_query" "test" {
+  query            = "resources | where resourceGroup =~ '${azurerm_resource_group.test.name}' | count"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, r.template(data))
+}
+
+func (ResourceGraphQueryDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rgq-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  count               = 2
+  name                = "acctestvn-%[1]d-${count.index}"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -22,6 +22,8 @@ import (
 var (
 	_ sdk.Resource                   = ResourceProviderRegistrationResource{}
 	_ sdk.ResourceWithCustomImporter = ResourceProviderRegistrationResource{}
+
+	_ sdk.ResourceWithSubscriptionTargeting = ResourceProviderRegistrationResource{}
 )
 
 type ResourceProviderRegistrationResource struct{}
@@ -336,6 +338,12 @@ func (r ResourceProviderRegistrationResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+// SupportsSubscriptionTargeting allows the Resource Provider to be registered within another Subscription, since the
+// Providers and Features clients are scoped to the Subscription of the Client made available during each operation
+func (r ResourceProviderRegistrationResource) SupportsSubscriptionTargeting() bool {
+	return true
+}
+
 func (r ResourceProviderRegistrationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return validate.ResourceProviderID
 }
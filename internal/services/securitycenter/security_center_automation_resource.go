@@ -107,19 +107,19 @@ func resourceSecurityCenterAutomation() *pluginsdk.Resource {
 							ValidateFunc: azure.ValidateResourceID,
 						},
 
-						"trigger_url": {
+						"trigger_url": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
-						},
+						}),
 
-						"connection_string": {
+						"connection_string": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 					},
 				},
 			},
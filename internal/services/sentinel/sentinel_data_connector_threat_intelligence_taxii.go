@@ -62,18 +62,18 @@ func (r DataConnectorThreatIntelligenceTAXIIResource) Arguments() map[string]*pl
 			Required:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
-		"user_name": {
+		"user_name": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
-		"password": {
+		}),
+		"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 		"polling_frequency": {
 			Type:     pluginsdk.TypeString,
 			Optional: true,
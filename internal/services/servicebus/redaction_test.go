@@ -0,0 +1,30 @@
+package servicebus
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestRedactionRules(t *testing.T) {
+	testCases := []common.RedactionTestCase{
+		{
+			Name:       "list keys",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ServiceBus/namespaces/example/authorizationRules/RootManageSharedAccessKey/listKeys",
+			Body:       `{"aliasPrimaryConnectionString":"Endpoint=sb://alias/;SharedAccessKey=YWxpYXM=","aliasSecondaryConnectionString":"Endpoint=sb://alias/;SharedAccessKey=c2Vjb25kYXJ5","keyName":"RootManageSharedAccessKey"}`,
+			Redacted:   []string{`YWxpYXM=`, `c2Vjb25kYXJ5`},
+			Unredacted: []string{`"keyName":"RootManageSharedAccessKey"`},
+		},
+		{
+			Name:       "regenerate keys",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.ServiceBus/namespaces/example/queues/example/authorizationRules/example/regenerateKeys",
+			Body:       `{"keyType":"PrimaryKey","key":"bmV3LWtleQ=="}`,
+			Redacted:   []string{`bmV3LWtleQ==`},
+			Unredacted: []string{`"keyType":"PrimaryKey"`},
+		},
+	}
+
+	if err := common.VerifyRedactionRules(Registration{}.RedactionRules(), testCases); err != nil {
+		t.Fatal(err)
+	}
+}
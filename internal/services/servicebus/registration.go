@@ -1,6 +1,9 @@
 package servicebus
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -18,6 +21,24 @@ func (r Registration) Name() string {
 	return "ServiceBus"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ServiceBus",
+	}
+}
+
+// RedactionRules returns the rules used to redact sensitive values from the requests and responses for this Service
+func (r Registration) RedactionRules() common.RedactionRules {
+	return common.RedactionRules{
+		{
+			// the Keys for a ServiceBus Authorization Rule
+			URLPattern: regexp.MustCompile(`(?i)/providers/Microsoft.ServiceBus/namespaces/.+/authorizationRules/[^/]+/(listKeys|regenerateKeys)$`),
+			JSONPaths:  []string{"aliasPrimaryConnectionString", "aliasSecondaryConnectionString", "key"},
+		},
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -104,7 +104,7 @@ func resourceServiceBusNamespaceAuthorizationRuleCreateUpdate(d *pluginsdk.Resou
 	d.SetId(id.ID())
 
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
-	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("waiting for replication to complete for %s: %+v", id, err)
 	}
 
@@ -174,7 +174,7 @@ func resourceServiceBusNamespaceAuthorizationRuleDelete(d *pluginsdk.ResourceDat
 	}
 
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
-	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("waiting for replication to complete for %s: %+v", *id, err)
 	}
 
@@ -99,7 +99,7 @@ func resourceServiceBusQueueAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceD
 
 	d.SetId(id.ID())
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
-	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("waiting for replication to complete for %s: %+v", id, err)
 	}
 
@@ -170,7 +170,7 @@ func resourceServiceBusQueueAuthorizationRuleDelete(d *pluginsdk.ResourceData, m
 	}
 
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
-	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+	if err := waitForPairedNamespaceReplication(ctx, meta, namespaceId, timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("waiting for replication to complete for %s: %+v", *id, err)
 	}
 
@@ -26,3 +26,10 @@ func (r Registration) Resources() []sdk.Resource {
 func (r Registration) Name() string {
 	return "ServiceConnector"
 }
+
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.ServiceLinker",
+	}
+}
@@ -64,7 +64,7 @@ type VaultCertificates struct {
 
 type VmSecrets struct {
 	SourceVault  string              `tfschema:"vault_id"`
-	Certificates []VaultCertificates `tfschema:"certificate"`
+	Certificates []VaultCertificates `tfschema:"certificates"`
 }
 
 type NodeType struct {
@@ -153,7 +153,7 @@ func (k ClusterResource) Arguments() map[string]*pluginsdk.Schema {
 				validation.StringLenBetween(1, 15),
 				validation.StringMatch(regexp.MustCompile("^[^\\\\/\"\\[\\]:|<>+=;,?*$]{1,14}$"), "User names cannot contain special characters \\/\"\"[]:|<>+=;,$?*@")),
 		},
-		"password": {
+		"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:      pluginsdk.TypeString,
 			Optional:  true,
 			Sensitive: true,
@@ -161,7 +161,7 @@ func (k ClusterResource) Arguments() map[string]*pluginsdk.Schema {
 				validation.StringLenBetween(8, 123),
 				validation.StringIsNotWhiteSpace,
 			),
-		},
+		}),
 		"resource_group_name": commonschema.ResourceGroupName(),
 
 		"node_type":      nodeTypeSchema(),
@@ -18,11 +18,11 @@ func SchemaConfigServerHttpBasicAuth(conflictsWith ...string) *pluginsdk.Schema
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 
-				"password": {
+				"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:      pluginsdk.TypeString,
 					Required:  true,
 					Sensitive: true,
-				},
+				}),
 			},
 		},
 	}
@@ -39,19 +39,19 @@ func SchemaConfigServerSSHAuth(conflictsWith ...string) *pluginsdk.Schema {
 		MaxItems: 1,
 		Elem: &pluginsdk.Resource{
 			Schema: map[string]*pluginsdk.Schema{
-				"private_key": {
+				"private_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					Required:     true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringIsNotEmpty,
-				},
+				}),
 
-				"host_key": {
+				"host_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					Optional:     true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringIsNotEmpty,
-				},
+				}),
 
 				"host_key_algorithm": {
 					Type:     pluginsdk.TypeString,
@@ -679,12 +679,12 @@ func resourceSqlDatabaseSchema() map[string]*pluginsdk.Schema {
 						}, false),
 					},
 
-					"storage_account_access_key": {
+					"storage_account_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-					},
+					}),
 
 					"storage_endpoint": {
 						Type:         pluginsdk.TypeString,
@@ -76,12 +76,12 @@ func resourceArmSqlMiServer() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"administrator_login_password": {
+			"administrator_login_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"vcores": {
 				Type:     schema.TypeInt,
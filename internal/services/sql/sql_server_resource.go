@@ -72,11 +72,11 @@ func resourceSqlServer() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
-			"administrator_login_password": {
+			"administrator_login_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Required:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"connection_policy": {
 				Type:     pluginsdk.TypeString,
@@ -152,12 +152,12 @@ func resourceSqlServer() *pluginsdk.Resource {
 							}, false),
 						},
 
-						"storage_account_access_key": {
+						"storage_account_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: validation.StringIsNotEmpty,
-						},
+						}),
 
 						"storage_endpoint": {
 							Type:         pluginsdk.TypeString,
@@ -0,0 +1,30 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestRedactionRules(t *testing.T) {
+	testCases := []common.RedactionTestCase{
+		{
+			Name:       "list keys",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys",
+			Body:       `{"keys":[{"keyName":"key1","value":"a2V5MQ==","permissions":"FULL"},{"keyName":"key2","value":"a2V5Mg==","permissions":"FULL"}]}`,
+			Redacted:   []string{`a2V5MQ==`, `a2V5Mg==`},
+			Unredacted: []string{`"keyName":"key1"`},
+		},
+		{
+			Name:       "account sas",
+			URL:        "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/ListAccountSas",
+			Body:       `{"accountSasToken":"sv=2021-06-08&ss=b&sig=c2lnbmF0dXJl"}`,
+			Redacted:   []string{`c2lnbmF0dXJl`},
+			Unredacted: nil,
+		},
+	}
+
+	if err := common.VerifyRedactionRules(Registration{}.RedactionRules(), testCases); err != nil {
+		t.Fatal(err)
+	}
+}
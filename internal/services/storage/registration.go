@@ -1,6 +1,9 @@
 package storage
 
 import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -18,6 +21,29 @@ func (r Registration) Name() string {
 	return "Storage"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Storage",
+	}
+}
+
+// RedactionRules returns the rules used to redact sensitive values from the requests and responses for this Service
+func (r Registration) RedactionRules() common.RedactionRules {
+	return common.RedactionRules{
+		{
+			// the Access Keys for a Storage Account
+			URLPattern: regexp.MustCompile(`(?i)/providers/Microsoft.Storage/storageAccounts/[^/]+/(listKeys|regenerateKey)$`),
+			JSONPaths:  []string{"keys.value"},
+		},
+		{
+			// the Shared Access Signatures generated for a Storage Account
+			URLPattern: regexp.MustCompile(`(?i)/providers/Microsoft.Storage/storageAccounts/[^/]+/(listAccountSas|listServiceSas)$`),
+			JSONPaths:  []string{"accountSasToken", "serviceSasToken"},
+		},
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
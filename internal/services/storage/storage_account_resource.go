@@ -26,7 +26,7 @@ import (
 	keyvault "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/locking"
 	vnetParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	resource "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
@@ -2260,7 +2260,7 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	// the networking api's only allow a single change to be made to a network layout at once, so let's lock to handle that
-	virtualNetworkNames := make([]string, 0)
+	virtualNetworkIds := make([]vnetParse.VirtualNetworkId, 0)
 	if props := read.AccountProperties; props != nil {
 		if rules := props.NetworkRuleSet; rules != nil {
 			if vnr := rules.VirtualNetworkRules; vnr != nil {
@@ -2274,20 +2274,17 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 						return err2
 					}
 
-					networkName := id.VirtualNetworkName
-					for _, virtualNetworkName := range virtualNetworkNames {
-						if networkName == virtualNetworkName {
-							continue
-						}
-					}
-					virtualNetworkNames = append(virtualNetworkNames, networkName)
+					virtualNetworkIds = append(virtualNetworkIds, locking.VirtualNetworkIdForSubnet(*id))
 				}
 			}
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	networkLocks := locking.New(meta)
+	if err := networkLocks.LockVirtualNetworks(ctx, virtualNetworkIds...); err != nil {
+		return err
+	}
+	defer networkLocks.UnlockVirtualNetworks(virtualNetworkIds...)
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
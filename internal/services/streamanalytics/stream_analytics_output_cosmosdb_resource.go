@@ -51,12 +51,12 @@ func (r OutputCosmosDBResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: streamingjobs.ValidateStreamingJobID,
 		},
 
-		"cosmosdb_account_key": {
+		"cosmosdb_account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 
 		"cosmosdb_sql_database_id": {
 			Type:         pluginsdk.TypeString,
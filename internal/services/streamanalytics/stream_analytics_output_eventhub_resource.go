@@ -70,12 +70,12 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"shared_access_policy_key": {
+			"shared_access_policy_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
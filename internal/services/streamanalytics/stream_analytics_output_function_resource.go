@@ -64,12 +64,12 @@ func (r OutputFunctionResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 
-		"api_key": {
+		"api_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 
 		"batch_max_in_bytes": {
 			Type:     pluginsdk.TypeInt,
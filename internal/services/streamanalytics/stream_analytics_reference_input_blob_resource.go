@@ -70,12 +70,12 @@ func resourceStreamAnalyticsReferenceInputBlob() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
-			"storage_account_key": {
+			"storage_account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"storage_account_name": {
 				Type:         pluginsdk.TypeString,
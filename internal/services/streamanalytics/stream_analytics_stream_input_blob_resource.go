@@ -69,12 +69,12 @@ func resourceStreamAnalyticsStreamInputBlob() *pluginsdk.Resource {
 				Required: true,
 			},
 
-			"storage_account_key": {
+			"storage_account_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"storage_account_name": {
 				Type:         pluginsdk.TypeString,
@@ -77,12 +77,12 @@ func (r StreamInputEventHubV2Resource) Arguments() map[string]*pluginsdk.Schema
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 
-		"shared_access_policy_key": {
+		"shared_access_policy_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ValidateFunc: validation.StringIsNotEmpty,
-		},
+		}),
 
 		"shared_access_policy_name": {
 			Type:         pluginsdk.TypeString,
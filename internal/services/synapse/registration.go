@@ -18,6 +18,16 @@ func (r Registration) Name() string {
 	return "Synapse"
 }
 
+// ResourceProviders returns the Resource Providers which must be registered to use this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		// required for the Linked Services to Data Lake Analytics and Data Lake Store (Gen1)
+		"Microsoft.DataLakeAnalytics",
+		"Microsoft.DataLakeStore",
+		"Microsoft.Synapse",
+	}
+}
+
 // WebsiteCategories returns a list of categories which can be used for the sidebar
 func (r Registration) WebsiteCategories() []string {
 	return []string{
@@ -77,11 +77,11 @@ func resourceSynapseWorkspace() *pluginsdk.Resource {
 				ValidateFunc: validate.SqlAdministratorLoginName,
 			},
 
-			"sql_administrator_login_password": {
+			"sql_administrator_login_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:      pluginsdk.TypeString,
 				Optional:  true,
 				Sensitive: true,
-			},
+			}),
 
 			"linking_allowed_for_aad_tenant_ids": {
 				Type:     pluginsdk.TypeList,
@@ -79,12 +79,12 @@ func resourceSynapseWorkspaceVulnerabilityAssessment() *pluginsdk.Resource {
 				},
 			},
 
-			"storage_account_access_key": {
+			"storage_account_access_key": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"storage_container_sas_key": {
 				Type:         pluginsdk.TypeString,
@@ -105,21 +105,21 @@ func resourceVmwarePrivateCloud() *pluginsdk.Resource {
 				Default:  false,
 			},
 
-			"nsxt_password": {
+			"nsxt_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
-			"vcenter_password": {
+			"vcenter_password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-			},
+			}),
 
 			"circuit": {
 				Type:     pluginsdk.TypeList,
@@ -217,21 +217,21 @@ func resourceAppServiceCertificateSchema() map[string]*pluginsdk.Schema {
 
 		"resource_group_name": commonschema.ResourceGroupName(),
 
-		"pfx_blob": {
+		"pfx_blob": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ForceNew:     true,
 			ValidateFunc: validation.StringIsBase64,
-		},
+		}),
 
-		"password": {
+		"password": pluginsdk.WriteOnly(&pluginsdk.Schema{
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Sensitive:    true,
 			ForceNew:     true,
 			ValidateFunc: validation.NoZeroValues,
-		},
+		}),
 
 		"key_vault_secret_id": {
 			Type:          pluginsdk.TypeString,
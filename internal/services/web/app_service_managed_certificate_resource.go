@@ -163,7 +163,7 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 		Pending:    []string{"NotFound", "Unknown"},
 		Target:     []string{"Success"},
 		MinTimeout: 1 * time.Minute,
-		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+		Timeout:    timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate),
 		Refresh: func() (interface{}, string, error) {
 			resp, err := client.Get(ctx, id.ResourceGroup, id.CertificateName)
 			if err != nil {
@@ -180,7 +180,7 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *pluginsdk.ResourceData,
 	}
 
 	if !d.IsNewResource() {
-		certificateWait.Timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+		certificateWait.Timeout = timeouts.DurationFor(ctx, d, pluginsdk.TimeoutUpdate)
 	}
 
 	if _, err := certificateWait.WaitForStateContext(ctx); err != nil {
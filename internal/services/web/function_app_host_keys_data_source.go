@@ -91,7 +91,7 @@ func dataSourceFunctionAppHostKeysRead(d *pluginsdk.ResourceData, meta interface
 
 	d.SetId(id.ID())
 
-	return pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
+	return pluginsdk.Retry(timeouts.DurationFor(ctx, d, pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
 		res, err := client.ListHostKeys(ctx, id.ResourceGroup, id.SiteName)
 		if err != nil {
 			if utils.ResponseWasNotFound(res.Response) {
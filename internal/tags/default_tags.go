@@ -0,0 +1,41 @@
+package tags
+
+import (
+	"strings"
+)
+
+// MergeDefaultTags returns the Default Tags defined in the Provider block overridden by the tags defined on the resource
+func MergeDefaultTags(defaultTags map[string]string, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range tagsMap {
+		// tag keys are case-insensitive in Azure, so the tag on the resource replaces the default
+		for existing := range output {
+			if strings.EqualFold(existing, k) {
+				delete(output, existing)
+			}
+		}
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveDefaultTags removes any Default Tags from the tags returned from Azure, so that these aren't shown as
+// a diff on the resource - unless the tag is defined on the resource, or has been overridden with a different value
+func RemoveDefaultTags(defaultTags map[string]string, tagsMap map[string]interface{}, configuredTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range tagsMap {
+		if defaultValue, ok := defaultTags[k]; ok && defaultValue == v {
+			if _, configured := configuredTags[k]; !configured {
+				continue
+			}
+		}
+		output[k] = v
+	}
+
+	return output
+}
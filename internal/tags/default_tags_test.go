@@ -0,0 +1,119 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Defaults map[string]string
+		Input    map[string]interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Name:     "No Default Tags",
+			Defaults: map[string]string{},
+			Input: map[string]interface{}{
+				"hello": "world",
+			},
+			Expected: map[string]interface{}{
+				"hello": "world",
+			},
+		},
+		{
+			Name: "Default Tags Merged",
+			Defaults: map[string]string{
+				"cost-center": "1234",
+			},
+			Input: map[string]interface{}{
+				"hello": "world",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"hello":       "world",
+			},
+		},
+		{
+			Name: "Default Tag Overridden",
+			Defaults: map[string]string{
+				"cost-center": "1234",
+				"owner":       "platform",
+			},
+			Input: map[string]interface{}{
+				"Cost-Center": "5678",
+			},
+			Expected: map[string]interface{}{
+				"Cost-Center": "5678",
+				"owner":       "platform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := MergeDefaultTags(v.Defaults, v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Input      map[string]interface{}
+		Configured map[string]interface{}
+		Expected   map[string]interface{}
+	}{
+		{
+			Name: "Default Tags Removed",
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+				"hello":       "world",
+			},
+			Configured: map[string]interface{}{
+				"hello": "world",
+			},
+			Expected: map[string]interface{}{
+				"hello": "world",
+			},
+		},
+		{
+			Name: "Default Tag Overridden with a different value",
+			Input: map[string]interface{}{
+				"cost-center": "5678",
+			},
+			Configured: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"cost-center": "5678",
+			},
+		},
+		{
+			Name: "Default Tag Overridden with the same value",
+			Input: map[string]interface{}{
+				"cost-center": "1234",
+			},
+			Configured: map[string]interface{}{
+				"cost-center": "1234",
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+			},
+		},
+	}
+
+	defaults := map[string]string{
+		"cost-center": "1234",
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := RemoveDefaultTags(defaults, v.Input, v.Configured)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package tags
+
+import (
+	"strings"
+)
+
+// IgnoredTags are the tag keys and key prefixes defined in the `ignore_tags` block within the Provider, which
+// are removed from the tags returned from Azure for each resource - and retained when the resource is updated
+type IgnoredTags struct {
+	keys        []string
+	keyPrefixes []string
+}
+
+// NewIgnoredTags returns the IgnoredTags for the specified tag keys and key prefixes
+func NewIgnoredTags(keys []string, keyPrefixes []string) IgnoredTags {
+	output := IgnoredTags{
+		keys:        make([]string, 0),
+		keyPrefixes: make([]string, 0),
+	}
+
+	for _, v := range keys {
+		if v != "" {
+			output.keys = append(output.keys, strings.ToLower(v))
+		}
+	}
+
+	for _, v := range keyPrefixes {
+		if v != "" {
+			output.keyPrefixes = append(output.keyPrefixes, strings.ToLower(v))
+		}
+	}
+
+	return output
+}
+
+// IsIgnored returns whether the specified tag key should be ignored, as it matches (case-insensitively)
+// either one of the keys or key prefixes defined in the `ignore_tags` block within the Provider
+func (t IgnoredTags) IsIgnored(key string) bool {
+	key = strings.ToLower(key)
+	for _, v := range t.keys {
+		if key == v {
+			return true
+		}
+	}
+
+	for _, v := range t.keyPrefixes {
+		if strings.HasPrefix(key, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoveIgnoredTags returns the tags which aren't ignored - an ignored tag is only removed when it isn't defined
+// (case-insensitively) within `configured` (the tags defined on the resource), since removing a tag defined
+// on the resource would otherwise show a diff
+func (t IgnoredTags) RemoveIgnoredTags(tagsMap map[string]interface{}, configured map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range tagsMap {
+		if !t.IsIgnored(k) || containsKey(configured, k) {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// MergeIgnoredTags returns the tags defined on the resource, alongside the ignored tags from `existing` (the tags
+// currently assigned to the resource) - such that the ignored tags aren't removed when the resource is updated
+func (t IgnoredTags) MergeIgnoredTags(tagsMap map[string]interface{}, existing map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+	for k, v := range tagsMap {
+		output[k] = v
+	}
+
+	for k, v := range existing {
+		if !t.IsIgnored(k) {
+			continue
+		}
+
+		// tag keys are case-insensitive in Azure, so a tag defined on the resource takes precedence
+		if !containsKey(output, k) {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// containsKey returns whether the tag key is defined (case-insensitively) within tagsMap
+func containsKey(tagsMap map[string]interface{}, key string) bool {
+	for k := range tagsMap {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	ignored := NewIgnoredTags([]string{"CreatedOnDate"}, []string{"hidden-"})
+
+	input := map[string]interface{}{
+		"createdondate":  "2023-01-01",
+		"Hidden-Owner":   "defender",
+		"hidden":         "not-a-prefix-match",
+		"environment":    "production",
+		"notHidden-Key":  "value",
+		"CreatedOnDate2": "value",
+	}
+
+	expected := map[string]interface{}{
+		"hidden":         "not-a-prefix-match",
+		"environment":    "production",
+		"notHidden-Key":  "value",
+		"CreatedOnDate2": "value",
+	}
+	if actual := ignored.RemoveIgnoredTags(input, nil); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	// an ignored tag which is defined on the resource is retained
+	configured := map[string]interface{}{
+		"hidden-owner": "defender",
+	}
+	expected["Hidden-Owner"] = "defender"
+	if actual := ignored.RemoveIgnoredTags(input, configured); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestMergeIgnoredTags(t *testing.T) {
+	ignored := NewIgnoredTags([]string{"CreatedOnDate"}, []string{"hidden-"})
+
+	input := map[string]interface{}{
+		"environment":  "staging",
+		"Hidden-Owner": "someone",
+	}
+	existing := map[string]interface{}{
+		"createdondate": "2023-01-01",
+		"hidden-owner":  "defender",
+		"environment":   "production",
+		"removed":       "value",
+	}
+
+	expected := map[string]interface{}{
+		"createdondate": "2023-01-01",
+		"environment":   "staging",
+		"Hidden-Owner":  "someone",
+	}
+	if actual := ignored.MergeIgnoredTags(input, existing); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestIsIgnored(t *testing.T) {
+	ignored := NewIgnoredTags([]string{"Key"}, []string{"prefix-", ""})
+
+	testData := map[string]bool{
+		"key":          true,
+		"KEY":          true,
+		"key2":         false,
+		"prefix-hello": true,
+		"PREFIX-hello": true,
+		"prefix":       false,
+		"hello":        false,
+	}
+	for input, expected := range testData {
+		if actual := ignored.IsIgnored(input); actual != expected {
+			t.Fatalf("Expected IsIgnored(%q) to be %t but got %t", input, expected, actual)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -24,15 +25,81 @@ func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.Resourc
 // ImporterValidatingResourceIdThen validates the ID provided at import time is valid
 // using the validateFunc then runs the 'thenFunc', allowing the import to be customised.
 func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
+	importer := validatingImporter{
+		validateFunc: validateFunc,
+		thenFunc:     thenFunc,
+	}
+	return &schema.ResourceImporter{
+		StateContext: importer.StateContext,
+	}
+}
+
+// ImporterWrapping returns a copy of the Importer where the import is wrapped by `wrapFunc` (e.g. to customise the
+// meta used to import the resource) - retaining the IDValidationFunc for Importers built using ImporterValidatingResourceId
+func ImporterWrapping(importer *schema.ResourceImporter, wrapFunc func(in ImporterFunc) ImporterFunc) *schema.ResourceImporter {
+	if existing, ok := validatingImporterFrom(importer); ok {
+		return ImporterValidatingResourceIdThen(existing.validateFunc, wrapFunc(existing.thenFunc))
+	}
+
 	return &schema.ResourceImporter{
-		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
-			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
+		StateContext: wrapFunc(importer.StateContext),
+	}
+}
+
+// ImporterIDValidationFunc returns the IDValidationFunc used by an Importer built using ImporterValidatingResourceId
+// (or ImporterValidatingResourceIdThen) - allowing tooling to determine whether a Resource ID is valid for a Resource
+func ImporterIDValidationFunc(importer *schema.ResourceImporter) (IDValidationFunc, bool) {
+	v, ok := validatingImporterFrom(importer)
+	if !ok {
+		return nil, false
+	}
+
+	return v.validateFunc, true
+}
 
-			if err := validateFunc(d.Id()); err != nil {
-				return []*ResourceData{d}, fmt.Errorf("parsing Resource ID %q: %+v", d.Id(), err)
-			}
+// validatingImporter is the Importer built by ImporterValidatingResourceIdThen, which holds the IDValidationFunc
+type validatingImporter struct {
+	validateFunc IDValidationFunc
+	thenFunc     ImporterFunc
+}
+
+// validatingImporterQueryKey is the context key used to retrieve the validatingImporter for an Importer, which is
+// only used when the Importer is known to be a validatingImporter (see validatingImporterFrom)
+type validatingImporterQueryKey struct{}
 
-			return thenFunc(ctx, d, meta)
-		},
+func (i validatingImporter) StateContext(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+	if query, ok := ctx.Value(validatingImporterQueryKey{}).(*validatingImporter); ok {
+		*query = i
+		return nil, nil
 	}
+
+	log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
+
+	if err := i.validateFunc(d.Id()); err != nil {
+		return []*ResourceData{d}, fmt.Errorf("parsing Resource ID %q: %+v", d.Id(), err)
+	}
+
+	return i.thenFunc(ctx, d, meta)
+}
+
+// validatingImporterFrom returns the validatingImporter for an Importer built using ImporterValidatingResourceIdThen.
+//
+// Since the ResourceImporter can't be extended, the StateContext function is compared to the method of the
+// validatingImporter - and only then called (without importing anything) to retrieve the validatingImporter, such
+// that other Importers are never called.
+func validatingImporterFrom(importer *schema.ResourceImporter) (*validatingImporter, bool) {
+	if importer == nil || importer.StateContext == nil {
+		return nil, false
+	}
+	if reflect.ValueOf(importer.StateContext).Pointer() != reflect.ValueOf(validatingImporter{}.StateContext).Pointer() {
+		return nil, false
+	}
+
+	var result validatingImporter
+	ctx := context.WithValue(context.Background(), validatingImporterQueryKey{}, &result)
+	if _, err := importer.StateContext(ctx, nil, nil); err != nil || result.validateFunc == nil {
+		return nil, false
+	}
+
+	return &result, true
 }
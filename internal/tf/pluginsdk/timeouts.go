@@ -18,4 +18,7 @@ const (
 	TimeoutUpdate  = schema.TimeoutUpdate
 	TimeoutDelete  = schema.TimeoutDelete
 	TimeoutDefault = schema.TimeoutDefault
+
+	// TimeoutsConfigKey is the name of the `timeouts` block within the Configuration for a Resource
+	TimeoutsConfigKey = schema.TimeoutsConfigKey
 )
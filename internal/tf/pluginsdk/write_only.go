@@ -0,0 +1,66 @@
+package pluginsdk
+
+import (
+	"sort"
+	"strings"
+)
+
+// writeOnlyDescription is appended to the Description of a field marked as Write-Only - since the Schema can't be
+// extended this is also used to determine whether the field is Write-Only, which means this is retained when the
+// Schema is copied
+const writeOnlyDescription = "This field is Write-Only - the value is sent to the API but isn't returned."
+
+// WriteOnly marks the field as Write-Only - meaning that it's sent to the API but isn't returned
+// (for example, a password) and so can't be set into the state during Read. These fields are
+// automatically ignored when verifying an import during the acceptance tests.
+func WriteOnly(input *Schema) *Schema {
+	if IsWriteOnly(input) {
+		return input
+	}
+
+	if input.Description == "" {
+		input.Description = writeOnlyDescription
+	} else {
+		input.Description = strings.TrimSuffix(input.Description, " ") + " " + writeOnlyDescription
+	}
+	return input
+}
+
+// IsWriteOnly returns whether the field has been marked as Write-Only via WriteOnly
+func IsWriteOnly(input *Schema) bool {
+	return input != nil && strings.HasSuffix(input.Description, writeOnlyDescription)
+}
+
+// WriteOnlyAttributes returns the (sorted) paths to the fields marked as Write-Only within the schema, in the
+// same format as the keys within the state (e.g. `admin_password` or `os_profile.0.admin_password`).
+//
+// Since the index of an item within a Set (or a List containing more than one item) isn't known, a `*` is used
+// in place of the index for Write-Only fields nested within these (e.g. `extension.*.protected_settings`).
+func WriteOnlyAttributes(input map[string]*Schema) []string {
+	paths := make([]string, 0)
+	writeOnlyAttributes("", input, &paths)
+
+	sort.Strings(paths)
+	return paths
+}
+
+func writeOnlyAttributes(prefix string, input map[string]*Schema, paths *[]string) {
+	for key, field := range input {
+		path := prefix + key
+		if IsWriteOnly(field) {
+			*paths = append(*paths, path)
+			continue
+		}
+
+		block, ok := field.Elem.(*Resource)
+		if !ok || (field.Type != TypeList && field.Type != TypeSet) {
+			continue
+		}
+
+		index := "*"
+		if field.Type == TypeList && field.MaxItems == 1 {
+			index = "0"
+		}
+		writeOnlyAttributes(path+"."+index+".", block.Schema, paths)
+	}
+}
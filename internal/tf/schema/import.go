@@ -1,11 +1,8 @@
 package schema
 
 import (
-	"context"
-	"fmt"
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 // ResourceIDValidator takes a Resource ID and confirms that it's Valid
@@ -22,15 +19,5 @@ func ValidateResourceIDPriorToImport(idParser ResourceIDValidator) *schema.Resou
 // valid for this Resource prior to calling the importer - allowing for incorrect
 // Resource ID's to be caught prior to Import and subsequent crashes
 func ValidateResourceIDPriorToImportThen(idParser ResourceIDValidator, importer schema.StateContextFunc) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
-		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
-
-			if err := idParser(d.Id()); err != nil {
-				return []*schema.ResourceData{d}, fmt.Errorf("parsing Resource ID %q: %+v", d.Id(), err)
-			}
-
-			return importer(ctx, d, meta)
-		},
-	}
+	return pluginsdk.ImporterValidatingResourceIdThen(pluginsdk.IDValidationFunc(idParser), importer)
 }
@@ -0,0 +1,128 @@
+package timeouts
+
+import (
+	"context"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// OperationTimeouts are the Default Timeouts for each operation on a Resource, where nil means that
+// the timeout defined by the Resource should be used
+type OperationTimeouts struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+func (t OperationTimeouts) forKey(key string) *time.Duration {
+	switch key {
+	case pluginsdk.TimeoutCreate:
+		return t.Create
+	case pluginsdk.TimeoutRead:
+		return t.Read
+	case pluginsdk.TimeoutUpdate:
+		return t.Update
+	case pluginsdk.TimeoutDelete:
+		return t.Delete
+	}
+
+	return nil
+}
+
+// DefaultTimeouts are the Default Timeouts defined in the Provider block, keyed by a glob pattern matching the
+// Resource Type (e.g. `azurerm_kubernetes_*`) - which override the timeouts defined by the Resource, when these
+// aren't defined within the `timeouts` block for the resource.
+type DefaultTimeouts map[string]OperationTimeouts
+
+// For returns the Default Timeout for the operation on the Resource Type (if any) - where more than one
+// pattern matches the Resource Type the most specific pattern is used, that is an exact match or otherwise
+// the longest pattern.
+func (t DefaultTimeouts) For(resourceType, key string) *time.Duration {
+	patterns := make([]string, 0)
+	for pattern := range t {
+		if matched, _ := path.Match(pattern, resourceType); matched {
+			patterns = append(patterns, pattern)
+		}
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		if (patterns[i] == resourceType) != (patterns[j] == resourceType) {
+			return patterns[i] == resourceType
+		}
+		if len(patterns[i]) != len(patterns[j]) {
+			return len(patterns[i]) > len(patterns[j])
+		}
+		return patterns[i] < patterns[j]
+	})
+
+	for _, pattern := range patterns {
+		if v := t[pattern].forKey(key); v != nil {
+			return v
+		}
+	}
+
+	return nil
+}
+
+type defaultTimeoutsContextKey struct{}
+
+type resourceDefaultTimeouts struct {
+	resourceType string
+	defaults     DefaultTimeouts
+}
+
+// WithDefaultTimeouts returns a context containing the Resource Type being operated on and the Default Timeouts
+// for the configured Provider - which are used to determine the timeout for each operation.
+func WithDefaultTimeouts(ctx context.Context, resourceType string, defaults DefaultTimeouts) context.Context {
+	return context.WithValue(ctx, defaultTimeoutsContextKey{}, resourceDefaultTimeouts{
+		resourceType: resourceType,
+		defaults:     defaults,
+	})
+}
+
+// DurationFor returns the timeout for the operation on the resource - which is the Default Timeout for the
+// Resource Type when one is defined in the Provider block and the timeout isn't defined in the `timeouts`
+// block for the resource, otherwise the timeout for the resource.
+//
+// This should be used rather than `d.Timeout`, which only returns the timeout for the resource.
+func DurationFor(ctx context.Context, d *pluginsdk.ResourceData, key string) time.Duration {
+	timeout := d.Timeout(key)
+
+	v, ok := ctx.Value(defaultTimeoutsContextKey{}).(resourceDefaultTimeouts)
+	if !ok || timeoutDefinedInConfig(d, key) {
+		return timeout
+	}
+
+	if defaultTimeout := v.defaults.For(v.resourceType, key); defaultTimeout != nil {
+		return *defaultTimeout
+	}
+
+	return timeout
+}
+
+// timeoutDefinedInConfig returns whether the timeout for the operation is defined within the `timeouts` block
+// for the resource - which is available from the Config/Plan during a Create or Update, and otherwise the State
+func timeoutDefinedInConfig(d *pluginsdk.ResourceData, key string) bool {
+	for _, raw := range []cty.Value{d.GetRawConfig(), d.GetRawPlan(), d.GetRawState()} {
+		if raw.IsNull() || !raw.IsKnown() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(pluginsdk.TimeoutsConfigKey) {
+			continue
+		}
+
+		block := raw.GetAttr(pluginsdk.TimeoutsConfigKey)
+		if block.IsNull() || !block.IsKnown() {
+			continue
+		}
+
+		if !block.Type().IsObjectType() || !block.Type().HasAttribute(key) {
+			return false
+		}
+		value := block.GetAttr(key)
+		return !value.IsNull()
+	}
+
+	return false
+}
@@ -0,0 +1,150 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func duration(input time.Duration) *time.Duration {
+	return &input
+}
+
+func TestDefaultTimeoutsFor(t *testing.T) {
+	defaults := DefaultTimeouts{
+		"azurerm_*": {
+			Delete: duration(1 * time.Hour),
+		},
+		"azurerm_kubernetes_*": {
+			Create: duration(90 * time.Minute),
+			Update: duration(90 * time.Minute),
+		},
+		"azurerm_kubernetes_cluster_*": {
+			Create: duration(2 * time.Hour),
+		},
+		"azurerm_kubernetes_cluster_node_pool": {
+			Create: duration(3 * time.Hour),
+		},
+	}
+
+	testData := []struct {
+		resourceType string
+		key          string
+		expected     *time.Duration
+	}{
+		{
+			resourceType: "azurerm_kubernetes_cluster",
+			key:          pluginsdk.TimeoutCreate,
+			expected:     duration(90 * time.Minute),
+		},
+		{
+			// the most specific pattern is used
+			resourceType: "azurerm_kubernetes_cluster_extension",
+			key:          pluginsdk.TimeoutCreate,
+			expected:     duration(2 * time.Hour),
+		},
+		{
+			// an exact match takes precedence
+			resourceType: "azurerm_kubernetes_cluster_node_pool",
+			key:          pluginsdk.TimeoutCreate,
+			expected:     duration(3 * time.Hour),
+		},
+		{
+			// falling back to a less specific pattern defining the timeout for the operation
+			resourceType: "azurerm_kubernetes_cluster_node_pool",
+			key:          pluginsdk.TimeoutUpdate,
+			expected:     duration(90 * time.Minute),
+		},
+		{
+			resourceType: "azurerm_kubernetes_cluster_node_pool",
+			key:          pluginsdk.TimeoutDelete,
+			expected:     duration(1 * time.Hour),
+		},
+		{
+			resourceType: "azurerm_resource_group",
+			key:          pluginsdk.TimeoutRead,
+			expected:     nil,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q (%s)", v.resourceType, v.key)
+
+		actual := defaults.For(v.resourceType, v.key)
+		if (actual == nil) != (v.expected == nil) || (actual != nil && *actual != *v.expected) {
+			t.Fatalf("expected %v but got %v", v.expected, actual)
+		}
+	}
+}
+
+func TestBuildWithTimeoutUsesDefaultTimeouts(t *testing.T) {
+	defaults := DefaultTimeouts{
+		"azurerm_example_*": {
+			Create: duration(3 * time.Hour),
+			Delete: duration(3 * time.Hour),
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+
+	// the `timeouts` block for this resource defines the Create timeout, but not the Delete timeout
+	state := &terraform.InstanceState{
+		ID: "example",
+		RawState: cty.ObjectVal(map[string]cty.Value{
+			"id":   cty.StringVal("example"),
+			"name": cty.StringVal("example"),
+			"timeouts": cty.ObjectVal(map[string]cty.Value{
+				"create": cty.StringVal("10m"),
+				"delete": cty.NullVal(cty.String),
+			}),
+		}),
+	}
+	d := resource.Data(state)
+
+	assertDeadline := func(ctx context.Context, expected time.Duration) {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			t.Fatalf("expected the context to have a deadline")
+		}
+		if remaining := time.Until(deadline); remaining > expected || remaining < expected-time.Minute {
+			t.Fatalf("expected a timeout of %s but got %s", expected, remaining)
+		}
+	}
+
+	ctx, cancel := ForDelete(WithDefaultTimeouts(context.TODO(), "azurerm_example_resource", defaults), d)
+	defer cancel()
+	assertDeadline(ctx, 3*time.Hour)
+	if actual := DurationFor(ctx, d, pluginsdk.TimeoutDelete); actual != 3*time.Hour {
+		t.Fatalf("expected the Delete timeout to be 3h but got %s", actual)
+	}
+
+	// since the Create timeout is defined for the resource, the Default Timeout isn't used
+	ctx, cancel = ForCreate(WithDefaultTimeouts(context.TODO(), "azurerm_example_resource", defaults), d)
+	defer cancel()
+	assertDeadline(ctx, d.Timeout(pluginsdk.TimeoutCreate))
+
+	// the Default Timeouts for another Provider (e.g. an aliased Provider) don't apply
+	ctx, cancel = ForDelete(WithDefaultTimeouts(context.TODO(), "azurerm_example_resource", DefaultTimeouts{}), d)
+	defer cancel()
+	assertDeadline(ctx, d.Timeout(pluginsdk.TimeoutDelete))
+
+	// the Default Timeouts only apply when the Resource Type is known
+	ctx, cancel = ForDelete(context.TODO(), d)
+	defer cancel()
+	assertDeadline(ctx, d.Timeout(pluginsdk.TimeoutDelete))
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+const resourcesApiVersion = "2021-04-01"
+
+// armResource is an existing resource within Resource Manager
+type armResource struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Location string `json:"location"`
+}
+
+type armResourceList struct {
+	Value    []armResource `json:"value"`
+	NextLink string        `json:"nextLink"`
+}
+
+type resourceManagerClient struct {
+	authorizer auth.Authorizer
+	endpoint   string
+	httpClient *http.Client
+}
+
+// newResourceManagerClient builds a client for Resource Manager, authenticating using a Service Principal with a
+// Client Secret (when `ARM_CLIENT_ID`, `ARM_CLIENT_SECRET` and `ARM_TENANT_ID` are set) or the Azure CLI
+func newResourceManagerClient(ctx context.Context, environmentName string) (*resourceManagerClient, error) {
+	environment, err := environments.FromName(environmentName)
+	if err != nil {
+		return nil, fmt.Errorf("finding the Azure Environment %q: %+v", environmentName, err)
+	}
+
+	endpoint, ok := environment.ResourceManager.Endpoint()
+	if !ok {
+		return nil, fmt.Errorf("the Resource Manager endpoint isn't defined for the Azure Environment %q", environmentName)
+	}
+
+	credentials := auth.Credentials{
+		Environment:                           *environment,
+		ClientID:                              os.Getenv("ARM_CLIENT_ID"),
+		ClientSecret:                          os.Getenv("ARM_CLIENT_SECRET"),
+		TenantID:                              os.Getenv("ARM_TENANT_ID"),
+		EnableAuthenticatingUsingClientSecret: true,
+		EnableAuthenticatingUsingAzureCLI:     true,
+	}
+	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, credentials, environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building the authorizer: %+v", err)
+	}
+
+	return &resourceManagerClient{
+		authorizer: authorizer,
+		endpoint:   strings.TrimSuffix(*endpoint, "/"),
+		httpClient: http.DefaultClient,
+	}, nil
+}
+
+// listResources lists the Resource Groups and the resources within either the Subscription or (when
+// specified) the Resource Group
+func (c *resourceManagerClient) listResources(ctx context.Context, subscriptionId, resourceGroupName string) ([]armResource, error) {
+	scope := fmt.Sprintf("/subscriptions/%s", subscriptionId)
+	resourceGroups := make([]armResource, 0)
+	if resourceGroupName != "" {
+		scope = fmt.Sprintf("%s/resourceGroups/%s", scope, resourceGroupName)
+
+		var resourceGroup armResource
+		if err := c.get(ctx, c.endpoint+scope, &resourceGroup); err != nil {
+			return nil, fmt.Errorf("retrieving Resource Group %q: %+v", resourceGroupName, err)
+		}
+		resourceGroups = append(resourceGroups, resourceGroup)
+	} else {
+		groups, err := c.list(ctx, fmt.Sprintf("%s/resourcegroups", scope))
+		if err != nil {
+			return nil, fmt.Errorf("listing Resource Groups: %+v", err)
+		}
+		resourceGroups = append(resourceGroups, groups...)
+	}
+
+	resources, err := c.list(ctx, fmt.Sprintf("%s/resources", scope))
+	if err != nil {
+		return nil, fmt.Errorf("listing resources within %q: %+v", scope, err)
+	}
+
+	return append(resourceGroups, resources...), nil
+}
+
+func (c *resourceManagerClient) list(ctx context.Context, path string) ([]armResource, error) {
+	output := make([]armResource, 0)
+
+	nextLink := c.endpoint + path
+	for nextLink != "" {
+		var page armResourceList
+		if err := c.get(ctx, nextLink, &page); err != nil {
+			return nil, err
+		}
+		output = append(output, page.Value...)
+		nextLink = page.NextLink
+	}
+
+	return output, nil
+}
+
+func (c *resourceManagerClient) get(ctx context.Context, uri string, output interface{}) error {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", uri, err)
+	}
+	// the nextLink already contains the API Version
+	if u.Query().Get("api-version") == "" {
+		query := u.Query()
+		query.Set("api-version", resourcesApiVersion)
+		u.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+	token, err := c.authorizer.Token(ctx, req)
+	if err != nil {
+		return fmt.Errorf("obtaining access token: %+v", err)
+	}
+	token.SetAuthHeader(req)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request to %q: %+v", u.Path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response from %q: %+v", u.Path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %q: %s", resp.StatusCode, u.Path, string(body))
+	}
+
+	if err := json.Unmarshal(body, output); err != nil {
+		return fmt.Errorf("unmarshaling response from %q: %+v", u.Path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ImportBlockGenerator struct {
+	Result        mappingResult
+	Schemas       map[string]map[string]*pluginsdk.Schema
+	IncludeConfig bool
+}
+
+// Code returns the `import` blocks (and, when IncludeConfig is set, a skeleton `resource` block) for each of
+// the mapped resources - with the ambiguous and unmapped resources listed as comments
+func (g ImportBlockGenerator) Code() string {
+	blocks := make([]string, 0)
+
+	for _, v := range g.Result.Mapped {
+		blocks = append(blocks, fmt.Sprintf(`import {
+  to = %[1]s.%[2]s
+  id = %[3]s
+}
+`, v.ResourceType, v.Label, strconv.Quote(v.Resource.ID)))
+
+		if g.IncludeConfig {
+			blocks = append(blocks, g.skeletonFor(v))
+		}
+	}
+
+	if len(g.Result.Ambiguous) > 0 {
+		lines := []string{
+			"# The following resources could be imported as more than one Resource Type:",
+		}
+		for _, v := range g.Result.Ambiguous {
+			lines = append(lines, fmt.Sprintf("# - %s (%s): %s", v.Resource.ID, v.Resource.Type, strings.Join(v.Candidates, ", ")))
+		}
+		blocks = append(blocks, strings.Join(lines, "\n")+"\n")
+	}
+
+	if len(g.Result.Unmapped) > 0 {
+		lines := []string{
+			"# The following resources couldn't be mapped to a Resource Type:",
+		}
+		for _, v := range g.Result.Unmapped {
+			lines = append(lines, fmt.Sprintf("# - %s (%s)", v.ID, v.Type))
+		}
+		blocks = append(blocks, strings.Join(lines, "\n")+"\n")
+	}
+
+	return strings.Join(blocks, "\n")
+}
+
+// skeletonFor returns a `resource` block containing the `name`, `resource_group_name` and `location` of the
+// resource (where these are available) - with the remaining Required arguments listed as comments
+func (g ImportBlockGenerator) skeletonFor(input mappedResource) string {
+	resourceSchema := g.Schemas[input.ResourceType]
+
+	known := map[string]string{
+		"name":                input.Resource.Name,
+		"location":            input.Resource.Location,
+		"resource_group_name": resourceGroupNameFromId(input.Resource.ID),
+	}
+
+	fieldNames := make([]string, 0)
+	for k := range resourceSchema {
+		fieldNames = append(fieldNames, k)
+	}
+	sort.Strings(fieldNames)
+
+	lines := make([]string, 0)
+	required := make([]string, 0)
+	for _, fieldName := range fieldNames {
+		field := resourceSchema[fieldName]
+		if !field.Required && !field.Optional {
+			continue
+		}
+
+		if v := known[fieldName]; v != "" {
+			lines = append(lines, fmt.Sprintf("  %s = %s", fieldName, strconv.Quote(v)))
+			continue
+		}
+
+		if field.Required {
+			required = append(required, fmt.Sprintf("  # %s = (Required)", fieldName))
+		}
+	}
+	lines = append(lines, required...)
+
+	return fmt.Sprintf(`resource %q %q {
+%s
+}
+`, input.ResourceType, input.Label, strings.Join(lines, "\n"))
+}
+
+func resourceGroupNameFromId(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "resourceGroups") {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+func main() {
+	subscriptionId := flag.String("subscription-id", os.Getenv("ARM_SUBSCRIPTION_ID"), "The ID of the Subscription containing the existing resources, defaults to `ARM_SUBSCRIPTION_ID`")
+	resourceGroupName := flag.String("resource-group", "", "(Optional) The name of the Resource Group containing the existing resources, otherwise all resources within the Subscription are generated")
+	environment := flag.String("environment", envOrDefault("ARM_ENVIRONMENT", "public"), "The Azure Environment which should be used, defaults to `ARM_ENVIRONMENT` or `public`")
+	outputPath := flag.String("output", "", "(Optional) The path to the file which the generated configuration should be written to, otherwise it's written to stdout")
+	skeleton := flag.Bool("skeleton", false, "Whether a skeleton `resource` block should be generated for each resource alongside the `import` block")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if err := run(context.Background(), *subscriptionId, *resourceGroupName, *environment, *outputPath, *skeleton); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, subscriptionId, resourceGroupName, environment, outputPath string, skeleton bool) error {
+	if subscriptionId == "" {
+		return fmt.Errorf("`-subscription-id` must be specified")
+	}
+
+	client, err := newResourceManagerClient(ctx, environment)
+	if err != nil {
+		return fmt.Errorf("building the Resource Manager client: %+v", err)
+	}
+
+	existing, err := client.listResources(ctx, subscriptionId, resourceGroupName)
+	if err != nil {
+		return fmt.Errorf("listing the existing resources: %+v", err)
+	}
+
+	mapper := newResourceMapper(provider.AzureProvider().ResourcesMap)
+	result := mapper.mapResources(existing)
+
+	generator := ImportBlockGenerator{
+		Result:        result,
+		Schemas:       mapper.schemas,
+		IncludeConfig: skeleton,
+	}
+
+	output := generator.Code()
+	if outputPath == "" {
+		fmt.Print(output)
+	} else if err := os.WriteFile(outputPath, []byte(output), 0o644); err != nil {
+		return fmt.Errorf("writing the generated configuration to %q: %+v", outputPath, err)
+	}
+
+	// the resources which couldn't be mapped are output separately, since they need to be reviewed
+	for _, v := range result.Unmapped {
+		fmt.Fprintf(os.Stderr, "Unmapped: %s (%s)\n", v.ID, v.Type)
+	}
+	for _, v := range result.Ambiguous {
+		fmt.Fprintf(os.Stderr, "Ambiguous: %s (%s) could be any of: %s\n", v.Resource.ID, v.Resource.Type, strings.Join(v.Candidates, ", "))
+	}
+	fmt.Fprintf(os.Stderr, "Generated %d import blocks - %d resources were ambiguous and %d resources couldn't be mapped\n", len(result.Mapped), len(result.Ambiguous), len(result.Unmapped))
+
+	return nil
+}
+
+func envOrDefault(name, defaultValue string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return defaultValue
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"golang.org/x/oauth2"
+)
+
+func validatorMatching(pattern string) pluginsdk.IDValidationFunc {
+	regex := regexp.MustCompile(pattern)
+	return func(id string) error {
+		if !regex.MatchString(id) {
+			return fmt.Errorf("%q didn't match %q", id, pattern)
+		}
+		return nil
+	}
+}
+
+var testResources = map[string]*pluginsdk.Resource{
+	"azurerm_resource_group": {
+		Importer: pluginsdk.ImporterValidatingResourceId(validatorMatching(`^/subscriptions/[^/]+/resourceGroups/[^/]+$`)),
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+		},
+	},
+	"azurerm_storage_account": {
+		Importer: pluginsdk.ImporterValidatingResourceId(validatorMatching(`^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft.Storage/storageAccounts/[^/]+$`)),
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+			"resource_group_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+			"location": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+			"account_tier": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+			"primary_access_key": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	},
+	"azurerm_storage_account_network_rules": {
+		Importer: pluginsdk.ImporterValidatingResourceId(validatorMatching(`^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft.Storage/storageAccounts/[^/]+$`)),
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+		},
+	},
+	"azurerm_linux_virtual_machine": {
+		Importer: pluginsdk.ImporterValidatingResourceId(validatorMatching(`/providers/Microsoft.Compute/virtualMachines/[^/]+$`)),
+	},
+	"azurerm_windows_virtual_machine": {
+		Importer: pluginsdk.ImporterValidatingResourceId(validatorMatching(`/providers/Microsoft.Compute/virtualMachines/[^/]+$`)),
+	},
+	"azurerm_generic": {
+		Importer: pluginsdk.ImporterValidatingResourceId(validatorMatching(`^/subscriptions/`)),
+	},
+	"azurerm_deprecated_storage_account": {
+		DeprecationMessage: "superseded by `azurerm_storage_account`",
+		Importer:           pluginsdk.ImporterValidatingResourceId(validatorMatching(`/providers/Microsoft.Storage/storageAccounts/[^/]+$`)),
+	},
+	"azurerm_passthrough": {
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	},
+}
+
+var testExistingResources = []armResource{
+	{
+		ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+		Name:     "account1",
+		Type:     "Microsoft.Storage/storageAccounts",
+		Location: "westeurope",
+	},
+	{
+		ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		Name:     "group1",
+		Type:     "Microsoft.Resources/resourceGroups",
+		Location: "westeurope",
+	},
+	{
+		ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Group-1",
+		Name:     "Group-1",
+		Type:     "Microsoft.Resources/resourceGroups",
+		Location: "westeurope",
+	},
+	{
+		ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group_1",
+		Name:     "group_1",
+		Type:     "Microsoft.Resources/resourceGroups",
+		Location: "westeurope",
+	},
+	{
+		ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+		Name: "vm1",
+		Type: "Microsoft.Compute/virtualMachines",
+	},
+	{
+		ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/widgets/widget1",
+		Name: "widget1",
+		Type: "Microsoft.Example/widgets",
+	},
+}
+
+func TestResourceMapper(t *testing.T) {
+	mapper := newResourceMapper(testResources)
+
+	for _, excluded := range []string{"azurerm_generic", "azurerm_deprecated_storage_account", "azurerm_passthrough"} {
+		if _, ok := mapper.schemas[excluded]; ok {
+			t.Fatalf("expected %q to be excluded from the mapping", excluded)
+		}
+	}
+
+	result := mapper.mapResources(testExistingResources)
+
+	expectedMapped := map[string]string{
+		"azurerm_resource_group.group_1":   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Group-1",
+		"azurerm_resource_group.group1":    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		"azurerm_resource_group.group_1_2": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group_1",
+		"azurerm_storage_account.account1": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+	}
+	if len(result.Mapped) != len(expectedMapped) {
+		t.Fatalf("expected %d mapped resources but got %d: %+v", len(expectedMapped), len(result.Mapped), result.Mapped)
+	}
+	for _, v := range result.Mapped {
+		address := fmt.Sprintf("%s.%s", v.ResourceType, v.Label)
+		if expectedMapped[address] != v.Resource.ID {
+			t.Fatalf("expected %q to map to %q but got %q", address, expectedMapped[address], v.Resource.ID)
+		}
+	}
+
+	if len(result.Ambiguous) != 1 || strings.Join(result.Ambiguous[0].Candidates, ",") != "azurerm_linux_virtual_machine,azurerm_windows_virtual_machine" {
+		t.Fatalf("expected the Virtual Machine to be ambiguous but got %+v", result.Ambiguous)
+	}
+
+	if len(result.Unmapped) != 1 || result.Unmapped[0].Name != "widget1" {
+		t.Fatalf("expected the widget to be unmapped but got %+v", result.Unmapped)
+	}
+}
+
+func TestImportBlockGenerator(t *testing.T) {
+	mapper := newResourceMapper(testResources)
+	generator := ImportBlockGenerator{
+		Result:        mapper.mapResources(testExistingResources[0:1]),
+		Schemas:       mapper.schemas,
+		IncludeConfig: true,
+	}
+
+	expected := `import {
+  to = azurerm_storage_account.account1
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1"
+}
+
+resource "azurerm_storage_account" "account1" {
+  location = "westeurope"
+  name = "account1"
+  resource_group_name = "group1"
+  # account_tier = (Required)
+}
+`
+	if actual := generator.Code(); actual != expected {
+		t.Fatalf("expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+
+	generator = ImportBlockGenerator{
+		Result: mapper.mapResources(testExistingResources[4:]),
+	}
+	actual := generator.Code()
+	for _, v := range []string{
+		"# - /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1 (Microsoft.Compute/virtualMachines): azurerm_linux_virtual_machine, azurerm_windows_virtual_machine",
+		"# - /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/widgets/widget1 (Microsoft.Example/widgets)",
+	} {
+		if !strings.Contains(actual, v) {
+			t.Fatalf("expected the output to contain %q but got:\n%s", v, actual)
+		}
+	}
+	if strings.Contains(actual, "import {") {
+		t.Fatalf("expected no import blocks but got:\n%s", actual)
+	}
+}
+
+type testAuthorizer struct{}
+
+func (testAuthorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: "example", TokenType: "Bearer"}, nil
+}
+
+func (testAuthorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+func TestListResources(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer example" || r.URL.Query().Get("api-version") != resourcesApiVersion {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/subscriptions/sub1/resourcegroups":
+			fmt.Fprint(w, `{"value": [{"id": "/subscriptions/sub1/resourceGroups/group1", "name": "group1"}]}`)
+		case r.URL.Path == "/subscriptions/sub1/resources" && r.URL.Query().Get("$skiptoken") == "":
+			fmt.Fprintf(w, `{"value": [{"id": "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Example/widgets/widget1"}], "nextLink": "%s/subscriptions/sub1/resources?api-version=%s&$skiptoken=page2"}`, server.URL, resourcesApiVersion)
+		case r.URL.Path == "/subscriptions/sub1/resources":
+			fmt.Fprint(w, `{"value": [{"id": "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Example/widgets/widget2"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := resourceManagerClient{
+		authorizer: testAuthorizer{},
+		endpoint:   server.URL,
+		httpClient: server.Client(),
+	}
+
+	resources, err := client.listResources(context.TODO(), "sub1", "")
+	if err != nil {
+		t.Fatalf("listing resources: %+v", err)
+	}
+	if len(resources) != 3 || !strings.HasSuffix(resources[2].ID, "/widget2") {
+		t.Fatalf("expected the resource group and both pages of resources but got %+v", resources)
+	}
+
+	if _, err := client.listResources(context.TODO(), "sub1", "group2"); err == nil {
+		t.Fatalf("expected an error retrieving a Resource Group which doesn't exist")
+	}
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// unknownResourceId is a Resource ID which shouldn't be valid for any Resource - the validation functions which
+// accept it are generic (e.g. only checking that the value is a Resource ID) and so can't be used for mapping
+const unknownResourceId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Unknown/unknownResources/resource1"
+
+var invalidLabelCharactersRegex = regexp.MustCompile(`[^a-z0-9_]+`)
+
+type resourceMatcher struct {
+	resourceType string
+	validate     pluginsdk.IDValidationFunc
+}
+
+type resourceMapper struct {
+	matchers []resourceMatcher
+	schemas  map[string]map[string]*pluginsdk.Schema
+}
+
+type mappedResource struct {
+	Resource     armResource
+	ResourceType string
+	Label        string
+}
+
+type ambiguousResource struct {
+	Resource   armResource
+	Candidates []string
+}
+
+type mappingResult struct {
+	Mapped    []mappedResource
+	Ambiguous []ambiguousResource
+	Unmapped  []armResource
+}
+
+// newResourceMapper builds a resourceMapper from the Resource ID validation functions used when importing each
+// Resource - Resources which are deprecated, or whose Resource ID can't be validated, are excluded
+func newResourceMapper(resources map[string]*pluginsdk.Resource) resourceMapper {
+	mapper := resourceMapper{
+		matchers: make([]resourceMatcher, 0),
+		schemas:  make(map[string]map[string]*pluginsdk.Schema),
+	}
+
+	for resourceType, resource := range resources {
+		if resource.DeprecationMessage != "" {
+			continue
+		}
+
+		validate, ok := pluginsdk.ImporterIDValidationFunc(resource.Importer)
+		if !ok || accepts(validate, unknownResourceId) {
+			continue
+		}
+
+		mapper.matchers = append(mapper.matchers, resourceMatcher{
+			resourceType: resourceType,
+			validate:     validate,
+		})
+		mapper.schemas[resourceType] = resource.Schema
+	}
+
+	sort.Slice(mapper.matchers, func(i, j int) bool {
+		return mapper.matchers[i].resourceType < mapper.matchers[j].resourceType
+	})
+
+	return mapper
+}
+
+// candidatesFor returns the Resource Types whose Resource ID validation accepts the specified Resource ID
+func (m resourceMapper) candidatesFor(id string) []string {
+	candidates := make([]string, 0)
+	for _, matcher := range m.matchers {
+		if accepts(matcher.validate, id) {
+			candidates = append(candidates, matcher.resourceType)
+		}
+	}
+	return candidates
+}
+
+// preferCandidatesManagingName filters the candidates to those with a `name` argument - since some Resources
+// manage a part of another resource and so share the same Resource ID (for example the Customer Managed Key
+// for a Storage Account), where the resource itself is the one which defines the `name`
+func (m resourceMapper) preferCandidatesManagingName(candidates []string) []string {
+	if len(candidates) <= 1 {
+		return candidates
+	}
+
+	filtered := make([]string, 0)
+	for _, candidate := range candidates {
+		if field, ok := m.schemas[candidate]["name"]; ok && (field.Required || field.Optional) {
+			filtered = append(filtered, candidate)
+		}
+	}
+	if len(filtered) == 0 {
+		return candidates
+	}
+
+	return filtered
+}
+
+// mapResources maps each of the existing resources to the Resource Type which can import it
+func (m resourceMapper) mapResources(input []armResource) mappingResult {
+	resources := make([]armResource, len(input))
+	copy(resources, input)
+	sort.Slice(resources, func(i, j int) bool {
+		return strings.ToLower(resources[i].ID) < strings.ToLower(resources[j].ID)
+	})
+
+	result := mappingResult{
+		Mapped:    make([]mappedResource, 0),
+		Ambiguous: make([]ambiguousResource, 0),
+		Unmapped:  make([]armResource, 0),
+	}
+	labels := make(map[string]struct{})
+
+	for _, resource := range resources {
+		candidates := m.preferCandidatesManagingName(m.candidatesFor(resource.ID))
+		switch len(candidates) {
+		case 0:
+			result.Unmapped = append(result.Unmapped, resource)
+		case 1:
+			result.Mapped = append(result.Mapped, mappedResource{
+				Resource:     resource,
+				ResourceType: candidates[0],
+				Label:        uniqueLabel(candidates[0], resource.Name, labels),
+			})
+		default:
+			result.Ambiguous = append(result.Ambiguous, ambiguousResource{
+				Resource:   resource,
+				Candidates: candidates,
+			})
+		}
+	}
+
+	return result
+}
+
+// accepts returns whether the validation function accepts the Resource ID - since this is run for every
+// Resource ID against every Resource any panic is treated as the Resource ID being invalid
+func accepts(validate pluginsdk.IDValidationFunc, id string) (valid bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("[DEBUG] validating %q: %+v", id, r)
+			valid = false
+		}
+	}()
+
+	return validate(id) == nil
+}
+
+// uniqueLabel returns a label for the resource which is a valid Terraform identifier, and which is unique
+// for the Resource Type
+func uniqueLabel(resourceType, name string, existing map[string]struct{}) string {
+	label := strings.Trim(invalidLabelCharactersRegex.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		label = "example"
+	}
+	if c := label[0]; c < 'a' || c > 'z' {
+		label = "r_" + label
+	}
+
+	candidate := label
+	for i := 2; ; i++ {
+		key := fmt.Sprintf("%s.%s", resourceType, candidate)
+		if _, ok := existing[key]; !ok {
+			existing[key] = struct{}{}
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s_%d", label, i)
+	}
+}
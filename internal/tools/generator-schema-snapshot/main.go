@@ -1,6 +1,8 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -8,6 +10,7 @@ import (
 	. "github.com/dave/jennifer/jen"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 )
 
 const (
@@ -15,15 +18,32 @@ const (
 )
 
 func main() {
-	if len(os.Args) != 2 {
-		log.Fatal("Usage: generator-schema-snapshot <reource_type>")
+	outputJSON := flag.Bool("json", false, "output the schema snapshot as JSON, for use with the generator-state-upgrade tool")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		log.Fatal("Usage: generator-schema-snapshot [-json] <resource_type>")
 	}
-	rt := os.Args[1]
+	rt := flag.Arg(0)
 	res, ok := provider.AzureProvider().ResourcesMap[rt]
 	if !ok {
 		log.Fatalf("unknown resource type %q", rt)
 	}
 
+	if *outputJSON {
+		snapshot, err := providerjson.ResourceFromRaw(res)
+		if err != nil {
+			log.Fatalf("converting the schema for %q: %+v", rt, err)
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(snapshot); err != nil {
+			log.Fatalf("encoding the schema for %q: %+v", rt, err)
+		}
+		return
+	}
+
 	f := NewFile("main")
 	f.ImportName("github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk", "")
 
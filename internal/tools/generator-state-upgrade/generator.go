@@ -0,0 +1,393 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+type StateUpgradeGenerator struct {
+	PackageName string
+	TypeName    string
+	OldSchema   map[string]providerjson.SchemaJSON
+	Mapping     Mapping
+}
+
+func (g StateUpgradeGenerator) Code() (*string, error) {
+	schemaCode, err := codeForSchema(g.OldSchema)
+	if err != nil {
+		return nil, fmt.Errorf("generating the schema: %+v", err)
+	}
+
+	imports := []string{
+		`"context"`,
+	}
+	if g.Mapping.ID != nil {
+		imports = append(imports, `"log"`, "")
+		qualifier := strings.Split(g.Mapping.ID.ParseFunc, ".")[0]
+		if qualifier == path.Base(g.Mapping.ID.Import) {
+			imports = append(imports, strconv.Quote(g.Mapping.ID.Import))
+		} else {
+			imports = append(imports, fmt.Sprintf("%s %q", qualifier, g.Mapping.ID.Import))
+		}
+	} else {
+		imports = append(imports, "")
+	}
+	imports = append(imports, `"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"`)
+
+	code := fmt.Sprintf(`package %[1]s
+
+import (
+	%[2]s
+)
+
+var _ pluginsdk.StateUpgrade = %[3]s{}
+
+type %[3]s struct{}
+
+func (%[3]s) Schema() map[string]*pluginsdk.Schema {
+	return %[4]s
+}
+
+func (%[3]s) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+%[5]s
+		return rawState, nil
+	}
+}
+`, g.PackageName, strings.Join(imports, "\n"), g.TypeName, schemaCode, g.codeForUpgradeFunc())
+
+	return formatCode(code)
+}
+
+func (g StateUpgradeGenerator) codeForUpgradeFunc() string {
+	output := make([]string, 0)
+
+	if id := g.Mapping.ID; id != nil {
+		output = append(output, fmt.Sprintf(`// old:
+// 	%[1]s
+// new:
+// 	%[2]s
+oldIdRaw := rawState["id"].(string)
+id, err := %[3]s(oldIdRaw)
+if err != nil {
+	return rawState, err
+}
+
+newId := id.ID()
+
+log.Printf("[DEBUG] Updating ID from %%q to %%q", oldIdRaw, newId)
+rawState["id"] = newId
+`, id.OldExample, id.NewExample, id.ParseFunc))
+	}
+
+	if ops := newOperations(g.Mapping); !ops.isEmpty() {
+		output = append(output, ops.code("rawState"))
+	}
+
+	return strings.Join(output, "\n")
+}
+
+func (g StateUpgradeGenerator) TestCode() (*string, error) {
+	oldPaths := schemaPaths(g.OldSchema, "")
+
+	input := make(map[string]interface{})
+	expected := make(map[string]interface{})
+	if id := g.Mapping.ID; id != nil {
+		input["id"] = id.OldExample
+		expected["id"] = id.NewExample
+	}
+
+	for oldPath, newPath := range g.Mapping.Renames {
+		value := sampleValue(oldPaths[oldPath])
+		blockWithinState(input, parentPath(oldPath))[fieldName(oldPath)] = value
+		blockWithinState(expected, parentPath(newPath))[fieldName(newPath)] = value
+	}
+
+	for _, removed := range g.Mapping.Removals {
+		blockWithinState(input, parentPath(removed))[fieldName(removed)] = sampleValue(oldPaths[removed])
+		blockWithinState(expected, parentPath(removed))
+	}
+
+	code := fmt.Sprintf(`package %[1]s
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func Test%[2]s(t *testing.T) {
+	input := %[3]s
+	expected := %[4]s
+
+	actual, err := %[2]s{}.UpgradeFunc()(context.TODO(), input, nil)
+	if err != nil {
+		t.Fatalf("upgrading the state: %%+v", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %%+v but got %%+v", expected, actual)
+	}
+}
+`, g.PackageName, g.TypeName, literalForValue(input), literalForValue(expected))
+
+	return formatCode(code)
+}
+
+// operations are the renames and removals which should be applied to a block within the state
+type operations struct {
+	renames  map[string]string
+	removals []string
+	blocks   map[string]*operations
+}
+
+func newOperations(mapping Mapping) *operations {
+	root := &operations{}
+	for oldPath, newPath := range mapping.Renames {
+		block := root.block(parentPath(oldPath))
+		if block.renames == nil {
+			block.renames = make(map[string]string)
+		}
+		block.renames[fieldName(oldPath)] = fieldName(newPath)
+	}
+
+	for _, removed := range mapping.Removals {
+		block := root.block(parentPath(removed))
+		block.removals = append(block.removals, fieldName(removed))
+	}
+
+	return root
+}
+
+func (o *operations) block(path string) *operations {
+	if path == "" {
+		return o
+	}
+
+	current := o
+	for _, name := range strings.Split(path, ".") {
+		if current.blocks == nil {
+			current.blocks = make(map[string]*operations)
+		}
+		if _, ok := current.blocks[name]; !ok {
+			current.blocks[name] = &operations{}
+		}
+		current = current.blocks[name]
+	}
+
+	return current
+}
+
+func (o *operations) isEmpty() bool {
+	return len(o.renames) == 0 && len(o.removals) == 0 && len(o.blocks) == 0
+}
+
+// code returns the code to apply these operations to the block within the variable `variableName` - nested blocks
+// are updated prior to any renames, so that these are referenced using the old name
+func (o *operations) code(variableName string) string {
+	output := make([]string, 0)
+
+	for _, name := range sortedKeys(o.blocks) {
+		output = append(output, fmt.Sprintf(`if items, ok := %[1]s[%[2]q].([]interface{}); ok {
+	for _, item := range items {
+		raw, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+%[3]s
+	}
+}
+`, variableName, name, o.blocks[name].code("raw")))
+	}
+
+	for _, oldName := range sortedKeys(o.renames) {
+		newName := o.renames[oldName]
+		output = append(output, fmt.Sprintf("// `%[2]s` has been renamed to `%[3]s`\n"+`if v, ok := %[1]s[%[2]q]; ok {
+	%[1]s[%[3]q] = v
+	delete(%[1]s, %[2]q)
+}
+`, variableName, oldName, newName))
+	}
+
+	removals := append([]string{}, o.removals...)
+	sort.Strings(removals)
+	for _, removed := range removals {
+		output = append(output, fmt.Sprintf("// `%[2]s` has been removed\ndelete(%[1]s, %[2]q)\n", variableName, removed))
+	}
+
+	return strings.Join(output, "\n")
+}
+
+func codeForSchema(input map[string]providerjson.SchemaJSON) (string, error) {
+	output := make([]string, 0)
+	for _, name := range sortedKeys(input) {
+		code, err := codeForSchemaField(input[name])
+		if err != nil {
+			return "", fmt.Errorf("field %q: %+v", name, err)
+		}
+
+		output = append(output, fmt.Sprintf("%q: %s,\n", name, code))
+	}
+
+	return fmt.Sprintf("map[string]*pluginsdk.Schema{\n%s}", strings.Join(output, "\n")), nil
+}
+
+func codeForSchemaField(input providerjson.SchemaJSON) (string, error) {
+	fieldType, err := codeForSchemaType(input.Type)
+	if err != nil {
+		return "", err
+	}
+
+	output := []string{
+		fmt.Sprintf("Type: %s,", fieldType),
+	}
+
+	if input.Required {
+		output = append(output, "Required: true,")
+	}
+	if input.Optional {
+		output = append(output, "Optional: true,")
+	}
+	if input.Computed {
+		output = append(output, "Computed: true,")
+	}
+	if input.ForceNew {
+		output = append(output, "ForceNew: true,")
+	}
+
+	switch input.ConfigMode {
+	case "Attribute":
+		output = append(output, "ConfigMode: pluginsdk.SchemaConfigModeAttr,")
+	case "Block":
+		output = append(output, "ConfigMode: pluginsdk.SchemaConfigModeBlock,")
+	}
+
+	if input.MaxItems > 0 {
+		output = append(output, fmt.Sprintf("MaxItems: %d,", input.MaxItems))
+	}
+	if input.MinItems > 0 {
+		output = append(output, fmt.Sprintf("MinItems: %d,", input.MinItems))
+	}
+
+	if block, ok := nestedBlock(input); ok {
+		nested, err := codeForSchema(block)
+		if err != nil {
+			return "", err
+		}
+		output = append(output, fmt.Sprintf("Elem: &pluginsdk.Resource{\nSchema: %s,\n},", nested))
+	} else if elemType, ok := input.Elem.(string); ok {
+		nestedType, err := codeForSchemaType(elemType)
+		if err != nil {
+			return "", err
+		}
+		output = append(output, fmt.Sprintf("Elem: &pluginsdk.Schema{\nType: %s,\n},", nestedType))
+	}
+
+	return fmt.Sprintf("{\n%s\n}", strings.Join(output, "\n")), nil
+}
+
+func codeForSchemaType(input string) (string, error) {
+	switch input {
+	case "TypeBool", "TypeFloat", "TypeInt", "TypeList", "TypeMap", "TypeSet", "TypeString":
+		return fmt.Sprintf("pluginsdk.%s", input), nil
+	}
+
+	return "", fmt.Errorf("unsupported schema type %q", input)
+}
+
+// blockWithinState returns the first item of the nested block at the specified path within the state, creating it if necessary
+func blockWithinState(state map[string]interface{}, path string) map[string]interface{} {
+	if path == "" {
+		return state
+	}
+
+	current := state
+	for _, name := range strings.Split(path, ".") {
+		items, ok := current[name].([]interface{})
+		if !ok || len(items) == 0 {
+			items = []interface{}{
+				map[string]interface{}{},
+			}
+			current[name] = items
+		}
+		current = items[0].(map[string]interface{})
+	}
+
+	return current
+}
+
+func sampleValue(input providerjson.SchemaJSON) interface{} {
+	if _, ok := nestedBlock(input); ok {
+		return []interface{}{
+			map[string]interface{}{},
+		}
+	}
+
+	switch input.Type {
+	case "TypeBool":
+		return true
+	case "TypeFloat":
+		return 1.5
+	case "TypeInt":
+		return 1
+	case "TypeList", "TypeSet":
+		return []interface{}{
+			"value",
+		}
+	case "TypeMap":
+		return map[string]interface{}{
+			"key": "value",
+		}
+	}
+
+	return "value"
+}
+
+func literalForValue(input interface{}) string {
+	switch v := input.(type) {
+	case []interface{}:
+		items := make([]string, 0)
+		for _, item := range v {
+			items = append(items, fmt.Sprintf("%s,\n", literalForValue(item)))
+		}
+		return fmt.Sprintf("[]interface{}{\n%s}", strings.Join(items, ""))
+
+	case map[string]interface{}:
+		items := make([]string, 0)
+		for _, k := range sortedKeys(v) {
+			items = append(items, fmt.Sprintf("%q: %s,\n", k, literalForValue(v[k])))
+		}
+		return fmt.Sprintf("map[string]interface{}{\n%s}", strings.Join(items, ""))
+
+	case string:
+		return strconv.Quote(v)
+	}
+
+	return fmt.Sprintf("%v", input)
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatCode(input string) (*string, error) {
+	formatted, err := format.Source([]byte(input))
+	if err != nil {
+		return nil, fmt.Errorf("formatting the generated code: %+v\n\n%s", err, input)
+	}
+
+	output := string(formatted)
+	return &output, nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	servicePackagePath := flag.String("path", "", "The relative path to the service package")
+	name := flag.String("name", "", "The name of this Resource Type, e.g. `Component`")
+	fromVersion := flag.Int("from-version", 0, "The Schema Version of the old schema snapshot")
+	oldSnapshot := flag.String("old", "", "The path to the JSON schema snapshot for the previous Schema Version")
+	newSnapshot := flag.String("new", "", "The path to the JSON schema snapshot for the current Schema Version")
+	mappingFile := flag.String("mapping", "", "The path to the JSON file describing the renamed/removed fields and how to rewrite the Resource ID")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if err := run(*servicePackagePath, *name, *fromVersion, *oldSnapshot, *newSnapshot, *mappingFile); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(servicePackagePath, name string, fromVersion int, oldSnapshotPath, newSnapshotPath, mappingPath string) error {
+	if servicePackagePath == "" || name == "" || oldSnapshotPath == "" || newSnapshotPath == "" {
+		return fmt.Errorf("`-path`, `-name`, `-old` and `-new` must be specified")
+	}
+
+	oldSnapshot, err := loadSnapshot(oldSnapshotPath)
+	if err != nil {
+		return fmt.Errorf("loading the old schema snapshot from %q: %+v", oldSnapshotPath, err)
+	}
+
+	newSnapshot, err := loadSnapshot(newSnapshotPath)
+	if err != nil {
+		return fmt.Errorf("loading the new schema snapshot from %q: %+v", newSnapshotPath, err)
+	}
+
+	mapping, err := loadMapping(mappingPath)
+	if err != nil {
+		return fmt.Errorf("loading the mapping from %q: %+v", mappingPath, err)
+	}
+
+	if err := mapping.validate(oldSnapshot.Schema, newSnapshot.Schema); err != nil {
+		return fmt.Errorf("validating the mapping: %+v", err)
+	}
+
+	migrationPath := path.Join(servicePackagePath, "migration")
+	if err := os.Mkdir(migrationPath, 0o755); err != nil && !os.IsExist(err) {
+		return fmt.Errorf("creating migration directory at %q: %+v", migrationPath, err)
+	}
+
+	generator := StateUpgradeGenerator{
+		PackageName: "migration",
+		TypeName:    fmt.Sprintf("%sV%dToV%d", name, fromVersion, fromVersion+1),
+		OldSchema:   oldSnapshot.Schema,
+		Mapping:     *mapping,
+	}
+
+	fileName := fmt.Sprintf("%s_v%d_to_v%d", convertToSnakeCase(name), fromVersion, fromVersion+1)
+
+	code, err := generator.Code()
+	if err != nil {
+		return fmt.Errorf("generating the State Upgrader: %+v", err)
+	}
+	upgraderFilePath := path.Join(migrationPath, fmt.Sprintf("%s.go", fileName))
+	if err := os.WriteFile(upgraderFilePath, []byte(*code), 0o644); err != nil {
+		return fmt.Errorf("writing the State Upgrader to %q: %+v", upgraderFilePath, err)
+	}
+
+	testCode, err := generator.TestCode()
+	if err != nil {
+		return fmt.Errorf("generating the State Upgrader Tests: %+v", err)
+	}
+	testFilePath := path.Join(migrationPath, fmt.Sprintf("%s_test.go", fileName))
+	if err := os.WriteFile(testFilePath, []byte(*testCode), 0o644); err != nil {
+		return fmt.Errorf("writing the State Upgrader Tests to %q: %+v", testFilePath, err)
+	}
+
+	log.Printf("generated %q and %q - register the State Upgrader within the resource using:\n\n%s", upgraderFilePath, testFilePath, registrationExample(generator.TypeName, fromVersion))
+
+	return nil
+}
+
+func registrationExample(typeName string, fromVersion int) string {
+	return fmt.Sprintf(`// Typed Resources
+func (r ExampleResource) StateUpgraders() sdk.StateUpgradeData {
+	return sdk.StateUpgradeData{
+		SchemaVersion: %[2]d,
+		Upgraders: map[int]pluginsdk.StateUpgrade{
+			%[3]d: migration.%[1]s{},
+		},
+	}
+}
+
+// Untyped Resources
+SchemaVersion: %[2]d,
+StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+	%[3]d: migration.%[1]s{},
+}),
+`, typeName, fromVersion+1, fromVersion)
+}
+
+func convertToSnakeCase(input string) string {
+	output := strings.Builder{}
+	for i, r := range input {
+		if unicode.IsUpper(r) {
+			if i > 0 && !unicode.IsUpper(rune(input[i-1])) {
+				output.WriteRune('_')
+			}
+			output.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		output.WriteRune(r)
+	}
+
+	return output.String()
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var testOldSchema = map[string]providerjson.SchemaJSON{
+	"name": {
+		Type:     "TypeString",
+		Required: true,
+		ForceNew: true,
+	},
+	"sku": {
+		Type:     "TypeString",
+		Optional: true,
+	},
+	"legacy_enabled": {
+		Type:     "TypeBool",
+		Optional: true,
+	},
+	"network": {
+		Type:     "TypeList",
+		Optional: true,
+		MaxItems: 1,
+		Elem: providerjson.ResourceJSON{
+			Schema: map[string]providerjson.SchemaJSON{
+				"subnet": {
+					Type:     "TypeString",
+					Optional: true,
+				},
+			},
+		},
+	},
+}
+
+var testNewSchema = map[string]providerjson.SchemaJSON{
+	"name": {
+		Type:     "TypeString",
+		Required: true,
+		ForceNew: true,
+	},
+	"sku_name": {
+		Type:     "TypeString",
+		Optional: true,
+	},
+	"network": {
+		Type:     "TypeList",
+		Optional: true,
+		MaxItems: 1,
+		Elem: providerjson.ResourceJSON{
+			Schema: map[string]providerjson.SchemaJSON{
+				"subnet_id": {
+					Type:     "TypeString",
+					Optional: true,
+				},
+			},
+		},
+	},
+}
+
+func TestMappingValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		mapping Mapping
+		valid   bool
+	}{
+		{
+			name:    "removed fields aren't mapped",
+			mapping: Mapping{},
+			valid:   false,
+		},
+		{
+			name: "nested field isn't mapped",
+			mapping: Mapping{
+				Renames: map[string]string{
+					"sku": "sku_name",
+				},
+				Removals: []string{"legacy_enabled"},
+			},
+			valid: false,
+		},
+		{
+			name: "renamed field doesn't exist in the new schema",
+			mapping: Mapping{
+				Renames: map[string]string{
+					"sku":            "sku_tier",
+					"network.subnet": "network.subnet_id",
+				},
+				Removals: []string{"legacy_enabled"},
+			},
+			valid: false,
+		},
+		{
+			name: "renamed into a different block",
+			mapping: Mapping{
+				Renames: map[string]string{
+					"sku":            "sku_name",
+					"network.subnet": "name",
+				},
+				Removals: []string{"legacy_enabled"},
+			},
+			valid: false,
+		},
+		{
+			name: "field within a removed block",
+			mapping: Mapping{
+				Renames: map[string]string{
+					"sku": "sku_name",
+				},
+				Removals: []string{"legacy_enabled", "network", "network.subnet"},
+			},
+			valid: false,
+		},
+		{
+			name: "valid",
+			mapping: Mapping{
+				Renames: map[string]string{
+					"sku":            "sku_name",
+					"network.subnet": "network.subnet_id",
+				},
+				Removals: []string{"legacy_enabled"},
+			},
+			valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.name)
+
+		err := tc.mapping.validate(testOldSchema, testNewSchema)
+		if tc.valid && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestStateUpgradeGenerator(t *testing.T) {
+	generator := StateUpgradeGenerator{
+		PackageName: "migration",
+		TypeName:    "ExampleV0ToV1",
+		OldSchema:   testOldSchema,
+		Mapping: Mapping{
+			Renames: map[string]string{
+				"sku":            "sku_name",
+				"network.subnet": "network.subnet_id",
+			},
+			Removals: []string{"legacy_enabled"},
+			ID: &IDMapping{
+				ParseFunc:  "parse.ExampleIDInsensitively",
+				Import:     "github.com/hashicorp/terraform-provider-azurerm/internal/services/example/parse",
+				OldExample: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.Example/examples/example1",
+				NewExample: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/examples/example1",
+			},
+		},
+	}
+
+	code, err := generator.Code()
+	if err != nil {
+		t.Fatalf("generating code: %+v", err)
+	}
+	for _, expected := range []string{
+		"var _ pluginsdk.StateUpgrade = ExampleV0ToV1{}",
+		`id, err := parse.ExampleIDInsensitively(oldIdRaw)`,
+		`if items, ok := rawState["network"].([]interface{}); ok {`,
+		`raw["subnet_id"] = v`,
+		`rawState["sku_name"] = v`,
+		`delete(rawState, "legacy_enabled")`,
+		"MaxItems: 1,",
+	} {
+		if !strings.Contains(*code, expected) {
+			t.Fatalf("expected the generated code to contain %q but it didn't:\n\n%s", expected, *code)
+		}
+	}
+
+	testCode, err := generator.TestCode()
+	if err != nil {
+		t.Fatalf("generating test code: %+v", err)
+	}
+	for _, expected := range []string{
+		"func TestExampleV0ToV1(t *testing.T) {",
+		`"legacy_enabled": true,`,
+		`"subnet_id": "value",`,
+	} {
+		if !strings.Contains(*testCode, expected) {
+			t.Fatalf("expected the generated test code to contain %q but it didn't:\n\n%s", expected, *testCode)
+		}
+	}
+}
+
+func TestConvertToSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"Component":      "component",
+		"KeyVaultSecret": "key_vault_secret",
+		"APIKey":         "apikey",
+	}
+	for input, expected := range cases {
+		if actual := convertToSnakeCase(input); actual != expected {
+			t.Fatalf("expected %q but got %q for %q", expected, actual, input)
+		}
+	}
+}
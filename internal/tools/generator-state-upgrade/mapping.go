@@ -0,0 +1,198 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// Mapping describes how the state for the previous Schema Version should be transformed into the current Schema Version
+type Mapping struct {
+	// Renames is a map of the old path to the new path for each field which has been renamed, where fields within
+	// a nested block are specified as `block_name.field_name` - fields can only be renamed within the same block
+	Renames map[string]string `json:"renames,omitempty"`
+
+	// Removals is a list of the paths for each field which has been removed
+	Removals []string `json:"removals,omitempty"`
+
+	// ID specifies how the Resource ID should be rewritten, if it has changed
+	ID *IDMapping `json:"id,omitempty"`
+}
+
+type IDMapping struct {
+	// ParseFunc is the qualified function used to parse the old Resource ID, e.g. `parse.ComponentIDInsensitively`
+	// which must return a type which implements the `ID()` function to format the new Resource ID
+	ParseFunc string `json:"parseFunc"`
+
+	// Import is the import path of the package containing ParseFunc
+	Import string `json:"import"`
+
+	// OldExample is an example of the old Resource ID
+	OldExample string `json:"oldExample"`
+
+	// NewExample is the expected Resource ID after OldExample has been rewritten
+	NewExample string `json:"newExample"`
+}
+
+func loadMapping(fileName string) (*Mapping, error) {
+	if fileName == "" {
+		return &Mapping{}, nil
+	}
+
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var mapping Mapping
+	if err := json.Unmarshal(contents, &mapping); err != nil {
+		return nil, fmt.Errorf("parsing: %+v", err)
+	}
+
+	return &mapping, nil
+}
+
+func loadSnapshot(fileName string) (*providerjson.ResourceJSON, error) {
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot providerjson.ResourceJSON
+	if err := json.Unmarshal(contents, &snapshot); err != nil {
+		return nil, fmt.Errorf("parsing: %+v", err)
+	}
+
+	if len(snapshot.Schema) == 0 {
+		return nil, fmt.Errorf("the snapshot doesn't contain a schema")
+	}
+
+	return &snapshot, nil
+}
+
+// validate ensures that the mapping accounts for each field which exists in the old schema but not the new schema
+func (m Mapping) validate(oldSchema, newSchema map[string]providerjson.SchemaJSON) error {
+	oldPaths := schemaPaths(oldSchema, "")
+	newPaths := schemaPaths(newSchema, "")
+
+	handled := make(map[string]struct{})
+	for oldPath, newPath := range m.Renames {
+		if _, ok := oldPaths[oldPath]; !ok {
+			return fmt.Errorf("the renamed field %q doesn't exist in the old schema", oldPath)
+		}
+		if _, ok := newPaths[newPath]; !ok {
+			return fmt.Errorf("the field %q (renamed from %q) doesn't exist in the new schema", newPath, oldPath)
+		}
+		if parentPath(oldPath) != parentPath(newPath) {
+			return fmt.Errorf("the field %q can only be renamed within the same block, but was renamed to %q", oldPath, newPath)
+		}
+		handled[oldPath] = struct{}{}
+	}
+
+	for _, removed := range m.Removals {
+		if _, ok := oldPaths[removed]; !ok {
+			return fmt.Errorf("the removed field %q doesn't exist in the old schema", removed)
+		}
+		if _, ok := m.Renames[removed]; ok {
+			return fmt.Errorf("the field %q is both renamed and removed", removed)
+		}
+		handled[removed] = struct{}{}
+	}
+
+	for path := range handled {
+		if parent := parentPath(path); parent != "" && isHandled(parent, handled) {
+			return fmt.Errorf("the field %q is within a block which has already been renamed or removed", path)
+		}
+	}
+
+	missing := make([]string, 0)
+	for path := range oldPaths {
+		if _, ok := newPaths[path]; ok {
+			continue
+		}
+		if isHandled(path, handled) {
+			continue
+		}
+		missing = append(missing, path)
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("the fields %q exist in the old schema but not the new schema - these must be specified in either `renames` or `removals`", missing)
+	}
+
+	if m.ID != nil {
+		if m.ID.ParseFunc == "" || m.ID.Import == "" {
+			return fmt.Errorf("`id.parseFunc` and `id.import` must be specified to rewrite the Resource ID")
+		}
+		if m.ID.OldExample == "" || m.ID.NewExample == "" {
+			return fmt.Errorf("`id.oldExample` and `id.newExample` must be specified to rewrite the Resource ID")
+		}
+	}
+
+	return nil
+}
+
+// isHandled returns whether the path, or the block containing it, has been renamed or removed
+func isHandled(path string, handled map[string]struct{}) bool {
+	for {
+		if _, ok := handled[path]; ok {
+			return true
+		}
+
+		parent := parentPath(path)
+		if parent == "" {
+			return false
+		}
+		path = parent
+	}
+}
+
+// schemaPaths returns the path for each field within the schema, including those within nested blocks
+func schemaPaths(input map[string]providerjson.SchemaJSON, prefix string) map[string]providerjson.SchemaJSON {
+	output := make(map[string]providerjson.SchemaJSON)
+	for k, v := range input {
+		path := k
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, k)
+		}
+		output[path] = v
+
+		if block, ok := nestedBlock(v); ok {
+			for nestedPath, nested := range schemaPaths(block, path) {
+				output[nestedPath] = nested
+			}
+		}
+	}
+
+	return output
+}
+
+func nestedBlock(input providerjson.SchemaJSON) (map[string]providerjson.SchemaJSON, bool) {
+	switch elem := input.Elem.(type) {
+	case providerjson.ResourceJSON:
+		return elem.Schema, true
+	case *providerjson.ResourceJSON:
+		if elem != nil {
+			return elem.Schema, true
+		}
+	}
+
+	return nil, false
+}
+
+func parentPath(path string) string {
+	if i := strings.LastIndex(path, "."); i != -1 {
+		return path[:i]
+	}
+
+	return ""
+}
+
+func fieldName(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
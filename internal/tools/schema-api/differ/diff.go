@@ -2,6 +2,7 @@ package differ
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
@@ -12,104 +13,127 @@ type Differ struct {
 	current *providerjson.ProviderWrapper
 }
 
+// Diff returns a human-readable description of each breaking change between the named schema dump and the current provider
 func (d *Differ) Diff(fileName string, providerName string) []string {
-	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
+	violations, err := d.Violations(fileName, providerName)
+	if err != nil {
 		return []string{err.Error()}
 	}
 
+	output := make([]string, 0, len(violations))
+	for _, v := range violations {
+		output = append(output, v.String())
+	}
+
+	return output
+}
+
+// Violations returns each breaking change between the named schema dump and the current provider
+func (d *Differ) Violations(fileName string, providerName string) ([]Violation, error) {
+	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
+		return nil, err
+	}
+
 	if err := d.loadFromFile(fileName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if d.base.ProviderName != d.current.ProviderName {
-		return []string{fmt.Sprintf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)}
+		return nil, fmt.Errorf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)
 	}
 
-	violations := make([]string, 0)
+	return compareProviders(d.base.ProviderSchema, d.current.ProviderSchema), nil
+}
 
-	for resource, rs := range d.current.ProviderSchema.ResourcesMap {
-		_, ok := d.base.ProviderSchema.ResourcesMap[resource]
-		if !ok {
-			// New resource, no breaking changes to worry about
-			continue
-		}
-		for propertyName, propertySchema := range rs.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.ResourcesMap[resource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeResource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
-		}
-	}
+func compareProviders(base *providerjson.ProviderSchemaJSON, current *providerjson.ProviderSchemaJSON) []Violation {
+	violations := make([]Violation, 0)
+	violations = append(violations, compareResources(ViolationTypeResource, base.ResourcesMap, current.ResourcesMap, schema_rules.BreakingChangeRules)...)
+	violations = append(violations, compareResources(ViolationTypeDataSource, base.DataSourcesMap, current.DataSourcesMap, schema_rules.BreakingChangeRulesDataSource)...)
+	sortViolations(violations)
+	return violations
+}
+
+func compareResources(violationType string, base map[string]providerjson.ResourceJSON, current map[string]providerjson.ResourceJSON, rules []schema_rules.BreakingChangeRule) []Violation {
+	violations := make([]Violation, 0)
 
-	for dataSource, ds := range d.current.ProviderSchema.DataSourcesMap {
-		_, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
+	// new resources/data sources have no breaking changes to worry about, so only those in the base (released) json are checked
+	for name, baseResource := range base {
+		currentResource, ok := current[name]
 		if !ok {
-			// New data source, no breaking changes to worry about
+			violations = append(violations, Violation{
+				Type:    violationType,
+				Name:    name,
+				Rule:    fmt.Sprintf("%sRemoved", violationType),
+				Message: fmt.Sprintf("%s %q has been removed", violationType, name),
+			})
 			continue
 		}
-		for propertyName, propertySchema := range ds.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.DataSourcesMap[dataSource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeDataSource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+
+		for _, v := range compareSchemas(baseResource.Schema, currentResource.Schema, "", rules) {
+			v.Type = violationType
+			v.Name = name
+			violations = append(violations, v)
 		}
 	}
 
 	return violations
 }
 
-func compareNodeResource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeResource(newBase, newCurrent[k], k)...)
-		}
+func compareSchemas(base map[string]providerjson.SchemaJSON, current map[string]providerjson.SchemaJSON, prefix string, rules []schema_rules.BreakingChangeRule) (violations []Violation) {
+	propertyNames := make(map[string]struct{})
+	for k := range base {
+		propertyNames[k] = struct{}{}
 	}
-
-	for _, v := range schema_rules.BreakingChangeRules {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
-		}
+	for k := range current {
+		propertyNames[k] = struct{}{}
 	}
 
-	return
-}
+	for propertyName := range propertyNames {
+		// a property missing from the base (released) json is new, and one missing from the current json has been
+		// removed - both of which are represented by an empty schema, which the rules check for
+		baseItem := base[propertyName]
+		currentItem := current[propertyName]
 
-func compareNodeDataSource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeDataSource(newBase, newCurrent[k], k)...)
+		path := propertyName
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, propertyName)
+		}
+
+		baseBlock, baseIsBlock := nestedBlock(baseItem)
+		currentBlock, currentIsBlock := nestedBlock(currentItem)
+		if baseIsBlock && currentIsBlock {
+			violations = append(violations, compareSchemas(baseBlock, currentBlock, path, rules)...)
 		}
-	}
 
-	for _, v := range schema_rules.BreakingChangeRulesDataSource {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+		for _, rule := range rules {
+			if err := rule.Check(baseItem, currentItem, path); err != nil {
+				violations = append(violations, Violation{
+					Property: path,
+					Rule:     reflect.TypeOf(rule).Name(),
+					Message:  *err,
+				})
+			}
 		}
 	}
 
 	return
 }
 
-func nodeIsBlock(input providerjson.SchemaJSON) bool {
-	if input.Type == providerjson.SchemaTypeList || input.Type == providerjson.SchemaTypeSet {
-		if _, ok := input.Elem.(providerjson.ResourceJSON); ok {
-			return true
+// nestedBlock returns the schema for the nested block when the property is a block - the base (released) json
+// contains a ResourceJSON whereas the current provider contains a pointer to one
+func nestedBlock(input providerjson.SchemaJSON) (map[string]providerjson.SchemaJSON, bool) {
+	if input.Type != providerjson.SchemaTypeList && input.Type != providerjson.SchemaTypeSet {
+		return nil, false
+	}
+
+	switch elem := input.Elem.(type) {
+	case providerjson.ResourceJSON:
+		return elem.Schema, true
+	case *providerjson.ResourceJSON:
+		if elem != nil {
+			return elem.Schema, true
 		}
 	}
 
-	return false
+	return nil, false
 }
@@ -0,0 +1,149 @@
+package differ
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+func TestCompareProviders(t *testing.T) {
+	// the base (released) json contains a ResourceJSON for nested blocks, whereas the current provider contains a pointer
+	base := &providerjson.ProviderSchemaJSON{
+		ResourcesMap: map[string]providerjson.ResourceJSON{
+			"azurerm_example": {
+				Schema: map[string]providerjson.SchemaJSON{
+					"name": {
+						Type:     "TypeString",
+						Required: true,
+						ForceNew: true,
+					},
+					"removed": {
+						Type:     "TypeString",
+						Optional: true,
+					},
+					"block": {
+						Type:     providerjson.SchemaTypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: providerjson.ResourceJSON{
+							Schema: map[string]providerjson.SchemaJSON{
+								"nested": {
+									Type:     "TypeString",
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"azurerm_removed": {
+				Schema: map[string]providerjson.SchemaJSON{},
+			},
+		},
+		DataSourcesMap: map[string]providerjson.ResourceJSON{
+			"azurerm_example": {
+				Schema: map[string]providerjson.SchemaJSON{
+					"name": {
+						Type:     "TypeString",
+						Required: true,
+					},
+					"sku": {
+						Type:     "TypeString",
+						Optional: true,
+						Default:  "Basic",
+					},
+				},
+			},
+		},
+	}
+	current := &providerjson.ProviderSchemaJSON{
+		ResourcesMap: map[string]providerjson.ResourceJSON{
+			"azurerm_example": {
+				Schema: map[string]providerjson.SchemaJSON{
+					"name": {
+						Type:     "TypeString",
+						Required: true,
+						ForceNew: true,
+					},
+					"block": {
+						Type:     providerjson.SchemaTypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &providerjson.ResourceJSON{
+							Schema: map[string]providerjson.SchemaJSON{
+								"nested": {
+									Type:     "TypeString",
+									Optional: true,
+									ForceNew: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"azurerm_new": {
+				Schema: map[string]providerjson.SchemaJSON{},
+			},
+		},
+		DataSourcesMap: map[string]providerjson.ResourceJSON{
+			"azurerm_example": {
+				Schema: map[string]providerjson.SchemaJSON{
+					"name": {
+						Type:     "TypeString",
+						Required: true,
+					},
+					"sku": {
+						Type:     "TypeString",
+						Optional: true,
+						Default:  "Standard",
+					},
+				},
+			},
+		},
+	}
+
+	expected := []Violation{
+		{
+			Type:     ViolationTypeDataSource,
+			Name:     "azurerm_example",
+			Property: "sku",
+			Rule:     "defaultValueChange",
+		},
+		{
+			Type:     ViolationTypeResource,
+			Name:     "azurerm_example",
+			Property: "block",
+			Rule:     "maxItemsReduced",
+		},
+		{
+			Type:     ViolationTypeResource,
+			Name:     "azurerm_example",
+			Property: "block.nested",
+			Rule:     "becomeForceNew",
+		},
+		{
+			Type:     ViolationTypeResource,
+			Name:     "azurerm_example",
+			Property: "removed",
+			Rule:     "propertyRemoved",
+		},
+		{
+			Type: ViolationTypeResource,
+			Name: "azurerm_removed",
+			Rule: "resourceRemoved",
+		},
+	}
+
+	actual := compareProviders(base, current)
+	for i := range actual {
+		if actual[i].Message == "" {
+			t.Fatalf("expected a message for violation %+v", actual[i])
+		}
+		actual[i].Message = ""
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
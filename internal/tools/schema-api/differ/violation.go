@@ -0,0 +1,50 @@
+package differ
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	ViolationTypeDataSource = "dataSource"
+	ViolationTypeResource   = "resource"
+)
+
+// Violation is a machine-readable representation of a breaking change detected between the two schemas
+type Violation struct {
+	// Type is the type of the Terraform object, either `resource` or `dataSource`
+	Type string `json:"type"`
+
+	// Name is the name of the Resource or Data Source, e.g. `azurerm_resource_group`
+	Name string `json:"name"`
+
+	// Property is the path to the property within the Resource or Data Source, e.g. `identity.type`
+	// this is empty when the Resource or Data Source has been removed
+	Property string `json:"property,omitempty"`
+
+	// Rule is the name of the rule which detected this breaking change
+	Rule string `json:"rule"`
+
+	// Message is a human-readable description of the breaking change
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s %q: %s", v.Type, v.Name, v.Message)
+}
+
+func sortViolations(input []Violation) {
+	sort.Slice(input, func(i, j int) bool {
+		a, b := input[i], input[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Property != b.Property {
+			return a.Property < b.Property
+		}
+		return a.Rule < b.Rule
+	})
+}
@@ -0,0 +1,23 @@
+package differ
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// WriteViolations writes the violations to the given path/filename as JSON, for consumption by other tooling
+func WriteViolations(violations []Violation, fileName string) error {
+	f, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(violations); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -23,6 +23,7 @@ func main() {
 	exportSchema := f.String("export", "", "export the schema to the given path/filename. Intended for use in the release process")
 	detectBreakingChanges := f.String("detect", "", "compare current schema to named dump.")
 	errorOnBreakingChange := f.Bool("error-on-violation", false, "should the detect mode exit with a non-zero error code. Defaults to `false`")
+	outputJSON := f.String("output-json", "", "used with detect, write the violations as JSON to the given path/filename")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		fmt.Printf("error parsing args: %+v", err)
@@ -50,15 +51,25 @@ func main() {
 	case pointer.From(detectBreakingChanges) != "":
 		{
 			d := differ.Differ{}
-			if violations := d.Diff(*detectBreakingChanges, *providerName); violations != nil {
-				for _, v := range violations {
-					log.Println(v)
-				}
-				if pointer.From(errorOnBreakingChange) {
-					os.Exit(1)
+			violations, err := d.Violations(*detectBreakingChanges, *providerName)
+			if err != nil {
+				log.Fatalf("error detecting breaking changes: %+v", err)
+			}
+
+			for _, v := range violations {
+				log.Println(v)
+			}
+
+			if pointer.From(outputJSON) != "" {
+				if err := differ.WriteViolations(violations, *outputJSON); err != nil {
+					log.Fatalf("error writing violations to %q: %+v", *outputJSON, err)
 				}
 			}
 
+			if len(violations) > 0 && pointer.From(errorOnBreakingChange) {
+				os.Exit(1)
+			}
+
 			os.Exit(0)
 		}
 
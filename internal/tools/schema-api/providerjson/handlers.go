@@ -21,7 +21,7 @@ func (p *ProviderJSON) DataSourcesHandler(w http.ResponseWriter, req *http.Reque
 
 	dsRaw := strings.Split(req.URL.RequestURI(), DataSourcesPath)
 	ds := strings.Split(dsRaw[1], "/")[0]
-	data, err := resourceFromRaw(p.DataSourcesMap[ds])
+	data, err := ResourceFromRaw(p.DataSourcesMap[ds])
 	if err != nil {
 		w.WriteHeader(http.StatusNotFound)
 		log.Println(w.Write([]byte(fmt.Sprintf("[{\"error\": \"Could not process ProviderSchema for %q from provider: %+v\"}]", ds, err))))
@@ -36,7 +36,7 @@ func (p *ProviderJSON) ResourcesHandler(w http.ResponseWriter, req *http.Request
 
 	dsRaw := strings.Split(req.URL.RequestURI(), ResourcesPath)
 	ds := strings.Split(dsRaw[1], "/")[0]
-	data, err := resourceFromRaw(p.ResourcesMap[ds])
+	data, err := ResourceFromRaw(p.ResourcesMap[ds])
 	if err != nil {
 		w.WriteHeader(http.StatusNotFound)
 		log.Println(w.Write([]byte(fmt.Sprintf("[{\"error\": \"Could not process ProviderSchema for %q from provider: %+v\"}]", ds, err))))
@@ -30,6 +30,9 @@ type SchemaJSON struct {
 	Elem        interface{} `json:"elem,omitempty"`
 	MaxItems    int         `json:"maxItems,omitempty"`
 	MinItems    int         `json:"minItems,omitempty"`
+
+	// HasValidation is nil when the schema was exported before validation information was included
+	HasValidation *bool `json:"hasValidation,omitempty"`
 }
 
 func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
@@ -49,7 +52,10 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 		b.MaxItems = int(max)
 	}
 	if min, ok := m["minItems"].(float64); ok {
-		b.MaxItems = int(min)
+		b.MinItems = int(min)
+	}
+	if v, ok := m["hasValidation"].(bool); ok {
+		b.HasValidation = &v
 	}
 
 	if def, ok := m["default"]; ok && def != nil {
@@ -67,14 +73,8 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 		}
 	}
 
-	if e, ok := m["elem"]; ok && e != nil {
-		elem := e.(map[string]interface{})
-		if schema, ok := elem["schema"]; ok {
-			b.Elem = ResourceFromMap(schema.(map[string]interface{}))
-		}
-		if t, ok := elem["type"]; ok {
-			b.Elem = t.(string)
-		}
+	if e, ok := m["elem"].(map[string]interface{}); ok {
+		b.Elem = elemFromMap(e)
 	}
 
 	return nil
@@ -3,10 +3,12 @@ package providerjson
 import (
 	"fmt"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-func resourceFromRaw(input *schema.Resource) (*ResourceJSON, error) {
+// ResourceFromRaw converts the schema for a Resource or Data Source into its JSON representation
+func ResourceFromRaw(input *schema.Resource) (*ResourceJSON, error) {
 	if input == nil {
 		return nil, fmt.Errorf("resource not found")
 	}
@@ -54,6 +56,8 @@ func schemaFromRaw(input *schema.Schema) SchemaJSON {
 		Elem:        decodeElem(input.Elem),
 		MaxItems:    input.MaxItems,
 		MinItems:    input.MinItems,
+
+		HasValidation: pointer.To(input.ValidateFunc != nil || input.ValidateDiagFunc != nil),
 	}
 }
 
@@ -91,12 +95,8 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.ForceNew = t.(bool)
 	}
 
-	if t, ok := input["forceNew"]; ok {
-		result.ForceNew = t.(bool)
-	}
-
-	if t, ok := input["elem"]; ok {
-		result.Elem = decodeElem(t)
+	if t, ok := input["elem"].(map[string]interface{}); ok {
+		result.Elem = elemFromMap(t)
 	}
 
 	if t, ok := input["minItems"]; ok {
@@ -107,6 +107,10 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.MaxItems = int(t.(float64))
 	}
 
+	if t, ok := input["hasValidation"].(bool); ok {
+		result.HasValidation = &t
+	}
+
 	return result
 }
 
@@ -120,6 +124,17 @@ func ResourceFromMap(input map[string]interface{}) ResourceJSON {
 	return result
 }
 
+// elemFromMap decodes the `elem` of an exported schema, which is either a nested block or the type of the items
+func elemFromMap(input map[string]interface{}) interface{} {
+	if schema, ok := input["schema"].(map[string]interface{}); ok {
+		return ResourceFromMap(schema)
+	}
+	if t, ok := input["type"].(string); ok {
+		return t
+	}
+	return nil
+}
+
 func decodeConfigMode(input schema.SchemaConfigMode) (out string) {
 	switch input {
 	case 1:
@@ -145,7 +160,7 @@ func decodeElem(input interface{}) interface{} {
 	case *schema.Schema:
 		return schemaFromRaw(t)
 	case *schema.Resource:
-		r, _ := resourceFromRaw(t)
+		r, _ := ResourceFromRaw(t)
 		return r
 	}
 	return nil
@@ -167,7 +182,7 @@ func ProviderFromRaw(input *ProviderJSON) (*ProviderSchemaJSON, error) {
 	}
 
 	for k, v := range input.ResourcesMap {
-		resource, err := resourceFromRaw(v)
+		resource, err := ResourceFromRaw(v)
 		if err != nil {
 			return nil, err
 		}
@@ -175,7 +190,7 @@ func ProviderFromRaw(input *ProviderJSON) (*ProviderSchemaJSON, error) {
 	}
 
 	for k, v := range input.DataSourcesMap {
-		dataSource, err := resourceFromRaw(v)
+		dataSource, err := ResourceFromRaw(v)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,21 @@
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = becomeForceNew{}
+
+type becomeForceNew struct{}
+
+// Check - Checks that an existing property is not updated to become ForceNew, since changes to it would now recreate the resource
+func (becomeForceNew) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type != "" && !base.ForceNew && current.ForceNew {
+		return pointer.To(fmt.Sprintf("Cannot change property %q to ForceNew", propertyName))
+	}
+
+	return nil
+}
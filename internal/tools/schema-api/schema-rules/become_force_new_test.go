@@ -0,0 +1,79 @@
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var becomeForceNewBase = providerjson.SchemaJSON{
+	Type:        providerjson.SchemaTypeString,
+	ConfigMode:  "",
+	Optional:    true,
+	Required:    false,
+	Default:     nil,
+	Description: "",
+	Computed:    false,
+	ForceNew:    false,
+	Elem:        nil,
+	MaxItems:    0,
+	MinItems:    0,
+}
+
+var becomeForceNewPasses = providerjson.SchemaJSON{
+	Type:        providerjson.SchemaTypeString,
+	ConfigMode:  "",
+	Optional:    true,
+	Required:    false,
+	Default:     nil,
+	Description: "",
+	Computed:    false,
+	ForceNew:    false,
+	Elem:        nil,
+	MaxItems:    0,
+	MinItems:    0,
+}
+
+var becomeForceNewViolates = providerjson.SchemaJSON{
+	Type:        providerjson.SchemaTypeString,
+	ConfigMode:  "",
+	Optional:    true,
+	Required:    false,
+	Default:     nil,
+	Description: "",
+	Computed:    false,
+	ForceNew:    true, // violation
+	Elem:        nil,
+	MaxItems:    0,
+	MinItems:    0,
+}
+
+var becomeForceNewNewProperty = providerjson.SchemaJSON{
+	Type:        "", // empty here indicates this doesn't exist in the base resource
+	ConfigMode:  "",
+	Optional:    false,
+	Required:    false,
+	Default:     nil,
+	Description: "",
+	Computed:    false,
+	ForceNew:    false,
+	Elem:        nil,
+	MaxItems:    0,
+	MinItems:    0,
+}
+
+func TestBecomeForceNew_Check(t *testing.T) {
+	data := becomeForceNew{}
+	if res := data.Check(becomeForceNewBase, becomeForceNewPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(becomeForceNewBase, becomeForceNewViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+	if res := data.Check(becomeForceNewViolates, becomeForceNewBase, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(becomeForceNewNewProperty, becomeForceNewViolates, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+}
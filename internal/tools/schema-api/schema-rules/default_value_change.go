@@ -12,11 +12,17 @@ type defaultValueChange struct {
 
 var _ BreakingChangeRule = defaultValueChange{}
 
-// Check - Checks that an Optional or Required property is not updated to become Computed only
+// Check - Checks that the Default value of an existing property has not been changed
 func (o defaultValueChange) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
-	if base.Default != current.Default {
-		return pointer.To(fmt.Sprintf("Cannot change property %q to Computed only", propertyName))
+	// new and removed properties are covered by other rules
+	if (base.Type == "" && base.Default == nil) || (current.Type == "" && current.Default == nil) {
+		return nil
 	}
 
-	return nil
+	// numbers are decoded from the exported schema as a float64, so compare the formatted values
+	if fmt.Sprintf("%v", base.Default) == fmt.Sprintf("%v", current.Default) {
+		return nil
+	}
+
+	return pointer.To(fmt.Sprintf("Cannot change the Default value for property %q (%v to %v)", propertyName, base.Default, current.Default))
 }
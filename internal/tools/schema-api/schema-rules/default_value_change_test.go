@@ -132,6 +132,21 @@ var defaultValueChangeFloatViolates = providerjson.SchemaJSON{
 	MinItems:    0,
 }
 
+// numbers within the exported (base) schema are decoded as a float64
+var defaultValueChangeExportedIntBase = providerjson.SchemaJSON{
+	Type:        providerjson.SchemaTypeInt,
+	ConfigMode:  "",
+	Optional:    true,
+	Required:    false,
+	Default:     float64(1),
+	Description: "",
+	Computed:    false,
+	ForceNew:    false,
+	Elem:        nil,
+	MaxItems:    0,
+	MinItems:    0,
+}
+
 func TestDefaultValueChange_Check(t *testing.T) {
 	data := defaultValueChange{}
 	if res := data.Check(defaultValueChangeStringBase, defaultValueChangeStringPasses, ""); res != nil {
@@ -152,4 +167,7 @@ func TestDefaultValueChange_Check(t *testing.T) {
 	if res := data.Check(defaultValueChangeFloatBase, defaultValueChangeFloatViolates, ""); res == nil {
 		t.Errorf("expected violation, but didn't get one")
 	}
+	if res := data.Check(defaultValueChangeExportedIntBase, defaultValueChangeIntPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
 }
@@ -0,0 +1,79 @@
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var maxItemsReducedBase = providerjson.SchemaJSON{
+	Type:        providerjson.SchemaTypeList,
+	ConfigMode:  "",
+	Optional:    true,
+	Required:    false,
+	Default:     nil,
+	Description: "",
+	Computed:    false,
+	ForceNew:    false,
+	Elem:        nil,
+	MaxItems:    2,
+	MinItems:    0,
+}
+
+var maxItemsReducedPasses = providerjson.SchemaJSON{
+	Type:        providerjson.SchemaTypeList,
+	ConfigMode:  "",
+	Optional:    true,
+	Required:    false,
+	Default:     nil,
+	Description: "",
+	Computed:    false,
+	ForceNew:    false,
+	Elem:        nil,
+	MaxItems:    3,
+	MinItems:    0,
+}
+
+var maxItemsReducedViolates = providerjson.SchemaJSON{
+	Type:        providerjson.SchemaTypeList,
+	ConfigMode:  "",
+	Optional:    true,
+	Required:    false,
+	Default:     nil,
+	Description: "",
+	Computed:    false,
+	ForceNew:    false,
+	Elem:        nil,
+	MaxItems:    1, // violation
+	MinItems:    0,
+}
+
+var maxItemsReducedUnlimited = providerjson.SchemaJSON{
+	Type:        providerjson.SchemaTypeList,
+	ConfigMode:  "",
+	Optional:    true,
+	Required:    false,
+	Default:     nil,
+	Description: "",
+	Computed:    false,
+	ForceNew:    false,
+	Elem:        nil,
+	MaxItems:    0,
+	MinItems:    0,
+}
+
+func TestMaxItemsReduced_Check(t *testing.T) {
+	data := maxItemsReduced{}
+	if res := data.Check(maxItemsReducedBase, maxItemsReducedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(maxItemsReducedBase, maxItemsReducedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+	if res := data.Check(maxItemsReducedBase, maxItemsReducedUnlimited, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(maxItemsReducedUnlimited, maxItemsReducedBase, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
@@ -8,12 +8,22 @@ type BreakingChangeRule interface {
 
 var BreakingChangeRules = []BreakingChangeRule{
 	becomeComputedOnly{},
+	becomeForceNew{},
+	maxItemsReduced{},
 	newRequiredPropertyExistingResource{},
 	optionalRemoveComputed{},
 	optionalToRequired{},
+	propertyRemoved{},
 	propertyType{},
+	validationAdded{},
 }
 
 var BreakingChangeRulesDataSource = []BreakingChangeRule{
+	defaultValueChange{},
+	maxItemsReduced{},
+	newRequiredPropertyExistingResource{},
+	optionalToRequired{},
+	propertyRemoved{},
 	propertyType{},
+	validationAdded{},
 }
@@ -0,0 +1,28 @@
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = validationAdded{}
+
+type validationAdded struct{}
+
+// Check - Checks that validation has not been added to an existing property which previously had none, since values within
+// users configurations may no longer be accepted. Since validation functions can't be inspected, narrowing existing validation
+// (e.g. removing a value from `validation.StringInSlice`) can't be detected.
+func (validationAdded) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	// schemas exported prior to validation information being included don't specify this
+	if base.HasValidation == nil || current.HasValidation == nil {
+		return nil
+	}
+
+	if !*base.HasValidation && *current.HasValidation {
+		return pointer.To(fmt.Sprintf("Cannot add validation to the existing property %q as values previously accepted may now be rejected", propertyName))
+	}
+
+	return nil
+}
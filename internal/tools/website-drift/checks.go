@@ -0,0 +1,502 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// issue is a mismatch between the documentation and the schema
+type issue struct {
+	line    int
+	message string
+
+	// fixed specifies whether this mismatch has been fixed in the document
+	fixed bool
+}
+
+// schemaBlock is a nested block within the schema for a Resource
+type schemaBlock struct {
+	fields map[string]providerjson.SchemaJSON
+
+	// isArgument specifies whether the block can be configured, rather than only being exported
+	isArgument bool
+}
+
+type checker struct {
+	doc    *document
+	fix    bool
+	issues []issue
+
+	// blocks are the nested blocks within the schema, keyed by the block name - since the documentation
+	// refers to nested blocks only by name, a block name can refer to more than one block within the schema
+	blocks map[string][]schemaBlock
+}
+
+// checkDocument compares the document against the schema for the Resource, returning the mismatches - which
+// are fixed within the document where possible when fix is true
+func checkDocument(doc *document, resource providerjson.ResourceJSON, fix bool) []issue {
+	c := &checker{
+		doc:    doc,
+		fix:    fix,
+		blocks: map[string][]schemaBlock{},
+	}
+	collectBlocks(resource.Schema, c.blocks)
+
+	if arguments, ok := doc.arguments[""]; ok {
+		c.checkArguments(arguments, resource.Schema)
+		c.checkArgumentBlocks()
+	} else {
+		c.report(0, "the `Arguments Reference` section is missing")
+	}
+
+	if attributes, ok := doc.attributes[""]; ok {
+		c.checkAttributes(attributes, doc.arguments[""], resource.Schema)
+		c.checkAttributeBlocks()
+	} else {
+		c.report(0, "the `Attributes Reference` section is missing")
+	}
+
+	c.checkTimeouts(resource.Timeouts)
+
+	sort.SliceStable(c.issues, func(i, j int) bool {
+		return c.issues[i].line < c.issues[j].line
+	})
+	return c.issues
+}
+
+func collectBlocks(input map[string]providerjson.SchemaJSON, out map[string][]schemaBlock) {
+	for _, name := range sortedFieldNames(input) {
+		field := input[name]
+		nested, ok := field.Elem.(*providerjson.ResourceJSON)
+		if !ok || nested == nil {
+			continue
+		}
+
+		out[name] = append(out[name], schemaBlock{
+			fields:     nested.Schema,
+			isArgument: field.Optional || field.Required,
+		})
+		collectBlocks(nested.Schema, out)
+	}
+}
+
+func (c *checker) report(line int, message string, args ...interface{}) {
+	c.issues = append(c.issues, issue{
+		line:    line,
+		message: fmt.Sprintf(message, args...),
+	})
+}
+
+// reportFixable reports a mismatch, calling fixFunc to fix the document when fixing is enabled
+func (c *checker) reportFixable(line int, fixFunc func(), message string, args ...interface{}) {
+	c.report(line, message, args...)
+	if c.fix {
+		fixFunc()
+		c.issues[len(c.issues)-1].fixed = true
+	}
+}
+
+// checkArguments compares the arguments documented within a block against the fields within the schema
+func (c *checker) checkArguments(documented *documentedBlock, fields map[string]providerjson.SchemaJSON) {
+	for _, name := range sortedFieldNames(fields) {
+		field := fields[name]
+		docField, isDocumented := documented.fields[name]
+		isArgument := field.Optional || field.Required
+
+		if !isArgument {
+			if isDocumented && documented.name == "" {
+				c.report(docField.line, "%s is documented as an argument but is only exported as an attribute", describeField(documented.name, name))
+			}
+			continue
+		}
+
+		if !isDocumented {
+			c.report(documented.line, "%s is not documented", describeField(documented.name, name))
+			continue
+		}
+
+		c.checkStatus(documented.name, docField, field)
+		c.checkDefault(documented.name, docField, field)
+		c.checkForceNew(documented.name, docField, field)
+	}
+
+	for _, name := range sortedDocumentedNames(documented.fields) {
+		if _, ok := fields[name]; !ok {
+			c.report(documented.fields[name].line, "%s is documented but isn't present in the schema", describeField(documented.name, name))
+		}
+	}
+}
+
+func (c *checker) checkStatus(blockName string, docField *documentedField, field providerjson.SchemaJSON) {
+	expected := "Optional"
+	if field.Required {
+		expected = "Required"
+	}
+
+	if docField.status == expected {
+		return
+	}
+
+	line := c.doc.lines[docField.line]
+	if docField.status == "" {
+		c.reportFixable(docField.line, func() {
+			c.doc.replaceLine(docField.line, strings.Replace(line, " - ", fmt.Sprintf(" - (%s) ", expected), 1))
+		}, "%s is missing `(%s)`", describeField(blockName, docField.name), expected)
+		return
+	}
+
+	c.reportFixable(docField.line, func() {
+		c.doc.replaceLine(docField.line, strings.Replace(line, fmt.Sprintf("(%s)", docField.status), fmt.Sprintf("(%s)", expected), 1))
+	}, "%s is documented as `%s` but is `%s` in the schema", describeField(blockName, docField.name), docField.status, expected)
+}
+
+func (c *checker) checkDefault(blockName string, docField *documentedField, field providerjson.SchemaJSON) {
+	line := c.doc.lines[docField.line]
+	documentedDefault := ""
+	hasDocumentedDefault := false
+	if match := defaultRegex.FindStringSubmatch(line); match != nil {
+		documentedDefault = match[1]
+		hasDocumentedDefault = true
+	}
+
+	expected, hasDefault := formatDefault(field.Default)
+	switch {
+	case hasDefault && !hasDocumentedDefault:
+		c.reportFixable(docField.line, func() {
+			c.doc.replaceLine(docField.line, appendSentence(c.doc.lines[docField.line], fmt.Sprintf("Defaults to `%s`.", expected)))
+		}, "%s has a default value of `%s` which isn't documented", describeField(blockName, docField.name), expected)
+
+	case hasDefault && hasDocumentedDefault && !defaultsEqual(expected, documentedDefault):
+		c.reportFixable(docField.line, func() {
+			updated := defaultRegex.ReplaceAllStringFunc(c.doc.lines[docField.line], func(v string) string {
+				return strings.Replace(v, fmt.Sprintf("`%s`", documentedDefault), fmt.Sprintf("`%s`", expected), 1)
+			})
+			c.doc.replaceLine(docField.line, updated)
+		}, "%s is documented as defaulting to `%s` but defaults to `%s` in the schema", describeField(blockName, docField.name), documentedDefault, expected)
+
+	case !hasDefault && hasDocumentedDefault && !field.Computed && !isZeroValue(field.Type, documentedDefault):
+		// Computed fields can have a default value which is set by the API, so there's nothing to compare against
+		c.report(docField.line, "%s is documented as defaulting to `%s` but has no default value in the schema", describeField(blockName, docField.name), documentedDefault)
+	}
+}
+
+func (c *checker) checkForceNew(blockName string, docField *documentedField, field providerjson.SchemaJSON) {
+	line := c.doc.lines[docField.line]
+	isDocumented := forceNewRegex.MatchString(line)
+
+	if field.ForceNew && !isDocumented {
+		c.reportFixable(docField.line, func() {
+			c.doc.replaceLine(docField.line, appendSentence(c.doc.lines[docField.line], fmt.Sprintf("Changing this forces a new %s to be created.", c.doc.brandName())))
+		}, "%s is ForceNew but this isn't documented", describeField(blockName, docField.name))
+		return
+	}
+
+	if !field.ForceNew && isDocumented {
+		message := "%s is documented as forcing a new resource to be created but isn't ForceNew in the schema"
+		// only the standard sentence can be removed, since other wording is conditional (e.g. `Changing this from X to Y forces...`)
+		if !forceNewSentence.MatchString(line) {
+			c.report(docField.line, message, describeField(blockName, docField.name))
+			return
+		}
+
+		c.reportFixable(docField.line, func() {
+			c.doc.replaceLine(docField.line, forceNewSentence.ReplaceAllString(c.doc.lines[docField.line], ""))
+		}, message, describeField(blockName, docField.name))
+	}
+}
+
+// checkArgumentBlocks compares the nested blocks documented within the Arguments Reference against the schema
+func (c *checker) checkArgumentBlocks() {
+	for _, name := range sortedBlockNames(c.doc.arguments) {
+		documented := c.doc.arguments[name]
+		candidates := c.blocksNamed(name, true)
+		if len(candidates) == 0 {
+			if len(c.blocksNamed(name, false)) > 0 {
+				c.report(documented.line, "the `%s` block is documented as an argument but is only exported as an attribute", name)
+			} else {
+				c.report(documented.line, "the `%s` block is documented but isn't present in the schema", name)
+			}
+			continue
+		}
+
+		c.checkArguments(documented, bestMatch(documented, candidates).fields)
+	}
+
+	line := c.doc.sectionLines[sectionArguments]
+	for _, name := range sortedSchemaBlockNames(c.blocks) {
+		if _, ok := c.doc.arguments[name]; ok {
+			continue
+		}
+		for _, block := range c.blocksNamed(name, true) {
+			if hasArguments(block.fields) {
+				c.report(line, "the `%s` block is not documented", name)
+				break
+			}
+		}
+	}
+}
+
+// checkAttributes compares the (top-level) attributes documented within the Attributes Reference against the schema
+func (c *checker) checkAttributes(documented, arguments *documentedBlock, fields map[string]providerjson.SchemaJSON) {
+	for _, name := range sortedFieldNames(fields) {
+		field := fields[name]
+		if field.Optional || field.Required {
+			continue
+		}
+
+		if _, ok := documented.fields[name]; ok {
+			continue
+		}
+		if arguments != nil {
+			if _, ok := arguments.fields[name]; ok {
+				continue
+			}
+		}
+
+		c.report(documented.line, "%s is not documented", describeAttribute(documented.name, name))
+	}
+
+	for _, name := range sortedDocumentedNames(documented.fields) {
+		// the `id` field isn't part of the schema
+		if name == "id" && documented.name == "" {
+			continue
+		}
+		if _, ok := fields[name]; !ok {
+			c.report(documented.fields[name].line, "%s is documented but isn't present in the schema", describeAttribute(documented.name, name))
+		}
+	}
+}
+
+// checkAttributeBlocks compares the nested blocks documented within the Attributes Reference against the schema
+func (c *checker) checkAttributeBlocks() {
+	for _, name := range sortedBlockNames(c.doc.attributes) {
+		documented := c.doc.attributes[name]
+		candidates := c.blocks[name]
+		if len(candidates) == 0 {
+			c.report(documented.line, "the `%s` block is documented but isn't present in the schema", name)
+			continue
+		}
+
+		c.checkAttributes(documented, c.doc.arguments[name], bestMatch(documented, candidates).fields)
+	}
+
+	line := c.doc.sectionLines[sectionAttributes]
+	for _, name := range sortedSchemaBlockNames(c.blocks) {
+		if _, ok := c.doc.attributes[name]; ok {
+			continue
+		}
+		if _, ok := c.doc.arguments[name]; ok {
+			continue
+		}
+		if len(c.blocksNamed(name, false)) > 0 {
+			c.report(line, "the exported `%s` block is not documented", name)
+		}
+	}
+}
+
+// checkTimeouts compares the documented timeouts against the default timeouts within the schema
+func (c *checker) checkTimeouts(timeouts *providerjson.ResourceTimeoutJSON) {
+	expected := map[string]int{}
+	if timeouts != nil {
+		expected = map[string]int{
+			"create": timeouts.Create,
+			"read":   timeouts.Read,
+			"update": timeouts.Update,
+			"delete": timeouts.Delete,
+		}
+	}
+
+	line, hasSection := c.doc.sectionLines[sectionTimeouts]
+	for i, operation := range timeoutOperations {
+		minutes := expected[operation]
+		documented, isDocumented := c.doc.timeouts[operation]
+
+		switch {
+		case minutes > 0 && !isDocumented:
+			if !hasSection || len(c.doc.timeouts) == 0 {
+				c.report(line, "the `%s` timeout (%s) is not documented", operation, formatDuration(minutes))
+				continue
+			}
+
+			value := fmt.Sprintf("* `%s` - (Defaults to %s) Used when %s the %s.", operation, formatDuration(minutes), timeoutVerbs[operation], c.doc.brandName())
+			c.reportFixable(line, func() {
+				c.doc.insertLine(c.timeoutInsertionLine(i), value)
+			}, "the `%s` timeout (%s) is not documented", operation, formatDuration(minutes))
+
+		case minutes == 0 && isDocumented:
+			c.reportFixable(documented.line, func() {
+				c.doc.removeLine(documented.line)
+			}, "the `%s` timeout is documented but isn't present in the schema", operation)
+
+		case minutes > 0 && documented.minutes != minutes:
+			c.reportFixable(documented.line, func() {
+				updated := timeoutDefaultRegex.ReplaceAllString(c.doc.lines[documented.line], fmt.Sprintf("(Defaults to %s)", formatDuration(minutes)))
+				c.doc.replaceLine(documented.line, updated)
+			}, "the `%s` timeout is documented as %s but is %s in the schema", operation, formatDuration(documented.minutes), formatDuration(minutes))
+		}
+	}
+}
+
+// timeoutInsertionLine returns the line which a missing timeout should be inserted before, to retain the ordering
+func (c *checker) timeoutInsertionLine(index int) int {
+	for _, operation := range timeoutOperations[index+1:] {
+		if documented, ok := c.doc.timeouts[operation]; ok {
+			return documented.line
+		}
+	}
+
+	return c.doc.timeoutsEnd
+}
+
+var timeoutVerbs = map[string]string{
+	"create": "creating",
+	"read":   "retrieving",
+	"update": "updating",
+	"delete": "deleting",
+}
+
+// blocksNamed returns the blocks within the schema with the specified name which are either arguments or only exported
+func (c *checker) blocksNamed(name string, isArgument bool) []schemaBlock {
+	out := make([]schemaBlock, 0)
+	for _, block := range c.blocks[name] {
+		if block.isArgument == isArgument {
+			out = append(out, block)
+		}
+	}
+	return out
+}
+
+// bestMatch returns the block within the schema which has the most fields in common with the documented block
+func bestMatch(documented *documentedBlock, candidates []schemaBlock) schemaBlock {
+	best := candidates[0]
+	bestCount := -1
+	for _, candidate := range candidates {
+		count := 0
+		for name := range documented.fields {
+			if _, ok := candidate.fields[name]; ok {
+				count++
+			}
+		}
+		if count > bestCount {
+			best = candidate
+			bestCount = count
+		}
+	}
+	return best
+}
+
+func hasArguments(fields map[string]providerjson.SchemaJSON) bool {
+	for _, field := range fields {
+		if field.Optional || field.Required {
+			return true
+		}
+	}
+	return false
+}
+
+func describeField(blockName, name string) string {
+	if blockName == "" {
+		return fmt.Sprintf("the argument `%s`", name)
+	}
+	return fmt.Sprintf("the argument `%s` within the `%s` block", name, blockName)
+}
+
+func describeAttribute(blockName, name string) string {
+	if blockName == "" {
+		return fmt.Sprintf("the attribute `%s`", name)
+	}
+	return fmt.Sprintf("the attribute `%s` within the `%s` block", name, blockName)
+}
+
+// appendSentence appends the sentence to the documented field, ensuring the existing description ends with a full stop
+func appendSentence(line, sentence string) string {
+	line = strings.TrimRight(line, " ")
+	if !strings.HasSuffix(line, ".") && !strings.HasSuffix(line, "?") && !strings.HasSuffix(line, ")") {
+		line += "."
+	}
+	return fmt.Sprintf("%s %s", line, sentence)
+}
+
+// formatDefault returns the default value as it's documented, and whether there's a default value
+func formatDefault(input interface{}) (string, bool) {
+	if input == nil {
+		return "", false
+	}
+	if v, ok := input.(string); ok && v == "" {
+		return "", false
+	}
+
+	return fmt.Sprintf("%v", input), true
+}
+
+func defaultsEqual(expected, documented string) bool {
+	if expected == documented {
+		return true
+	}
+
+	// numbers can be documented in a different format, e.g. `1.0` rather than `1`
+	e, err := strconv.ParseFloat(expected, 64)
+	if err != nil {
+		return false
+	}
+	d, err := strconv.ParseFloat(documented, 64)
+	if err != nil {
+		return false
+	}
+	return e == d
+}
+
+// isZeroValue returns whether the documented default value is the zero value for the type - which is commonly
+// documented for fields which don't define a default value
+func isZeroValue(fieldType, value string) bool {
+	switch fieldType {
+	case "TypeBool":
+		return value == "false"
+	case "TypeInt", "TypeFloat":
+		return value == "0"
+	}
+	return false
+}
+
+func sortedFieldNames(input map[string]providerjson.SchemaJSON) []string {
+	out := make([]string, 0, len(input))
+	for k := range input {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func sortedDocumentedNames(input map[string]*documentedField) []string {
+	out := make([]string, 0, len(input))
+	for k := range input {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// sortedBlockNames returns the names of the nested blocks within the documentation (excluding the top-level block)
+func sortedBlockNames(input map[string]*documentedBlock) []string {
+	out := make([]string, 0, len(input))
+	for k := range input {
+		if k != "" {
+			out = append(out, k)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func sortedSchemaBlockNames(input map[string][]schemaBlock) []string {
+	out := make([]string, 0, len(input))
+	for k := range input {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
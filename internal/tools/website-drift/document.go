@@ -0,0 +1,317 @@
+package main
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	sectionArguments  = "arguments"
+	sectionAttributes = "attributes"
+	sectionTimeouts   = "timeouts"
+)
+
+var (
+	headingRegex        = regexp.MustCompile(`^(##|###)\s+(.+?)\s*$`)
+	blockRegex          = regexp.MustCompile("^(?i:an?|the|each) ((?:`[a-zA-Z0-9_]+`(?:, | and | or |, and |, or )?)+)(?: \\([^)]*\\))? blocks? .*:\\s*$")
+	blockNameRegex      = regexp.MustCompile("`([a-zA-Z0-9_]+)`")
+	fieldRegex          = regexp.MustCompile("^\\* `([a-zA-Z0-9_]+)` - (?:\\((Required|Optional)\\))?")
+	defaultRegex        = regexp.MustCompile("(?:[Dd]efaults? to|[Dd]efault value is|[Dd]efault is) `([^`]*)`")
+	forceNewRegex       = regexp.MustCompile(`(?i)forces a new`)
+	forceNewSentence    = regexp.MustCompile(`\s*Changing this forces a new ([^.]+?) to be created\.`)
+	timeoutRegex        = regexp.MustCompile("^\\* `(create|read|update|delete)` - \\(Defaults to ([^)]+)\\)")
+	timeoutDefaultRegex = regexp.MustCompile(`\(Defaults to [^)]+\)`)
+	timeoutUsedRegex    = regexp.MustCompile(`Used when \w+ the (.+?)\.?\s*$`)
+	hoursRegex          = regexp.MustCompile(`(\d+) hours?`)
+	minutesRegex        = regexp.MustCompile(`(\d+) minutes?`)
+)
+
+// timeoutOperations are the operations which can have a timeout, in the order they're documented
+var timeoutOperations = []string{"create", "read", "update", "delete"}
+
+// document is a (parsed) documentation page for a Resource
+type document struct {
+	lines []string
+
+	// arguments are the blocks documented within the Arguments Reference, keyed by the block name
+	// (where the top-level arguments are keyed by an empty string)
+	arguments map[string]*documentedBlock
+
+	// attributes are the blocks documented within the Attributes Reference, keyed by the block name
+	// (where the top-level attributes are keyed by an empty string)
+	attributes map[string]*documentedBlock
+
+	// timeouts are the documented timeouts, keyed by the operation
+	timeouts map[string]*documentedTimeout
+
+	// sectionLines are the line numbers of the heading for each section
+	sectionLines map[string]int
+
+	// timeoutsEnd is the line number following the last documented timeout
+	timeoutsEnd int
+
+	removed  map[int]struct{}
+	inserted map[int][]string
+	modified bool
+}
+
+// documentedBlock is a (top-level or nested) block within the Arguments or Attributes Reference
+type documentedBlock struct {
+	name   string
+	line   int
+	fields map[string]*documentedField
+}
+
+// documentedField is an argument or attribute documented within a block
+type documentedField struct {
+	name string
+	line int
+
+	// status is either `Required` or `Optional` for an argument, otherwise empty
+	status string
+}
+
+// documentedTimeout is a timeout documented within the Timeouts section
+type documentedTimeout struct {
+	line    int
+	minutes int
+}
+
+func parseDocument(input string) *document {
+	doc := &document{
+		lines:        strings.Split(input, "\n"),
+		arguments:    map[string]*documentedBlock{},
+		attributes:   map[string]*documentedBlock{},
+		timeouts:     map[string]*documentedTimeout{},
+		sectionLines: map[string]int{},
+		removed:      map[int]struct{}{},
+		inserted:     map[int][]string{},
+	}
+
+	section := ""
+	// current are the blocks being documented, since a set of fields can be documented for multiple blocks
+	var current []*documentedBlock
+	inCodeBlock := false
+
+	for i, line := range doc.lines {
+		// code fences can also be used for inline code, which shouldn't toggle the code block
+		if strings.HasPrefix(line, "```") && strings.Count(line, "```") == 1 {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			continue
+		}
+
+		if match := headingRegex.FindStringSubmatch(line); match != nil {
+			// some pages document the Timeouts within a sub-heading, otherwise sub-headings are part of the section
+			headingSection := sectionForHeading(match[2])
+			if match[1] == "###" && headingSection == "" {
+				continue
+			}
+
+			section = headingSection
+			current = nil
+			if section != "" {
+				if _, exists := doc.sectionLines[section]; !exists {
+					doc.sectionLines[section] = i
+				}
+			}
+			if section == sectionArguments || section == sectionAttributes {
+				current = []*documentedBlock{doc.block(section, "", i)}
+			}
+			continue
+		}
+
+		switch section {
+		case sectionArguments, sectionAttributes:
+			// a separator either precedes a nested block, or returns to the top-level fields
+			if strings.TrimSpace(line) == "---" {
+				current = []*documentedBlock{doc.block(section, "", i)}
+				continue
+			}
+
+			if match := blockRegex.FindStringSubmatch(line); match != nil {
+				current = make([]*documentedBlock, 0)
+				for _, name := range blockNameRegex.FindAllStringSubmatch(match[1], -1) {
+					current = append(current, doc.block(section, name[1], i))
+				}
+				continue
+			}
+
+			if match := fieldRegex.FindStringSubmatch(line); match != nil {
+				for _, block := range current {
+					if _, exists := block.fields[match[1]]; !exists {
+						block.fields[match[1]] = &documentedField{
+							name:   match[1],
+							line:   i,
+							status: match[2],
+						}
+					}
+				}
+			}
+
+		case sectionTimeouts:
+			if match := timeoutRegex.FindStringSubmatch(line); match != nil {
+				doc.timeouts[match[1]] = &documentedTimeout{
+					line:    i,
+					minutes: parseDuration(match[2]),
+				}
+				doc.timeoutsEnd = i + 1
+			}
+		}
+	}
+
+	return doc
+}
+
+// sectionForHeading returns the section for a (level two) heading within the documentation
+func sectionForHeading(input string) string {
+	switch strings.ToLower(input) {
+	case "arguments reference", "argument reference":
+		return sectionArguments
+	case "attributes reference", "attribute reference":
+		return sectionAttributes
+	case "timeouts":
+		return sectionTimeouts
+	}
+
+	return ""
+}
+
+// block returns the documented block with the specified name within the section, creating it if necessary
+func (d *document) block(section, name string, line int) *documentedBlock {
+	blocks := d.arguments
+	if section == sectionAttributes {
+		blocks = d.attributes
+	}
+
+	if existing, ok := blocks[name]; ok {
+		return existing
+	}
+
+	block := &documentedBlock{
+		name:   name,
+		line:   line,
+		fields: map[string]*documentedField{},
+	}
+	blocks[name] = block
+	return block
+}
+
+// brandName returns the name used for the Resource within the documentation (e.g. `Resource Group`)
+func (d *document) brandName() string {
+	for _, line := range d.lines {
+		if match := forceNewSentence.FindStringSubmatch(line); match != nil && !strings.EqualFold(match[1], "resource") {
+			return match[1]
+		}
+	}
+	for _, operation := range timeoutOperations {
+		timeout, ok := d.timeouts[operation]
+		if !ok {
+			continue
+		}
+		if match := timeoutUsedRegex.FindStringSubmatch(d.lines[timeout.line]); match != nil {
+			return match[1]
+		}
+	}
+
+	return "resource"
+}
+
+// replaceLine replaces the contents of the line
+func (d *document) replaceLine(line int, value string) {
+	if d.lines[line] == value {
+		return
+	}
+
+	d.lines[line] = value
+	d.modified = true
+}
+
+// removeLine removes the line from the document
+func (d *document) removeLine(line int) {
+	d.removed[line] = struct{}{}
+	d.modified = true
+}
+
+// insertLine inserts the value before the line
+func (d *document) insertLine(line int, value string) {
+	d.inserted[line] = append(d.inserted[line], value)
+	d.modified = true
+}
+
+func (d *document) changed() bool {
+	return d.modified
+}
+
+func (d *document) String() string {
+	insertedAt := make([]int, 0, len(d.inserted))
+	for k := range d.inserted {
+		insertedAt = append(insertedAt, k)
+	}
+	sort.Ints(insertedAt)
+
+	out := make([]string, 0, len(d.lines))
+	for i, line := range d.lines {
+		out = append(out, d.inserted[i]...)
+		if _, removed := d.removed[i]; removed {
+			continue
+		}
+		out = append(out, line)
+	}
+	// values can also be inserted after the last line
+	for _, k := range insertedAt {
+		if k >= len(d.lines) {
+			out = append(out, d.inserted[k]...)
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// parseDuration parses a documented duration (e.g. `1 hour and 30 minutes`) into the number of minutes
+func parseDuration(input string) int {
+	minutes := 0
+	if match := hoursRegex.FindStringSubmatch(input); match != nil {
+		v, _ := strconv.Atoi(match[1])
+		minutes += v * 60
+	}
+	if match := minutesRegex.FindStringSubmatch(input); match != nil {
+		v, _ := strconv.Atoi(match[1])
+		minutes += v
+	}
+
+	return minutes
+}
+
+// formatDuration formats the number of minutes in the same way as the website-scaffold tool (e.g. `1 hour and 30 minutes`)
+func formatDuration(minutes int) string {
+	hours := minutes / 60
+	if hours > 0 {
+		hoursText := "1 hour"
+		if hours > 1 {
+			hoursText = strconv.Itoa(hours) + " hours"
+		}
+
+		minutesRemaining := minutes % 60
+		if minutesRemaining == 0 {
+			return hoursText
+		}
+
+		minutesText := "1 minute"
+		if minutesRemaining > 1 {
+			minutesText = strconv.Itoa(minutesRemaining) + " minutes"
+		}
+
+		return hoursText + " and " + minutesText
+	}
+
+	if minutes > 1 {
+		return strconv.Itoa(minutes) + " minutes"
+	}
+
+	return "1 minute"
+}
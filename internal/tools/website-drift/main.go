@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+func main() {
+	websitePath := flag.String("website-path", "", "The path to the `./website` directory in the root of this repository")
+	resourceType := flag.String("resource", "", "(Optional) The Resource Type to check the documentation for (e.g. `azurerm_resource_group`), otherwise all Resources are checked")
+	fix := flag.Bool("fix", false, "Whether the mismatches which can be fixed automatically should be fixed in the documentation")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	remaining, err := run(*websitePath, *resourceType, *fix)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if remaining > 0 {
+		log.Printf("%d mismatch(es) were found between the documentation and the schema", remaining)
+		os.Exit(1)
+	}
+}
+
+// run checks the documentation for each Resource against the schema, returning the number of mismatches which remain
+func run(websitePath, resourceType string, fix bool) (int, error) {
+	if websitePath == "" {
+		return 0, fmt.Errorf("`-website-path` must be specified")
+	}
+
+	// load the schema in the same way as the schema-api, so that the documentation is compared against the same data
+	data, err := providerjson.ProviderFromRaw(providerjson.LoadData())
+	if err != nil {
+		return 0, fmt.Errorf("loading the Provider schema: %+v", err)
+	}
+
+	resourceTypes := make([]string, 0)
+	for k := range data.ResourcesMap {
+		if resourceType == "" || k == resourceType {
+			resourceTypes = append(resourceTypes, k)
+		}
+	}
+	if len(resourceTypes) == 0 {
+		return 0, fmt.Errorf("the Resource %q was not found in the Provider", resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	remaining := 0
+	for _, name := range resourceTypes {
+		fileName := filepath.Join(websitePath, "docs", "r", fmt.Sprintf("%s.html.markdown", strings.TrimPrefix(name, "azurerm_")))
+		contents, err := os.ReadFile(fileName)
+		if err != nil {
+			if os.IsNotExist(err) {
+				log.Printf("%s: the documentation for %q does not exist", fileName, name)
+				remaining++
+				continue
+			}
+			return 0, fmt.Errorf("reading %q: %+v", fileName, err)
+		}
+
+		doc := parseDocument(string(contents))
+		issues := checkDocument(doc, data.ResourcesMap[name], fix)
+		for _, issue := range issues {
+			if issue.fixed {
+				log.Printf("%s:%d: (fixed) %s", fileName, issue.line+1, issue.message)
+				continue
+			}
+
+			log.Printf("%s:%d: %s", fileName, issue.line+1, issue.message)
+			remaining++
+		}
+
+		if fix && doc.changed() {
+			if err := os.WriteFile(fileName, []byte(doc.String()), 0o644); err != nil {
+				return 0, fmt.Errorf("writing %q: %+v", fileName, err)
+			}
+		}
+	}
+
+	return remaining, nil
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+func testResourceSchema() providerjson.ResourceJSON {
+	return providerjson.ResourceJSON{
+		Schema: map[string]providerjson.SchemaJSON{
+			"name": {
+				Type:     "TypeString",
+				Required: true,
+				ForceNew: true,
+			},
+			"sku_name": {
+				Type:     "TypeString",
+				Optional: true,
+				Default:  "Standard",
+			},
+			"enabled": {
+				Type:     "TypeBool",
+				Optional: true,
+			},
+			"network_rules": {
+				Type:     "TypeList",
+				Optional: true,
+				MaxItems: 1,
+				Elem: &providerjson.ResourceJSON{
+					Schema: map[string]providerjson.SchemaJSON{
+						"default_action": {
+							Type:     "TypeString",
+							Required: true,
+						},
+						"bypass": {
+							Type:     "TypeString",
+							Optional: true,
+						},
+					},
+				},
+			},
+			"endpoint": {
+				Type:     "TypeString",
+				Computed: true,
+			},
+		},
+		Timeouts: &providerjson.ResourceTimeoutJSON{
+			Create: 30,
+			Read:   5,
+			Update: 90,
+			Delete: 30,
+		},
+	}
+}
+
+const testDocument = `# azurerm_example
+
+## Arguments Reference
+
+The following arguments are supported:
+
+* ` + "`name`" + ` - (Optional) The name of this Example. Changing this forces a new Example to be created.
+
+* ` + "`sku_name`" + ` - (Optional) The SKU Name. Defaults to ` + "`Basic`" + `.
+
+* ` + "`enabled`" + ` - Should the Example be enabled? Changing this forces a new Example to be created.
+
+* ` + "`legacy`" + ` - (Optional) A field which has been removed.
+
+---
+
+A ` + "`network_rules`" + ` block supports the following:
+
+* ` + "`default_action`" + ` - (Required) The default action.
+
+## Attributes Reference
+
+In addition to the Arguments listed above - the following Attributes are exported:
+
+* ` + "`id`" + ` - The ID of the Example.
+
+## Timeouts
+
+* ` + "`create`" + ` - (Defaults to 30 minutes) Used when creating the Example.
+* ` + "`read`" + ` - (Defaults to 5 minutes) Used when retrieving the Example.
+* ` + "`delete`" + ` - (Defaults to 1 hour) Used when deleting the Example.
+`
+
+func TestCheckDocument(t *testing.T) {
+	doc := parseDocument(testDocument)
+	issues := checkDocument(doc, testResourceSchema(), false)
+
+	expected := []string{
+		"the argument `enabled` is missing `(Optional)`",
+		"the argument `enabled` is documented as forcing a new resource to be created but isn't ForceNew in the schema",
+		"the argument `legacy` is documented but isn't present in the schema",
+		"the argument `name` is documented as `Optional` but is `Required` in the schema",
+		"the argument `network_rules` is not documented",
+		"the argument `sku_name` is documented as defaulting to `Basic` but defaults to `Standard` in the schema",
+		"the argument `bypass` within the `network_rules` block is not documented",
+		"the attribute `endpoint` is not documented",
+		"the `update` timeout (1 hour and 30 minutes) is not documented",
+		"the `delete` timeout is documented as 1 hour but is 30 minutes in the schema",
+	}
+
+	actual := make(map[string]struct{})
+	for _, v := range issues {
+		actual[v.message] = struct{}{}
+		if v.fixed {
+			t.Fatalf("expected %q not to be fixed", v.message)
+		}
+	}
+	for _, v := range expected {
+		if _, ok := actual[v]; !ok {
+			t.Errorf("expected the issue %q but got %+v", v, issues)
+		}
+	}
+	if len(issues) != len(expected) {
+		t.Fatalf("expected %d issues but got %d: %+v", len(expected), len(issues), issues)
+	}
+	if doc.changed() {
+		t.Fatalf("expected the document to be unchanged when not fixing")
+	}
+}
+
+func TestFixDocument(t *testing.T) {
+	doc := parseDocument(testDocument)
+	checkDocument(doc, testResourceSchema(), true)
+	actual := doc.String()
+
+	expectedLines := []string{
+		"* `name` - (Required) The name of this Example. Changing this forces a new Example to be created.",
+		"* `sku_name` - (Optional) The SKU Name. Defaults to `Standard`.",
+		"* `enabled` - (Optional) Should the Example be enabled?",
+		"* `read` - (Defaults to 5 minutes) Used when retrieving the Example.\n* `update` - (Defaults to 1 hour and 30 minutes) Used when updating the Example.\n* `delete` - (Defaults to 30 minutes) Used when deleting the Example.",
+	}
+	for _, v := range expectedLines {
+		if !strings.Contains(actual, v) {
+			t.Errorf("expected the fixed document to contain %q but got:\n%s", v, actual)
+		}
+	}
+
+	// the remaining issues can't be fixed automatically
+	if remaining := checkDocument(parseDocument(actual), testResourceSchema(), false); len(remaining) != 4 {
+		t.Fatalf("expected 4 issues to remain but got %d: %+v", len(remaining), remaining)
+	}
+}
+
+func TestParseDocumentMultipleBlocks(t *testing.T) {
+	doc := parseDocument(strings.Join([]string{
+		"## Arguments Reference",
+		"",
+		"* `frontend` - (Optional) A `frontend` block as defined below.",
+		"",
+		"---",
+		"",
+		"A `frontend` or `backend` (endpoint) block supports the following:",
+		"",
+		"* `host_name` - (Required) The Host Name.",
+		"",
+		"---",
+		"",
+		"* `tags` - (Optional) A mapping of tags.",
+	}, "\n"))
+
+	for _, name := range []string{"frontend", "backend"} {
+		block, ok := doc.arguments[name]
+		if !ok {
+			t.Fatalf("expected the `%s` block to be documented", name)
+		}
+		if _, ok := block.fields["host_name"]; !ok {
+			t.Fatalf("expected the `%s` block to contain `host_name`", name)
+		}
+	}
+	if _, ok := doc.arguments[""].fields["tags"]; !ok {
+		t.Fatalf("expected `tags` to be a top-level argument after the separator")
+	}
+}
+
+func TestDuration(t *testing.T) {
+	cases := map[string]int{
+		"1 minute":              1,
+		"30 minutes":            30,
+		"1 hour":                60,
+		"2 hours":               120,
+		"1 hour and 30 minutes": 90,
+	}
+	for input, minutes := range cases {
+		if actual := parseDuration(input); actual != minutes {
+			t.Fatalf("expected %q to be %d minutes but got %d", input, minutes, actual)
+		}
+		if actual := formatDuration(minutes); actual != input {
+			t.Fatalf("expected %d minutes to be formatted as %q but got %q", minutes, input, actual)
+		}
+	}
+}
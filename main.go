@@ -5,7 +5,9 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 )
 
@@ -18,18 +20,35 @@ func main() {
 	flag.BoolVar(&debugMode, "debuggable", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	ctx := context.Background()
+
+	// tracing is only enabled when an exporter has been configured via Environment Variables
+	shutdownTracing, err := common.ConfigureTracing(ctx)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	providerServer, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		log.Fatalln(err.Error())
+	}
+
+	var serveOpts []tf5server.ServeOpt
 	if debugMode {
-		//nolint:staticcheck
-		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/azurerm",
-			&plugin.ServeOpts{
-				ProviderFunc: provider.AzureProvider,
-			})
-		if err != nil {
-			log.Println(err.Error())
-		}
-	} else {
-		plugin.Serve(&plugin.ServeOpts{
-			ProviderFunc: provider.AzureProvider,
-		})
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+	}
+
+	err = tf5server.Serve("registry.terraform.io/hashicorp/azurerm", providerServer, serveOpts...)
+
+	// report how often (and how long) locks were waited on, to help diagnose slow applies
+	log.Printf("[DEBUG] Locks waited on during this run: %s", locks.Stats())
+
+	// flush any remaining spans once the Provider is shut down
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("[DEBUG] Shutting down tracing: %+v", err)
+	}
+
+	if err != nil {
+		log.Fatalln(err.Error())
 	}
 }